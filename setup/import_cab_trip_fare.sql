@@ -0,0 +1,26 @@
+-- Loads a TLC trip fare file (e.g. trip_fare_12.csv) into the cab_trip_fare table
+-- the file must first be copied into the MySQL server 'secure_file_priv' directory as trip_fare.csv
+-- fares are joined to cab_trip_data on medallion, hack_license and pickup_datetime
+USE ny_cab_data;
+
+CREATE TABLE IF NOT EXISTS cab_trip_fare (
+    medallion VARCHAR(32) NOT NULL,
+    hack_license VARCHAR(32) NOT NULL,
+    vendor_id VARCHAR(3),
+    pickup_datetime DATETIME NOT NULL,
+    payment_type VARCHAR(3),
+    fare_amount DECIMAL(8, 2),
+    surcharge DECIMAL(8, 2),
+    mta_tax DECIMAL(8, 2),
+    tip_amount DECIMAL(8, 2),
+    tolls_amount DECIMAL(8, 2),
+    total_amount DECIMAL(8, 2),
+    KEY idx_trip (medallion, hack_license, pickup_datetime)
+);
+
+LOAD DATA INFILE '/var/lib/mysql-files/trip_fare.csv'
+INTO TABLE cab_trip_fare
+FIELDS TERMINATED BY ','
+LINES TERMINATED BY '\n'
+IGNORE 1 LINES
+(medallion, hack_license, vendor_id, pickup_datetime, payment_type, fare_amount, surcharge, mta_tax, tip_amount, tolls_amount, total_amount);
@@ -0,0 +1,20 @@
+-- Loads a TLC for-hire vehicle trip file (e.g. fhv_tripdata_2017-06.csv) into the fhv_trip_data table
+-- the file must first be copied into the MySQL server 'secure_file_priv' directory as fhv_tripdata.csv
+-- only the 2017 layout with pickup and dropoff times is supported, the taxi zone IDs are skipped
+USE ny_cab_data;
+
+CREATE TABLE IF NOT EXISTS fhv_trip_data (
+    Dispatching_base_num VARCHAR(16) NOT NULL,
+    Pickup_DateTime DATETIME NOT NULL,
+    DropOff_datetime DATETIME,
+    KEY idx_base_pickup (Dispatching_base_num, Pickup_DateTime),
+    KEY idx_pickup (Pickup_DateTime)
+);
+
+LOAD DATA INFILE '/var/lib/mysql-files/fhv_tripdata.csv'
+INTO TABLE fhv_trip_data
+FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '"'
+LINES TERMINATED BY '\n'
+IGNORE 1 LINES
+(Dispatching_base_num, Pickup_DateTime, @dropoff_datetime, @pu_location_id, @do_location_id)
+SET DropOff_datetime = NULLIF(TRIM(@dropoff_datetime), '');
@@ -0,0 +1,25 @@
+-- Loads a TLC green cab trip file (e.g. green_tripdata_2015-12.csv) into the green_trip_data table
+-- the file must first be copied into the MySQL server 'secure_file_priv' directory as green_tripdata.csv
+-- only the 2013-2016 layout with pickup and dropoff coordinates is supported, the columns the server does not query are skipped
+USE ny_cab_data;
+
+CREATE TABLE IF NOT EXISTS green_trip_data (
+    VendorID VARCHAR(3),
+    lpep_pickup_datetime DATETIME NOT NULL,
+    Lpep_dropoff_datetime DATETIME,
+    Pickup_longitude DOUBLE,
+    Pickup_latitude DOUBLE,
+    Dropoff_longitude DOUBLE,
+    Dropoff_latitude DOUBLE,
+    Passenger_count INT,
+    Trip_distance DOUBLE,
+    KEY idx_pickup (lpep_pickup_datetime)
+);
+
+LOAD DATA INFILE '/var/lib/mysql-files/green_tripdata.csv'
+INTO TABLE green_trip_data
+FIELDS TERMINATED BY ','
+LINES TERMINATED BY '\n'
+IGNORE 1 LINES
+(VendorID, lpep_pickup_datetime, Lpep_dropoff_datetime, @store_and_fwd_flag, @rate_code_id,
+ Pickup_longitude, Pickup_latitude, Dropoff_longitude, Dropoff_latitude, Passenger_count, Trip_distance);
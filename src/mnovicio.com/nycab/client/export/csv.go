@@ -0,0 +1,39 @@
+package export
+
+import (
+	"encoding/csv"
+	"os"
+	"strconv"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+// WriteODMatrixCSV writes origin-destination matrix entries into path as CSV with a header row
+func WriteODMatrixCSV(path string, entries []*pbdata.ODMatrixEntry) error {
+	rows := [][]string{{"origin_cell", "destination_cell", "trip_count", "avg_trip_duration_secs"}}
+	for _, entry := range entries {
+		rows = append(rows, []string{
+			entry.GetOriginCell(),
+			entry.GetDestinationCell(),
+			strconv.FormatUint(uint64(entry.GetTripCount()), 10),
+			strconv.FormatFloat(entry.GetAvgTripDurationSecs(), 'f', 2, 64),
+		})
+	}
+
+	return writeCSV(path, rows)
+}
+
+func writeCSV(path string, rows [][]string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err := w.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,69 @@
+package export
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+// FeatureCollection is a GeoJSON feature collection
+type FeatureCollection struct {
+	Type     string    `json:"type"`
+	Features []Feature `json:"features"`
+}
+
+// Feature is a GeoJSON feature
+type Feature struct {
+	Type       string                 `json:"type"`
+	Geometry   Geometry               `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// Geometry is a GeoJSON polygon geometry, coordinates are [longitude, latitude] pairs
+type Geometry struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+// WriteHeatmapGeoJSON writes heatmap cells into path as a GeoJSON feature collection of polygons
+// each feature has the 'geohash' and 'trip_count' properties
+func WriteHeatmapGeoJSON(path string, cells []*pbdata.HeatmapCell) error {
+	collection := FeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]Feature, 0, len(cells)),
+	}
+
+	for _, cell := range cells {
+		collection.Features = append(collection.Features, Feature{
+			Type:     "Feature",
+			Geometry: boundingBoxGeometry(cell.GetBounds()),
+			Properties: map[string]interface{}{
+				"geohash":    cell.GetGeohash(),
+				"trip_count": cell.GetTripCount(),
+			},
+		})
+	}
+
+	data, err := json.MarshalIndent(collection, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// boundingBoxGeometry returns the bounding box as a closed polygon ring, counterclockwise
+func boundingBoxGeometry(b *pbdata.BoundingBox) Geometry {
+	sw, ne := b.GetSouthWest(), b.GetNorthEast()
+	return Geometry{
+		Type: "Polygon",
+		Coordinates: [][][2]float64{{
+			{sw.GetLongitude(), sw.GetLatitude()},
+			{ne.GetLongitude(), sw.GetLatitude()},
+			{ne.GetLongitude(), ne.GetLatitude()},
+			{sw.GetLongitude(), ne.GetLatitude()},
+			{sw.GetLongitude(), sw.GetLatitude()},
+		}},
+	}
+}
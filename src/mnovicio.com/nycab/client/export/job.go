@@ -0,0 +1,52 @@
+package export
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+// WriteQueryJob writes the state and progress of a query job, followed by its result as JSON once it has succeeded
+func WriteQueryJob(w io.Writer, job *pbsvc.QueryJob) error {
+	fmt.Fprintf(w, "job:       %s\n", job.GetJobId())
+	fmt.Fprintf(w, "query:     %s\n", job.GetQuery())
+	fmt.Fprintf(w, "state:     %s\n", job.GetState())
+	fmt.Fprintf(w, "progress:  %.0f%%\n", job.GetProgress()*100)
+	fmt.Fprintf(w, "submitted: %s\n", job.GetSubmitTime())
+	if job.GetStartTime() != "" {
+		fmt.Fprintf(w, "started:   %s\n", job.GetStartTime())
+	}
+	if job.GetEndTime() != "" {
+		fmt.Fprintf(w, "ended:     %s\n", job.GetEndTime())
+	}
+	if job.GetError() != "" {
+		fmt.Fprintf(w, "error:     %s\n", job.GetError())
+	}
+	if job.GetResult() == nil {
+		return nil
+	}
+
+	// marshal the job without its metadata to only print the result
+	result := &pbsvc.QueryJob{
+		Result: job.GetResult(),
+	}
+	marshaler := jsonpb.Marshaler{OrigName: true, Indent: "  "}
+	if err := marshaler.Marshal(w, result); err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+
+	return nil
+}
+
+// QueryJobEnded returns true if the job has succeeded, failed or was cancelled
+func QueryJobEnded(job *pbsvc.QueryJob) bool {
+	switch job.GetState() {
+	case pbsvc.QueryJobState_SUCCEEDED, pbsvc.QueryJobState_FAILED, pbsvc.QueryJobState_CANCELLED:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+// WriteQueryExport writes the file streamed back by ExportQueryV1 into path, and its schema next to it for CSV files
+// recv returns the chunks of the file then io.EOF, path is only created once the first chunk is received
+func WriteQueryExport(path string, format pbsvc.ExportFormat, recv func() (*pbsvc.ExportQueryResponseV1, error)) (*pbsvc.ExportSchema, error) {
+	chunk, err := recv()
+	if err != nil {
+		return nil, err
+	}
+	if chunk.Error != "" {
+		return nil, fmt.Errorf("ExportQueryV1 returned error: %s", chunk.Error)
+	}
+	schema := chunk.Schema
+
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	for ; err == nil; chunk, err = recv() {
+		if _, err := f.Write(chunk.Data); err != nil {
+			return nil, err
+		}
+	}
+	if err != io.EOF {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	if format == pbsvc.ExportFormat_CSV {
+		if err := writeExportSchema(QueryExportSchemaPath(path), schema); err != nil {
+			return nil, err
+		}
+	}
+	return schema, nil
+}
+
+// QueryExportSchemaPath returns the path of the schema file of an exported CSV file, e.g. 'trips.schema.json' for 'trips.csv'
+func QueryExportSchemaPath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + ".schema.json"
+}
+
+func writeExportSchema(path string, schema *pbsvc.ExportSchema) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	marshaler := jsonpb.Marshaler{OrigName: true, EmitDefaults: true, Indent: "  "}
+	if err := marshaler.Marshal(f, schema); err != nil {
+		return err
+	}
+
+	return f.Close()
+}
+
+// WriteQueryExportSchema writes the query, row count and columns of exported query results as an aligned text table
+func WriteQueryExportSchema(w io.Writer, schema *pbsvc.ExportSchema) error {
+	fmt.Fprintf(w, "query:    %s\n", schema.GetQuery())
+	fmt.Fprintf(w, "request:  %s\n", schema.GetRequest())
+	fmt.Fprintf(w, "exported: %s\n", schema.GetExportTime())
+	fmt.Fprintf(w, "rows:     %d\n", schema.GetRowCount())
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "column\ttype\t\n")
+	for _, column := range schema.GetColumns() {
+		fmt.Fprintf(tw, "%s\t%s\t\n", column.GetName(), column.GetType())
+	}
+	return tw.Flush()
+}
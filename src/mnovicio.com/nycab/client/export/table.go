@@ -0,0 +1,55 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+// WriteTripPatternsTable writes the trip patterns of each cab as aligned text tables, by day of the week then by month
+func WriteTripPatternsTable(w io.Writer, patterns []*pbdata.TripPatterns) error {
+	for _, cabPatterns := range patterns {
+		fmt.Fprintf(w, "%s\n", cabPatterns.GetCabId())
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+		writeTripCountSummaries(tw, "day of week", cabPatterns.GetByDayOfWeek())
+		writeTripCountSummaries(tw, "month", cabPatterns.GetByMonth())
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeTripCountSummaries(w io.Writer, periodHeader string, summaries []*pbdata.TripCountSummary) {
+	fmt.Fprintf(w, "%s\tdays\ttotal trips\tmean\tstd dev\t\n", periodHeader)
+	for _, summary := range summaries {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%.2f\t%.2f\t\n",
+			summary.GetPeriod(), summary.GetDays(), summary.GetTotalTrips(), summary.GetMean(), summary.GetStdDev())
+	}
+	fmt.Fprintf(w, "\t\t\t\t\t\n")
+}
+
+// WritePassengerCountsTable writes the passenger count distribution of the fleet then of each cab as aligned text tables
+func WritePassengerCountsTable(w io.Writer, fleet *pbdata.PassengerCountDistribution, cabs []*pbdata.PassengerCountDistribution) error {
+	for _, distribution := range append([]*pbdata.PassengerCountDistribution{fleet}, cabs...) {
+		fmt.Fprintf(w, "%s\n", distribution.GetCabId())
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintf(tw, "passengers\ttrips\tshare\t\n")
+		for _, bucket := range distribution.GetBuckets() {
+			fmt.Fprintf(tw, "%d\t%d\t%.2f%%\t\n", bucket.GetPassengerCount(), bucket.GetTrips(), 100*bucket.GetShare())
+		}
+		fmt.Fprintf(tw, "total\t%d\t\t\n", distribution.GetTotalTrips())
+		fmt.Fprintf(tw, "invalid\t%d\t%.2f%%\t\n", distribution.GetInvalidTrips(), 100*distribution.GetInvalidShare())
+		fmt.Fprintf(tw, "\t\t\t\n")
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package flags
+
+import (
+	"fmt"
+	"strings"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+// ParseHolidayFilter parses the value of the holidays flag shared by the clients: include, tag or exclude
+func ParseHolidayFilter(value string) (pbdata.HolidayFilter, error) {
+	filter, found := pbdata.HolidayFilter_value[strings.ToUpper(value)+"_HOLIDAYS"]
+	if !found {
+		return pbdata.HolidayFilter_INCLUDE_HOLIDAYS, fmt.Errorf("invalid holidays [%s], expecting include, tag or exclude", value)
+	}
+	return pbdata.HolidayFilter(filter), nil
+}
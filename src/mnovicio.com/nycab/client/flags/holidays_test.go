@@ -0,0 +1,28 @@
+package flags
+
+import (
+	"testing"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+func TestParseHolidayFilter(t *testing.T) {
+	tests := []struct {
+		value string
+		want  pbdata.HolidayFilter
+		valid bool
+	}{
+		{"include", pbdata.HolidayFilter_INCLUDE_HOLIDAYS, true},
+		{"TAG", pbdata.HolidayFilter_TAG_HOLIDAYS, true},
+		{"Exclude", pbdata.HolidayFilter_EXCLUDE_HOLIDAYS, true},
+		{"skip", pbdata.HolidayFilter_INCLUDE_HOLIDAYS, false},
+		{"", pbdata.HolidayFilter_INCLUDE_HOLIDAYS, false},
+	}
+
+	for _, test := range tests {
+		filter, err := ParseHolidayFilter(test.value)
+		if filter != test.want || (err == nil) != test.valid {
+			t.Errorf("ParseHolidayFilter(%q) = %s, %v, want %s, valid %t", test.value, filter, err, test.want, test.valid)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/spf13/cobra"
+
+	"mnovicio.com/nycab/client/export"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.PersistentFlags().StringP("params", "", "{}", "query parameters as JSON, same as the body of the query REST endpoint")
+	exportCmd.PersistentFlags().StringP("format", "", "csv", "file format: csv or parquet")
+	exportCmd.PersistentFlags().StringP("output", "o", "", "output file, <query>.<format> if empty")
+	exportCmd.PersistentFlags().StringP("server-file", "", "", "file name to write in the export directory of the server instead of streaming the file back")
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export <query>",
+	Short: "Exports the result of an aggregation query as a CSV or Parquet file",
+	Long: `Exports the result of an aggregation query as a CSV or Parquet file, CSV files are written with a .schema.json file describing their columns
+Queries: all_cab_trips, all_driver_trips, pickup_heatmap, origin_destination_matrix, cab_utilization, trip_patterns,
+count_anomalies, forecast, passenger_counts, vendor_stats, taxi_zone_trip_counts, cab_zone_coverage, cab_revenue, tip_rates, payment_type_mix
+Example: ./ny_cab_client_grpc export cab_revenue --params='{"start_date": "2013-01-01", "end_date": "2013-01-31"}' --format=parquet --output=revenue.parquet`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("export gRPC started at %s", now)
+		defer trackTime(now, "export gRPC")
+		params, _ := cmd.Flags().GetString("params")
+		format, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+		serverFile, _ := cmd.Flags().GetString("server-file")
+
+		exportFormat, found := pbsvc.ExportFormat_value[strings.ToUpper(format)]
+		if !found {
+			log.Fatalf("invalid format [%s], expecting csv or parquet", format)
+		}
+		if output == "" {
+			output = args[0] + "." + strings.ToLower(format)
+		}
+
+		request := &pbsvc.ExportQueryRequestV1{}
+		if err := jsonpb.UnmarshalString(fmt.Sprintf(`{"%s": %s}`, args[0], params), request); err != nil {
+			log.Fatalf("invalid query [%s] or params: %v", args[0], err)
+		}
+		request.Format = pbsvc.ExportFormat(exportFormat)
+		request.FileName = serverFile
+
+		nyCabClient := dialServer(cmd)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
+		defer cancel()
+
+		stream, err := nyCabClient.ExportQueryV1(ctx, request)
+		if err != nil {
+			log.Fatalf("Failed calling ExportQueryV1 RPC: %v", err)
+		}
+
+		if serverFile != "" {
+			response, err := stream.Recv()
+			if err != nil {
+				log.Fatalf("Failed receiving ExportQueryV1 response: %v", err)
+			}
+			if response.Error != "" {
+				log.Fatalf("ExportQueryV1 returned error: %s", response.Error)
+			}
+			log.Printf("ExportQueryV1 wrote [%s] on the server", response.Path)
+			if err := export.WriteQueryExportSchema(os.Stdout, response.Schema); err != nil {
+				log.Fatalf("failed to print ExportQueryV1 schema: %v", err)
+			}
+			return
+		}
+
+		schema, err := export.WriteQueryExport(output, request.Format, stream.Recv)
+		if err != nil {
+			log.Fatalf("failed to write [%s]: %v", output, err)
+		}
+
+		log.Printf("ExportQueryV1 wrote [%s]", output)
+		if err := export.WriteQueryExportSchema(os.Stdout, schema); err != nil {
+			log.Fatalf("failed to print ExportQueryV1 schema: %v", err)
+		}
+	},
+}
@@ -7,12 +7,15 @@ import (
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+
+	"mnovicio.com/nycab/client/flags"
 	pbsvc "mnovicio.com/nycab/protocol/rpc"
 )
 
 func init() {
 	rootCmd.AddCommand(getAllCabTrips)
 	getAllCabTrips.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+	getAllCabTrips.PersistentFlags().StringP("holidays", "", "include", "include, tag or exclude holiday dates")
 }
 
 var getAllCabTrips = &cobra.Command{
@@ -25,6 +28,11 @@ var getAllCabTrips = &cobra.Command{
 		defer trackTime(now, "getAllCabTrips gRPC")
 		server, _ := cmd.Flags().GetString("server")
 		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+		holidays, _ := cmd.Flags().GetString("holidays")
+		holidayFilter, err := flags.ParseHolidayFilter(holidays)
+		if err != nil {
+			log.Fatal(err)
+		}
 
 		log.Printf("Dialing gRPC server: %s", server)
 		conn, err := grpc.Dial(server, grpc.WithInsecure())
@@ -38,7 +46,8 @@ var getAllCabTrips = &cobra.Command{
 		defer cancel()
 
 		request := &pbsvc.GetAllCabTripsRequestV1{
-			IgnoreCache: ignoreCache,
+			IgnoreCache:   ignoreCache,
+			HolidayFilter: holidayFilter,
 		}
 
 		response, err := nyCabClient.GetAllCabTripCountPerDayV1(ctx, request)
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"mnovicio.com/nycab/client/export"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(getODMatrix)
+	getODMatrix.PersistentFlags().Uint32P("geohash-precision", "", 0, "geohash precision (1 to 12), either geohash-precision or grid-size must be set")
+	getODMatrix.PersistentFlags().Float64P("grid-size", "", 0.01, "grid cell size in degrees, ignored if geohash-precision is set")
+	getODMatrix.PersistentFlags().StringP("start-time", "", "2013-12-01 00:00:00", "pickup time lower bound (inclusive)")
+	getODMatrix.PersistentFlags().StringP("end-time", "", "2013-12-02 00:00:00", "pickup time upper bound (exclusive)")
+	getODMatrix.PersistentFlags().StringP("output", "o", "od_matrix.csv", "CSV output file")
+	getODMatrix.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+}
+
+var getODMatrix = &cobra.Command{
+	Use:   "get-od-matrix",
+	Short: "Writes trip counts between pickup and dropoff cells as CSV",
+	Long: `Writes trip counts and average trip duration between pickup and dropoff cells as CSV
+Example: ./ny_cab_client_grpc get-od-matrix --geohash-precision=5 --start-time="2013-12-01 08:00:00" --end-time="2013-12-01 10:00:00" --output=od_matrix.csv`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getODMatrix gRPC started at %s", now)
+		defer trackTime(now, "getODMatrix gRPC")
+		server, _ := cmd.Flags().GetString("server")
+		geohashPrecision, _ := cmd.Flags().GetUint32("geohash-precision")
+		gridSize, _ := cmd.Flags().GetFloat64("grid-size")
+		startTime, _ := cmd.Flags().GetString("start-time")
+		endTime, _ := cmd.Flags().GetString("end-time")
+		output, _ := cmd.Flags().GetString("output")
+		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+
+		log.Printf("Dialing gRPC server: %s", server)
+		conn, err := grpc.Dial(server, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("Unable to connect to NY CAB gRPC server at [%s]", server)
+		}
+
+		nyCabClient := pbsvc.NewNYCabServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
+		defer cancel()
+
+		if geohashPrecision > 0 {
+			gridSize = 0
+		}
+
+		request := &pbsvc.GetOriginDestinationMatrixRequestV1{
+			StartTime:        startTime,
+			EndTime:          endTime,
+			GeohashPrecision: geohashPrecision,
+			GridSize:         gridSize,
+			IgnoreCache:      ignoreCache,
+		}
+
+		response, err := nyCabClient.GetOriginDestinationMatrixV1(ctx, request)
+		if err != nil {
+			log.Fatalf("Failed calling GetOriginDestinationMatrixV1 RPC from %s", server)
+		}
+
+		if response.Error != "" {
+			log.Fatalf("GetOriginDestinationMatrixV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WriteODMatrixCSV(output, response.Entries); err != nil {
+			log.Fatalf("failed to write CSV to [%s]: %v", output, err)
+		}
+
+		log.Printf("GetOriginDestinationMatrixV1 wrote %d entries to [%s]", len(response.Entries), output)
+	},
+}
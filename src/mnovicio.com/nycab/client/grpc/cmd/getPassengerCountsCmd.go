@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"mnovicio.com/nycab/client/export"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(getPassengerCounts)
+	getPassengerCounts.PersistentFlags().StringSliceP("cab-ids", "", []string{}, "list of cab IDs to fetch, fleet only if empty")
+	getPassengerCounts.PersistentFlags().StringP("start-date", "", "2013-12-01", "first pickup date (inclusive)")
+	getPassengerCounts.PersistentFlags().StringP("end-date", "", "2013-12-31", "last pickup date (inclusive)")
+	getPassengerCounts.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+}
+
+var getPassengerCounts = &cobra.Command{
+	Use:   "get-passenger-counts",
+	Short: "Prints the distribution of passenger counts as a table",
+	Long: `Prints the number and share of trips per passenger count of the whole fleet and of each cab as a table,
+including the trips with an invalid passenger count (0 or above 6)
+Example: ./ny_cab_client_grpc get-passenger-counts --cab-ids="cab1,cab2" --start-date="2013-12-01" --end-date="2013-12-31"`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getPassengerCounts gRPC started at %s", now)
+		defer trackTime(now, "getPassengerCounts gRPC")
+		server, _ := cmd.Flags().GetString("server")
+		cabIds, _ := cmd.Flags().GetStringSlice("cab-ids")
+		startDate, _ := cmd.Flags().GetString("start-date")
+		endDate, _ := cmd.Flags().GetString("end-date")
+		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+
+		log.Printf("Dialing gRPC server: %s", server)
+		conn, err := grpc.Dial(server, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("Unable to connect to NY CAB gRPC server at [%s]", server)
+		}
+
+		nyCabClient := pbsvc.NewNYCabServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
+		defer cancel()
+
+		request := &pbsvc.GetPassengerCountsRequestV1{
+			CabIds:      cabIds,
+			StartDate:   startDate,
+			EndDate:     endDate,
+			IgnoreCache: ignoreCache,
+		}
+
+		response, err := nyCabClient.GetPassengerCountsV1(ctx, request)
+		if err != nil {
+			log.Fatalf("Failed calling GetPassengerCountsV1 RPC from %s", server)
+		}
+
+		if response.Error != "" {
+			log.Fatalf("GetPassengerCountsV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WritePassengerCountsTable(os.Stdout, response.Fleet, response.Cabs); err != nil {
+			log.Fatalf("failed to print GetPassengerCountsV1 response: %v", err)
+		}
+	},
+}
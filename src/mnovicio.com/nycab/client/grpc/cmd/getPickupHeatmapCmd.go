@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"mnovicio.com/nycab/client/export"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(getPickupHeatmap)
+	getPickupHeatmap.PersistentFlags().Uint32P("precision", "", 6, "geohash precision (1 to 12)")
+	getPickupHeatmap.PersistentFlags().StringP("start-time", "", "2013-12-01 00:00:00", "pickup time lower bound (inclusive)")
+	getPickupHeatmap.PersistentFlags().StringP("end-time", "", "2013-12-02 00:00:00", "pickup time upper bound (exclusive)")
+	getPickupHeatmap.PersistentFlags().StringP("output", "o", "heatmap.geojson", "GeoJSON output file")
+	getPickupHeatmap.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+}
+
+var getPickupHeatmap = &cobra.Command{
+	Use:   "get-pickup-heatmap",
+	Short: "Writes pickup density per geohash cell as GeoJSON",
+	Long: `Writes pickup density per geohash cell as GeoJSON
+Example: ./ny_cab_client_grpc get-pickup-heatmap --precision=6 --start-time="2013-12-01 08:00:00" --end-time="2013-12-01 10:00:00" --output=heatmap.geojson`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getPickupHeatmap gRPC started at %s", now)
+		defer trackTime(now, "getPickupHeatmap gRPC")
+		server, _ := cmd.Flags().GetString("server")
+		precision, _ := cmd.Flags().GetUint32("precision")
+		startTime, _ := cmd.Flags().GetString("start-time")
+		endTime, _ := cmd.Flags().GetString("end-time")
+		output, _ := cmd.Flags().GetString("output")
+		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+
+		log.Printf("Dialing gRPC server: %s", server)
+		conn, err := grpc.Dial(server, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("Unable to connect to NY CAB gRPC server at [%s]", server)
+		}
+
+		nyCabClient := pbsvc.NewNYCabServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
+		defer cancel()
+
+		request := &pbsvc.GetPickupHeatmapRequestV1{
+			Precision:   precision,
+			StartTime:   startTime,
+			EndTime:     endTime,
+			IgnoreCache: ignoreCache,
+		}
+
+		response, err := nyCabClient.GetPickupHeatmapV1(ctx, request)
+		if err != nil {
+			log.Fatalf("Failed calling GetPickupHeatmapV1 RPC from %s", server)
+		}
+
+		if response.Error != "" {
+			log.Fatalf("GetPickupHeatmapV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WriteHeatmapGeoJSON(output, response.Cells); err != nil {
+			log.Fatalf("failed to write GeoJSON to [%s]: %v", output, err)
+		}
+
+		log.Printf("GetPickupHeatmapV1 wrote %d cells to [%s]", len(response.Cells), output)
+	},
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(getShifts)
+	getShifts.PersistentFlags().StringP("cab-id", "", "D7D598CD99978BD012A87A76A7C891B7", "cab ID to reconstruct the shifts of")
+	getShifts.PersistentFlags().StringP("pickup-date", "", "2013-12-01", "pickup date")
+	getShifts.PersistentFlags().Uint32P("max-idle-minutes", "", 60, "idle gap between trips starting a new shift")
+}
+
+var getShifts = &cobra.Command{
+	Use:   "get-shifts",
+	Short: "Prints the shifts of a cab on given pickup date",
+	Long: `Prints the shifts of a cab on given pickup date
+Example: ./ny_cab_client_grpc get-shifts --cab-id="cab1" --pickup-date="2013-12-01" --max-idle-minutes=60`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getShifts gRPC started at %s", now)
+		defer trackTime(now, "getShifts gRPC")
+		server, _ := cmd.Flags().GetString("server")
+		cabID, _ := cmd.Flags().GetString("cab-id")
+		if cabID == "" {
+			log.Fatal("missing cab-id")
+		}
+		pickUpDate, _ := cmd.Flags().GetString("pickup-date")
+		if pickUpDate == "" {
+			log.Fatal("missing pickup-date")
+		}
+		maxIdleMinutes, _ := cmd.Flags().GetUint32("max-idle-minutes")
+
+		log.Printf("Dialing gRPC server: %s", server)
+		conn, err := grpc.Dial(server, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("Unable to connect to NY CAB gRPC server at [%s]", server)
+		}
+
+		nyCabClient := pbsvc.NewNYCabServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
+		defer cancel()
+
+		request := &pbsvc.GetCabShiftsRequestV1{
+			CabId:          cabID,
+			PickupDate:     pickUpDate,
+			MaxIdleMinutes: maxIdleMinutes,
+		}
+
+		response, err := nyCabClient.GetCabShiftsV1(ctx, request)
+		if err != nil {
+			log.Fatalf("Failed calling GetCabShiftsV1 RPC from %s", server)
+		}
+
+		log.Printf("GetCabShiftsV1 response=[%+v]", response)
+	},
+}
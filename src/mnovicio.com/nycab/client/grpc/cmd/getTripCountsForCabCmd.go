@@ -7,6 +7,8 @@ import (
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+
+	"mnovicio.com/nycab/client/flags"
 	pbsvc "mnovicio.com/nycab/protocol/rpc"
 )
 
@@ -15,13 +17,14 @@ func init() {
 	getTripCountsForCab.PersistentFlags().StringSliceP("cab-ids", "", []string{"D7D598CD99978BD012A87A76A7C891B7", "42D815590CE3A33F3A23DBF145EE66E3"}, "list of cab IDs to fetch")
 	getTripCountsForCab.PersistentFlags().StringP("pickup-date", "", "2013-12-01", "pickup date")
 	getTripCountsForCab.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+	getTripCountsForCab.PersistentFlags().StringP("holidays", "", "include", "include, tag or exclude holiday dates")
 }
 
 var getTripCountsForCab = &cobra.Command{
 	Use:   "get-trip-counts-for-cab",
 	Short: "Prints cab trip count on given pickup date",
 	Long: `Prints cab trip count on given pickup date
-Example: ./ny_cab_client_grpc get-trip-counts-for-cab --cab-ids="cab1,cab2" --pickup-date="2013-12-01" --ignore-cache=true`,
+Example: ./ny_cab_client_grpc get-trip-counts-for-cab --cab-ids="cab1,cab2" --pickup-date="2013-12-01" --ignore-cache=true --holidays=tag`,
 	Run: func(cmd *cobra.Command, args []string) {
 		now := time.Now()
 		log.Printf("getTripCountsForCab gRPC started at %s", now)
@@ -38,6 +41,11 @@ Example: ./ny_cab_client_grpc get-trip-counts-for-cab --cab-ids="cab1,cab2" --pi
 		}
 
 		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+		holidays, _ := cmd.Flags().GetString("holidays")
+		holidayFilter, err := flags.ParseHolidayFilter(holidays)
+		if err != nil {
+			log.Fatal(err)
+		}
 
 		log.Printf("Dialing gRPC server: %s", server)
 		conn, err := grpc.Dial(server, grpc.WithInsecure())
@@ -51,9 +59,10 @@ Example: ./ny_cab_client_grpc get-trip-counts-for-cab --cab-ids="cab1,cab2" --pi
 		defer cancel()
 
 		request := &pbsvc.GetTripCountsForCabIDsRequestV1{
-			CabIds:      cabIds,
-			PickupDate:  pickUpDate,
-			IgnoreCache: ignoreCache,
+			CabIds:        cabIds,
+			PickupDate:    pickUpDate,
+			IgnoreCache:   ignoreCache,
+			HolidayFilter: holidayFilter,
 		}
 
 		response, err := nyCabClient.GetTripCountsForCabIDsV1(ctx, request)
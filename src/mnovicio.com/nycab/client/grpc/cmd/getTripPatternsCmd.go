@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"mnovicio.com/nycab/client/export"
+	"mnovicio.com/nycab/client/flags"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(getTripPatterns)
+	getTripPatterns.PersistentFlags().StringSliceP("cab-ids", "", []string{}, "list of cab IDs to fetch, whole fleet if empty")
+	getTripPatterns.PersistentFlags().StringP("start-date", "", "2013-12-01", "first pickup date (inclusive)")
+	getTripPatterns.PersistentFlags().StringP("end-date", "", "2013-12-31", "last pickup date (inclusive)")
+	getTripPatterns.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+	getTripPatterns.PersistentFlags().StringP("holidays", "", "include", "include, tag or exclude holiday dates")
+}
+
+var getTripPatterns = &cobra.Command{
+	Use:   "get-trip-patterns",
+	Short: "Prints trip counts by day of week and by month as a table",
+	Long: `Prints daily trip count totals, means and standard deviations by day of week and by month as a table
+Example: ./ny_cab_client_grpc get-trip-patterns --cab-ids="cab1,cab2" --start-date="2013-12-01" --end-date="2013-12-31" --holidays=exclude`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getTripPatterns gRPC started at %s", now)
+		defer trackTime(now, "getTripPatterns gRPC")
+		server, _ := cmd.Flags().GetString("server")
+		cabIds, _ := cmd.Flags().GetStringSlice("cab-ids")
+		startDate, _ := cmd.Flags().GetString("start-date")
+		endDate, _ := cmd.Flags().GetString("end-date")
+		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+		holidays, _ := cmd.Flags().GetString("holidays")
+		holidayFilter, err := flags.ParseHolidayFilter(holidays)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		log.Printf("Dialing gRPC server: %s", server)
+		conn, err := grpc.Dial(server, grpc.WithInsecure())
+		if err != nil {
+			log.Fatalf("Unable to connect to NY CAB gRPC server at [%s]", server)
+		}
+
+		nyCabClient := pbsvc.NewNYCabServiceClient(conn)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
+		defer cancel()
+
+		request := &pbsvc.GetTripPatternsRequestV1{
+			CabIds:        cabIds,
+			StartDate:     startDate,
+			EndDate:       endDate,
+			IgnoreCache:   ignoreCache,
+			HolidayFilter: holidayFilter,
+		}
+
+		response, err := nyCabClient.GetTripPatternsV1(ctx, request)
+		if err != nil {
+			log.Fatalf("Failed calling GetTripPatternsV1 RPC from %s", server)
+		}
+
+		if response.Error != "" {
+			log.Fatalf("GetTripPatternsV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WriteTripPatternsTable(os.Stdout, response.Patterns); err != nil {
+			log.Fatalf("failed to print GetTripPatternsV1 response: %v", err)
+		}
+	},
+}
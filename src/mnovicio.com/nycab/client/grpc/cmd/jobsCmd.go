@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"mnovicio.com/nycab/client/export"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(jobsCmd)
+	jobsCmd.AddCommand(submitJobCmd, getJobCmd, cancelJobCmd)
+	submitJobCmd.PersistentFlags().StringP("params", "", "{}", "query parameters as JSON, same as the body of the query REST endpoint")
+	getJobCmd.PersistentFlags().BoolP("wait", "", false, "poll the job until it ends")
+	getJobCmd.PersistentFlags().DurationP("poll-interval", "", 2*time.Second, "time between polls when waiting")
+}
+
+var jobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "Runs queries as background jobs on the server",
+	Long: `Runs long queries as background jobs on the server, then polls or cancels them
+Queries: all_cab_trips, all_driver_trips, batch_trip_counts, pickup_heatmap, origin_destination_matrix, cab_utilization,
+trip_patterns, count_anomalies, forecast, passenger_counts, vendor_stats, taxi_zone_trip_counts, cab_zone_coverage,
+cab_revenue, tip_rates, payment_type_mix`,
+}
+
+// dialServer connects to the gRPC server set by the server flag
+func dialServer(cmd *cobra.Command) pbsvc.NYCabServiceClient {
+	server, _ := cmd.Flags().GetString("server")
+
+	log.Printf("Dialing gRPC server: %s", server)
+	conn, err := grpc.Dial(server, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("Unable to connect to NY CAB gRPC server at [%s]", server)
+	}
+
+	return pbsvc.NewNYCabServiceClient(conn)
+}
+
+var submitJobCmd = &cobra.Command{
+	Use:   "submit <query>",
+	Short: "Submits a query job and prints its job ID",
+	Long: `Submits a query job and prints its job ID
+Example: ./ny_cab_client_grpc jobs submit trip_patterns --params='{"start_date": "2013-01-01", "end_date": "2013-12-31"}'`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("submitJob gRPC started at %s", now)
+		defer trackTime(now, "submitJob gRPC")
+		params, _ := cmd.Flags().GetString("params")
+
+		request := &pbsvc.SubmitQueryJobRequestV1{}
+		if err := jsonpb.UnmarshalString(fmt.Sprintf(`{"%s": %s}`, args[0], params), request); err != nil {
+			log.Fatalf("invalid query [%s] or params: %v", args[0], err)
+		}
+
+		nyCabClient := dialServer(cmd)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
+		defer cancel()
+
+		response, err := nyCabClient.SubmitQueryJobV1(ctx, request)
+		if err != nil {
+			log.Fatalf("Failed calling SubmitQueryJobV1 RPC: %v", err)
+		}
+
+		if response.Error != "" {
+			log.Fatalf("SubmitQueryJobV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WriteQueryJob(os.Stdout, response.Job); err != nil {
+			log.Fatalf("failed to print SubmitQueryJobV1 response: %v", err)
+		}
+	},
+}
+
+var getJobCmd = &cobra.Command{
+	Use:   "get <job-id>",
+	Short: "Prints the state of a query job, and its result once it has succeeded",
+	Long: `Prints the state and progress of a query job, and its result once it has succeeded
+Example: ./ny_cab_client_grpc jobs get 9ebef369aeaf9b7b2b1d6d4e051623b5 --wait`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getJob gRPC started at %s", now)
+		defer trackTime(now, "getJob gRPC")
+		wait, _ := cmd.Flags().GetBool("wait")
+		pollInterval, _ := cmd.Flags().GetDuration("poll-interval")
+
+		nyCabClient := dialServer(cmd)
+		request := &pbsvc.GetQueryJobRequestV1{
+			JobId: args[0],
+		}
+
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
+			response, err := nyCabClient.GetQueryJobV1(ctx, request)
+			cancel()
+			if err != nil {
+				log.Fatalf("Failed calling GetQueryJobV1 RPC: %v", err)
+			}
+
+			if response.Error != "" {
+				log.Fatalf("GetQueryJobV1 returned error: %s", response.Error)
+			}
+
+			if !wait || export.QueryJobEnded(response.Job) {
+				if err := export.WriteQueryJob(os.Stdout, response.Job); err != nil {
+					log.Fatalf("failed to print GetQueryJobV1 response: %v", err)
+				}
+				return
+			}
+
+			log.Printf("job %s is %s, %.0f%% done", args[0], response.Job.State, response.Job.Progress*100)
+			time.Sleep(pollInterval)
+		}
+	},
+}
+
+var cancelJobCmd = &cobra.Command{
+	Use:   "cancel <job-id>",
+	Short: "Cancels a pending or running query job",
+	Long: `Cancels a pending or running query job, aborting its running DB queries
+Example: ./ny_cab_client_grpc jobs cancel 9ebef369aeaf9b7b2b1d6d4e051623b5`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("cancelJob gRPC started at %s", now)
+		defer trackTime(now, "cancelJob gRPC")
+
+		nyCabClient := dialServer(cmd)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 300*time.Second)
+		defer cancel()
+
+		response, err := nyCabClient.CancelQueryJobV1(ctx, &pbsvc.CancelQueryJobRequestV1{
+			JobId: args[0],
+		})
+		if err != nil {
+			log.Fatalf("Failed calling CancelQueryJobV1 RPC: %v", err)
+		}
+
+		if response.Error != "" {
+			log.Fatalf("CancelQueryJobV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WriteQueryJob(os.Stdout, response.Job); err != nil {
+			log.Fatalf("failed to print CancelQueryJobV1 response: %v", err)
+		}
+	},
+}
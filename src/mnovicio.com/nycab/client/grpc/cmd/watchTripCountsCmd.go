@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(watchTripCounts)
+	watchTripCounts.PersistentFlags().StringSliceP("cab-ids", "", []string{"D7D598CD99978BD012A87A76A7C891B7", "42D815590CE3A33F3A23DBF145EE66E3"}, "list of cab IDs to watch, up to 100")
+	watchTripCounts.PersistentFlags().StringP("start-date", "", "2013-12-01", "first pickup date")
+	watchTripCounts.PersistentFlags().StringP("end-date", "", "2013-12-31", "last pickup date")
+	watchTripCounts.PersistentFlags().StringP("dataset", "", "yellow", "yellow, green or fhv trips")
+}
+
+var watchTripCounts = &cobra.Command{
+	Use:   "watch-trip-counts",
+	Short: "Prints the daily trip counts of cabs, then the counts that change until interrupted",
+	Long: `Prints the daily trip counts of cabs, then the counts that change when trips are imported or the cache is cleared or refreshed, until interrupted
+Example: ./ny_cab_client_grpc watch-trip-counts --cab-ids="cab1,cab2" --start-date="2013-12-01" --end-date="2013-12-31" --dataset=green`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("watchTripCounts gRPC started at %s", now)
+		defer trackTime(now, "watchTripCounts gRPC")
+		cabIds, _ := cmd.Flags().GetStringSlice("cab-ids")
+		if len(cabIds) <= 0 {
+			log.Fatal("empty cab ID list")
+		}
+		startDate, _ := cmd.Flags().GetString("start-date")
+		endDate, _ := cmd.Flags().GetString("end-date")
+		dataset, _ := cmd.Flags().GetString("dataset")
+
+		datasetValue, found := pbdata.Dataset_value[strings.ToUpper(dataset)]
+		if !found {
+			log.Fatalf("invalid dataset [%s], expecting yellow, green or fhv", dataset)
+		}
+
+		nyCabClient := dialServer(cmd)
+
+		// no timeout, the server streams changes until the watch is interrupted
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := nyCabClient.WatchTripCountsV1(ctx, &pbsvc.WatchTripCountsRequestV1{
+			CabIds:    cabIds,
+			StartDate: startDate,
+			EndDate:   endDate,
+			Dataset:   pbdata.Dataset(datasetValue),
+		})
+		if err != nil {
+			log.Fatalf("Failed calling WatchTripCountsV1 RPC: %v", err)
+		}
+
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				log.Fatalf("Failed receiving WatchTripCountsV1 response: %v", err)
+			}
+			if response.Error != "" {
+				log.Fatalf("WatchTripCountsV1 returned error: %s", response.Error)
+			}
+			log.Printf("WatchTripCountsV1 %s: trip counts=[%+v]", response.Trigger, response.CabTripsPerDay)
+		}
+	},
+}
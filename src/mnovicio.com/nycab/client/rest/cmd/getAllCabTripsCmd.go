@@ -9,11 +9,14 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+
+	"mnovicio.com/nycab/client/flags"
 )
 
 func init() {
 	rootCmd.AddCommand(getAllCabTrips)
 	getAllCabTrips.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+	getAllCabTrips.PersistentFlags().StringP("holidays", "", "include", "include, tag or exclude holiday dates")
 }
 
 var getAllCabTrips = &cobra.Command{
@@ -26,15 +29,21 @@ var getAllCabTrips = &cobra.Command{
 		defer trackTime(now, "getAllCabTrips REST")
 		server, _ := cmd.Flags().GetString("server")
 		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+		holidays, _ := cmd.Flags().GetString("holidays")
+		holidayFilter, err := flags.ParseHolidayFilter(holidays)
+		if err != nil {
+			log.Fatal(err)
+		}
 
 		var body string
 
 		// Call GetAllCabTripCountPerDayV1
 		resp, err := http.Post(server+"/v1/cabtrips", "application/json", strings.NewReader(fmt.Sprintf(`
 			{
-				"ignore_cache": %t
+				"ignore_cache": %t,
+				"holiday_filter": "%s"
 			}
-		`, ignoreCache)))
+		`, ignoreCache, holidayFilter)))
 		if err != nil {
 			log.Fatalf("failed to call GetAllCabTripCountPerDayV1 method: %v", err)
 		}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"mnovicio.com/nycab/client/export"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(getODMatrix)
+	getODMatrix.PersistentFlags().Uint32P("geohash-precision", "", 0, "geohash precision (1 to 12), either geohash-precision or grid-size must be set")
+	getODMatrix.PersistentFlags().Float64P("grid-size", "", 0.01, "grid cell size in degrees, ignored if geohash-precision is set")
+	getODMatrix.PersistentFlags().StringP("start-time", "", "2013-12-01 00:00:00", "pickup time lower bound (inclusive)")
+	getODMatrix.PersistentFlags().StringP("end-time", "", "2013-12-02 00:00:00", "pickup time upper bound (exclusive)")
+	getODMatrix.PersistentFlags().StringP("output", "o", "od_matrix.csv", "CSV output file")
+	getODMatrix.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+}
+
+var getODMatrix = &cobra.Command{
+	Use:   "get-od-matrix",
+	Short: "Writes trip counts between pickup and dropoff cells as CSV",
+	Long: `Writes trip counts and average trip duration between pickup and dropoff cells as CSV
+Example: ./ny_cab_client_rest get-od-matrix --geohash-precision=5 --start-time="2013-12-01 08:00:00" --end-time="2013-12-01 10:00:00" --output=od_matrix.csv`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getODMatrix REST started at %s", now)
+		defer trackTime(now, "getODMatrix REST")
+		server, _ := cmd.Flags().GetString("server")
+		geohashPrecision, _ := cmd.Flags().GetUint32("geohash-precision")
+		gridSize, _ := cmd.Flags().GetFloat64("grid-size")
+		startTime, _ := cmd.Flags().GetString("start-time")
+		endTime, _ := cmd.Flags().GetString("end-time")
+		output, _ := cmd.Flags().GetString("output")
+		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+
+		if geohashPrecision > 0 {
+			gridSize = 0
+		}
+
+		// Call GetOriginDestinationMatrixV1
+		bodyRequest := fmt.Sprintf(`
+		{
+			"start_time": "%s",
+			"end_time": "%s",
+			"geohash_precision": %d,
+			"grid_size": %f,
+			"ignore_cache": %t
+		}`, startTime, endTime, geohashPrecision, gridSize, ignoreCache)
+
+		var response pbsvc.GetOriginDestinationMatrixResponseV1
+		postRPC(server+"/v1/cabtrips/odmatrix", "GetOriginDestinationMatrixV1", bodyRequest, &response)
+
+		if response.Error != "" {
+			log.Fatalf("GetOriginDestinationMatrixV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WriteODMatrixCSV(output, response.Entries); err != nil {
+			log.Fatalf("failed to write CSV to [%s]: %v", output, err)
+		}
+
+		log.Printf("GetOriginDestinationMatrixV1 wrote %d entries to [%s]", len(response.Entries), output)
+	},
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"mnovicio.com/nycab/client/export"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(getPassengerCounts)
+	getPassengerCounts.PersistentFlags().StringSliceP("cab-ids", "", []string{}, "list of cab IDs to fetch, fleet only if empty")
+	getPassengerCounts.PersistentFlags().StringP("start-date", "", "2013-12-01", "first pickup date (inclusive)")
+	getPassengerCounts.PersistentFlags().StringP("end-date", "", "2013-12-31", "last pickup date (inclusive)")
+	getPassengerCounts.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+}
+
+var getPassengerCounts = &cobra.Command{
+	Use:   "get-passenger-counts",
+	Short: "Prints the distribution of passenger counts as a table",
+	Long: `Prints the number and share of trips per passenger count of the whole fleet and of each cab as a table,
+including the trips with an invalid passenger count (0 or above 6)
+Example: ./ny_cab_client_rest get-passenger-counts --cab-ids="cab1,cab2" --start-date="2013-12-01" --end-date="2013-12-31"`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getPassengerCounts REST started at %s", now)
+		defer trackTime(now, "getPassengerCounts REST")
+		server, _ := cmd.Flags().GetString("server")
+		cabIds, _ := cmd.Flags().GetStringSlice("cab-ids")
+		startDate, _ := cmd.Flags().GetString("start-date")
+		endDate, _ := cmd.Flags().GetString("end-date")
+		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+
+		cabIdsJSON, _ := json.Marshal(cabIds)
+
+		// Call GetPassengerCountsV1
+		bodyRequest := fmt.Sprintf(`
+		{
+			"cab_ids": %s,
+			"start_date": "%s",
+			"end_date": "%s",
+			"ignore_cache": %t
+		}`, cabIdsJSON, startDate, endDate, ignoreCache)
+
+		var response pbsvc.GetPassengerCountsResponseV1
+		postRPC(server+"/v1/cabtrips/passengers", "GetPassengerCountsV1", bodyRequest, &response)
+
+		if response.Error != "" {
+			log.Fatalf("GetPassengerCountsV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WritePassengerCountsTable(os.Stdout, response.Fleet, response.Cabs); err != nil {
+			log.Fatalf("failed to print GetPassengerCountsV1 response: %v", err)
+		}
+	},
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"mnovicio.com/nycab/client/export"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(getPickupHeatmap)
+	getPickupHeatmap.PersistentFlags().Uint32P("precision", "", 6, "geohash precision (1 to 12)")
+	getPickupHeatmap.PersistentFlags().StringP("start-time", "", "2013-12-01 00:00:00", "pickup time lower bound (inclusive)")
+	getPickupHeatmap.PersistentFlags().StringP("end-time", "", "2013-12-02 00:00:00", "pickup time upper bound (exclusive)")
+	getPickupHeatmap.PersistentFlags().StringP("output", "o", "heatmap.geojson", "GeoJSON output file")
+	getPickupHeatmap.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+}
+
+var getPickupHeatmap = &cobra.Command{
+	Use:   "get-pickup-heatmap",
+	Short: "Writes pickup density per geohash cell as GeoJSON",
+	Long: `Writes pickup density per geohash cell as GeoJSON
+Example: ./ny_cab_client_rest get-pickup-heatmap --precision=6 --start-time="2013-12-01 08:00:00" --end-time="2013-12-01 10:00:00" --output=heatmap.geojson`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getPickupHeatmap REST started at %s", now)
+		defer trackTime(now, "getPickupHeatmap REST")
+		server, _ := cmd.Flags().GetString("server")
+		precision, _ := cmd.Flags().GetUint32("precision")
+		startTime, _ := cmd.Flags().GetString("start-time")
+		endTime, _ := cmd.Flags().GetString("end-time")
+		output, _ := cmd.Flags().GetString("output")
+		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+
+		// Call GetPickupHeatmapV1
+		bodyRequest := fmt.Sprintf(`
+		{
+			"precision": %d,
+			"start_time": "%s",
+			"end_time": "%s",
+			"ignore_cache": %t
+		}`, precision, startTime, endTime, ignoreCache)
+
+		var response pbsvc.GetPickupHeatmapResponseV1
+		postRPC(server+"/v1/cabtrips/heatmap", "GetPickupHeatmapV1", bodyRequest, &response)
+
+		if response.Error != "" {
+			log.Fatalf("GetPickupHeatmapV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WriteHeatmapGeoJSON(output, response.Cells); err != nil {
+			log.Fatalf("failed to write GeoJSON to [%s]: %v", output, err)
+		}
+
+		log.Printf("GetPickupHeatmapV1 wrote %d cells to [%s]", len(response.Cells), output)
+	},
+}
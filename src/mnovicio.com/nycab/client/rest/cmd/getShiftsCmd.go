@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(getShifts)
+	getShifts.PersistentFlags().StringP("cab-id", "", "D7D598CD99978BD012A87A76A7C891B7", "cab ID to reconstruct the shifts of")
+	getShifts.PersistentFlags().StringP("pickup-date", "", "2013-12-01", "pickup date")
+	getShifts.PersistentFlags().Uint32P("max-idle-minutes", "", 60, "idle gap between trips starting a new shift")
+}
+
+var getShifts = &cobra.Command{
+	Use:   "get-shifts",
+	Short: "Prints the shifts of a cab on given pickup date",
+	Long: `Prints the shifts of a cab on given pickup date
+Example: ./ny_cab_client_rest get-shifts --cab-id="cab1" --pickup-date="2013-12-01" --max-idle-minutes=60`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getShifts REST started at %s", now)
+		defer trackTime(now, "getShifts REST")
+		server, _ := cmd.Flags().GetString("server")
+		cabID, _ := cmd.Flags().GetString("cab-id")
+		if cabID == "" {
+			log.Fatal("missing cab-id")
+		}
+		pickUpDate, _ := cmd.Flags().GetString("pickup-date")
+		if pickUpDate == "" {
+			log.Fatal("missing pickup-date")
+		}
+		maxIdleMinutes, _ := cmd.Flags().GetUint32("max-idle-minutes")
+
+		// Call GetCabShiftsV1
+		bodyRequest := fmt.Sprintf(`
+		{
+			"cab_id": "%s",
+			"pickup_date": "%s",
+			"max_idle_minutes": %d
+		}`, cabID, pickUpDate, maxIdleMinutes)
+
+		var response pbsvc.GetCabShiftsResponseV1
+		postRPC(server+"/v1/cabshifts", "GetCabShiftsV1", bodyRequest, &response)
+
+		log.Printf("GetCabShiftsV1 response=[%+v]", &response)
+	},
+}
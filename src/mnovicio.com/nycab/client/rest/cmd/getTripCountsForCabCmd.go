@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+
+	"mnovicio.com/nycab/client/flags"
 )
 
 func init() {
@@ -16,13 +18,14 @@ func init() {
 	getTripCountsForCab.PersistentFlags().StringSliceP("cab-ids", "", []string{"D7D598CD99978BD012A87A76A7C891B7", "42D815590CE3A33F3A23DBF145EE66E3"}, "list of cab IDs to fetch")
 	getTripCountsForCab.PersistentFlags().StringP("pickup-date", "", "2013-12-01", "pickup date")
 	getTripCountsForCab.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+	getTripCountsForCab.PersistentFlags().StringP("holidays", "", "include", "include, tag or exclude holiday dates")
 }
 
 var getTripCountsForCab = &cobra.Command{
 	Use:   "get-trip-counts-for-cab",
 	Short: "Prints cab trip count on given pickup date",
 	Long: `Prints cab trip count on given pickup date
-Example: ./ny_cab_client_rest get-trip-counts-for-cab --cab-ids="cab1,cab2" --pickup-date="2013-12-01" --ignore-cache=true`,
+Example: ./ny_cab_client_rest get-trip-counts-for-cab --cab-ids="cab1,cab2" --pickup-date="2013-12-01" --ignore-cache=true --holidays=tag`,
 	Run: func(cmd *cobra.Command, args []string) {
 		now := time.Now()
 		log.Printf("getTripCountsForCab REST started at %s", now)
@@ -39,6 +42,11 @@ Example: ./ny_cab_client_rest get-trip-counts-for-cab --cab-ids="cab1,cab2" --pi
 		}
 
 		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+		holidays, _ := cmd.Flags().GetString("holidays")
+		holidayFilter, err := flags.ParseHolidayFilter(holidays)
+		if err != nil {
+			log.Fatal(err)
+		}
 
 		var body string
 
@@ -49,8 +57,9 @@ Example: ./ny_cab_client_rest get-trip-counts-for-cab --cab-ids="cab1,cab2" --pi
 		{
 			"cab_ids": [%s],
 			"pickup_date": "%s",
-			"ignore_cache": %t
-		}`, cbIDs, pickUpDate, ignoreCache)
+			"ignore_cache": %t,
+			"holiday_filter": "%s"
+		}`, cbIDs, pickUpDate, ignoreCache, holidayFilter)
 		log.Println("body request: ", bodyRequest)
 		resp, err := http.Post(server+"/v1/cabtrips/bypickupdate", "application/json", strings.NewReader(bodyRequest))
 		if err != nil {
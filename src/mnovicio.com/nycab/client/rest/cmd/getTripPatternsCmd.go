@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"mnovicio.com/nycab/client/export"
+	"mnovicio.com/nycab/client/flags"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(getTripPatterns)
+	getTripPatterns.PersistentFlags().StringSliceP("cab-ids", "", []string{}, "list of cab IDs to fetch, whole fleet if empty")
+	getTripPatterns.PersistentFlags().StringP("start-date", "", "2013-12-01", "first pickup date (inclusive)")
+	getTripPatterns.PersistentFlags().StringP("end-date", "", "2013-12-31", "last pickup date (inclusive)")
+	getTripPatterns.PersistentFlags().BoolP("ignore-cache", "", false, "Ignore cached data and force fetch DB")
+	getTripPatterns.PersistentFlags().StringP("holidays", "", "include", "include, tag or exclude holiday dates")
+}
+
+var getTripPatterns = &cobra.Command{
+	Use:   "get-trip-patterns",
+	Short: "Prints trip counts by day of week and by month as a table",
+	Long: `Prints daily trip count totals, means and standard deviations by day of week and by month as a table
+Example: ./ny_cab_client_rest get-trip-patterns --cab-ids="cab1,cab2" --start-date="2013-12-01" --end-date="2013-12-31" --holidays=exclude`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getTripPatterns REST started at %s", now)
+		defer trackTime(now, "getTripPatterns REST")
+		server, _ := cmd.Flags().GetString("server")
+		cabIds, _ := cmd.Flags().GetStringSlice("cab-ids")
+		startDate, _ := cmd.Flags().GetString("start-date")
+		endDate, _ := cmd.Flags().GetString("end-date")
+		ignoreCache, _ := cmd.Flags().GetBool("ignore-cache")
+		holidays, _ := cmd.Flags().GetString("holidays")
+		holidayFilter, err := flags.ParseHolidayFilter(holidays)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		cabIdsJSON, _ := json.Marshal(cabIds)
+
+		// Call GetTripPatternsV1
+		bodyRequest := fmt.Sprintf(`
+		{
+			"cab_ids": %s,
+			"start_date": "%s",
+			"end_date": "%s",
+			"ignore_cache": %t,
+			"holiday_filter": "%s"
+		}`, cabIdsJSON, startDate, endDate, ignoreCache, holidayFilter)
+
+		var response pbsvc.GetTripPatternsResponseV1
+		postRPC(server+"/v1/cabtrips/patterns", "GetTripPatternsV1", bodyRequest, &response)
+
+		if response.Error != "" {
+			log.Fatalf("GetTripPatternsV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WriteTripPatternsTable(os.Stdout, response.Patterns); err != nil {
+			log.Fatalf("failed to print GetTripPatternsV1 response: %v", err)
+		}
+	},
+}
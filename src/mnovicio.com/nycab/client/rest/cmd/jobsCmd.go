@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"mnovicio.com/nycab/client/export"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(jobsCmd)
+	jobsCmd.AddCommand(submitJobCmd, getJobCmd, cancelJobCmd)
+	submitJobCmd.PersistentFlags().StringP("params", "", "{}", "query parameters as JSON, same as the body of the query endpoint")
+	getJobCmd.PersistentFlags().BoolP("wait", "", false, "poll the job until it ends")
+	getJobCmd.PersistentFlags().DurationP("poll-interval", "", 2*time.Second, "time between polls when waiting")
+}
+
+var jobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "Runs queries as background jobs on the server",
+	Long: `Runs long queries as background jobs on the server, then polls or cancels them
+Queries: all_cab_trips, all_driver_trips, batch_trip_counts, pickup_heatmap, origin_destination_matrix, cab_utilization,
+trip_patterns, count_anomalies, forecast, passenger_counts, vendor_stats, taxi_zone_trip_counts, cab_zone_coverage,
+cab_revenue, tip_rates, payment_type_mix`,
+}
+
+var submitJobCmd = &cobra.Command{
+	Use:   "submit <query>",
+	Short: "Submits a query job and prints its job ID",
+	Long: `Submits a query job and prints its job ID
+Example: ./ny_cab_client_rest jobs submit trip_patterns --params='{"start_date": "2013-01-01", "end_date": "2013-12-31"}'`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("submitJob REST started at %s", now)
+		defer trackTime(now, "submitJob REST")
+		server, _ := cmd.Flags().GetString("server")
+		params, _ := cmd.Flags().GetString("params")
+
+		// Call SubmitQueryJobV1
+		bodyRequest := fmt.Sprintf(`{"%s": %s}`, args[0], params)
+
+		var response pbsvc.SubmitQueryJobResponseV1
+		postRPC(server+"/v1/jobs", "SubmitQueryJobV1", bodyRequest, &response)
+
+		if response.Error != "" {
+			log.Fatalf("SubmitQueryJobV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WriteQueryJob(os.Stdout, response.Job); err != nil {
+			log.Fatalf("failed to print SubmitQueryJobV1 response: %v", err)
+		}
+	},
+}
+
+var getJobCmd = &cobra.Command{
+	Use:   "get <job-id>",
+	Short: "Prints the state of a query job, and its result once it has succeeded",
+	Long: `Prints the state and progress of a query job, and its result once it has succeeded
+Example: ./ny_cab_client_rest jobs get 9ebef369aeaf9b7b2b1d6d4e051623b5 --wait`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("getJob REST started at %s", now)
+		defer trackTime(now, "getJob REST")
+		server, _ := cmd.Flags().GetString("server")
+		wait, _ := cmd.Flags().GetBool("wait")
+		pollInterval, _ := cmd.Flags().GetDuration("poll-interval")
+
+		for {
+			// Call GetQueryJobV1
+			var response pbsvc.GetQueryJobResponseV1
+			getRPC(server+"/v1/jobs/"+args[0], "GetQueryJobV1", &response)
+
+			if response.Error != "" {
+				log.Fatalf("GetQueryJobV1 returned error: %s", response.Error)
+			}
+
+			if !wait || export.QueryJobEnded(response.Job) {
+				if err := export.WriteQueryJob(os.Stdout, response.Job); err != nil {
+					log.Fatalf("failed to print GetQueryJobV1 response: %v", err)
+				}
+				return
+			}
+
+			log.Printf("job %s is %s, %.0f%% done", args[0], response.Job.State, response.Job.Progress*100)
+			time.Sleep(pollInterval)
+		}
+	},
+}
+
+var cancelJobCmd = &cobra.Command{
+	Use:   "cancel <job-id>",
+	Short: "Cancels a pending or running query job",
+	Long: `Cancels a pending or running query job, aborting its running DB queries
+Example: ./ny_cab_client_rest jobs cancel 9ebef369aeaf9b7b2b1d6d4e051623b5`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("cancelJob REST started at %s", now)
+		defer trackTime(now, "cancelJob REST")
+		server, _ := cmd.Flags().GetString("server")
+
+		// Call CancelQueryJobV1
+		var response pbsvc.CancelQueryJobResponseV1
+		postRPC(server+"/v1/jobs/"+args[0]+"/cancel", "CancelQueryJobV1", "{}", &response)
+
+		if response.Error != "" {
+			log.Fatalf("CancelQueryJobV1 returned error: %s", response.Error)
+		}
+
+		if err := export.WriteQueryJob(os.Stdout, response.Job); err != nil {
+			log.Fatalf("failed to print CancelQueryJobV1 response: %v", err)
+		}
+	},
+}
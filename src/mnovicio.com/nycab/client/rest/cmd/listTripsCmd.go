@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(listTrips)
+	listTrips.PersistentFlags().StringP("cab-id", "", "D7D598CD99978BD012A87A76A7C891B7", "cab ID to list the trips of")
+	listTrips.PersistentFlags().StringP("start-time", "", "2013-12-01 00:00:00", "pickup time lower bound (inclusive)")
+	listTrips.PersistentFlags().StringP("end-time", "", "2013-12-02 00:00:00", "pickup time upper bound (exclusive)")
+	listTrips.PersistentFlags().Uint32P("page-size", "", 100, "number of trips per page (up to 1000)")
+	listTrips.PersistentFlags().StringP("page-token", "", "", "next page token returned by the previous page")
+	listTrips.PersistentFlags().StringSliceP("fields", "", []string{}, "trip fields to return, all fields if empty")
+}
+
+var listTrips = &cobra.Command{
+	Use:   "list-trips",
+	Short: "Prints the trips of a cab within a time range",
+	Long: `Prints the trips of a cab within a time range, one page at a time
+Example: ./ny_cab_client_rest list-trips --cab-id="cab1" --start-time="2013-12-01" --end-time="2013-12-02" --page-size=50 --fields="pickup_time,trip_distance"`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("listTrips REST started at %s", now)
+		defer trackTime(now, "listTrips REST")
+		server, _ := cmd.Flags().GetString("server")
+		cabID, _ := cmd.Flags().GetString("cab-id")
+		if cabID == "" {
+			log.Fatal("missing cab-id")
+		}
+		startTime, _ := cmd.Flags().GetString("start-time")
+		endTime, _ := cmd.Flags().GetString("end-time")
+		pageSize, _ := cmd.Flags().GetUint32("page-size")
+		pageToken, _ := cmd.Flags().GetString("page-token")
+		fields, _ := cmd.Flags().GetStringSlice("fields")
+
+		fieldsJSON, _ := json.Marshal(fields)
+
+		// Call ListTripsV1
+		bodyRequest := fmt.Sprintf(`
+		{
+			"cab_id": "%s",
+			"start_time": "%s",
+			"end_time": "%s",
+			"page_size": %d,
+			"page_token": "%s",
+			"fields": %s
+		}`, cabID, startTime, endTime, pageSize, pageToken, fieldsJSON)
+
+		var response pbsvc.ListTripsResponseV1
+		postRPC(server+"/v1/cabtrips/list", "ListTripsV1", bodyRequest, &response)
+
+		log.Printf("ListTripsV1 response=[%+v]", &response)
+	},
+}
@@ -1,10 +1,17 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"strings"
 	"time"
 
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -56,3 +63,78 @@ func trackTime(start time.Time, name string) {
 
 	log.Printf("%s took %s", name, elapsed)
 }
+
+// postRPC posts bodyRequest to the REST endpoint of the RPC and unmarshals the JSON response into response
+func postRPC(url, rpcName, bodyRequest string, response proto.Message) {
+	log.Println("body request: ", bodyRequest)
+	resp, err := http.Post(url, "application/json", strings.NewReader(bodyRequest))
+	if err != nil {
+		log.Fatalf("failed to call %s method: %v", rpcName, err)
+	}
+	readRPCResponse(resp, rpcName, response)
+}
+
+// getRPC gets the REST endpoint of the RPC and unmarshals the JSON response into response
+func getRPC(url, rpcName string, response proto.Message) {
+	resp, err := http.Get(url)
+	if err != nil {
+		log.Fatalf("failed to call %s method: %v", rpcName, err)
+	}
+	readRPCResponse(resp, rpcName, response)
+}
+
+// readRPCResponse unmarshals the JSON body of a REST response into response
+func readRPCResponse(resp *http.Response, rpcName string, response proto.Message) {
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		log.Fatalf("failed read %s response body: %v", rpcName, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("%s response: Code=%d, Body=%s", rpcName, resp.StatusCode, string(bodyBytes))
+	}
+
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := unmarshaler.Unmarshal(bytes.NewReader(bodyBytes), response); err != nil {
+		log.Fatalf("failed to parse %s response body: %v", rpcName, err)
+	}
+}
+
+// streamRPC sends bodyRequest, if any, with method to the REST endpoint of a server streaming RPC
+// returns a function unmarshaling the next message of the stream into response, which returns io.EOF at the end of the stream
+func streamRPC(method, url, rpcName, bodyRequest string) func(response proto.Message) error {
+	log.Println("body request: ", bodyRequest)
+	req, err := http.NewRequest(method, url, strings.NewReader(bodyRequest))
+	if err != nil {
+		log.Fatalf("failed to call %s method: %v", rpcName, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Fatalf("failed to call %s method: %v", rpcName, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		log.Fatalf("%s response: Code=%d, Body=%s", rpcName, resp.StatusCode, string(bodyBytes))
+	}
+
+	// the gateway streams one {"result": message} or {"error": status} JSON object per message
+	decoder := json.NewDecoder(resp.Body)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	return func(response proto.Message) error {
+		var message struct {
+			Result json.RawMessage `json:"result"`
+			Error  json.RawMessage `json:"error"`
+		}
+		if err := decoder.Decode(&message); err != nil {
+			resp.Body.Close()
+			return err
+		}
+		if message.Error != nil {
+			resp.Body.Close()
+			return fmt.Errorf("%s stream error: %s", rpcName, string(message.Error))
+		}
+		return unmarshaler.Unmarshal(bytes.NewReader(message.Result), response)
+	}
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func init() {
+	rootCmd.AddCommand(watchTripCounts)
+	watchTripCounts.PersistentFlags().StringSliceP("cab-ids", "", []string{"D7D598CD99978BD012A87A76A7C891B7", "42D815590CE3A33F3A23DBF145EE66E3"}, "list of cab IDs to watch, up to 100")
+	watchTripCounts.PersistentFlags().StringP("start-date", "", "2013-12-01", "first pickup date")
+	watchTripCounts.PersistentFlags().StringP("end-date", "", "2013-12-31", "last pickup date")
+	watchTripCounts.PersistentFlags().StringP("dataset", "", "yellow", "yellow, green or fhv trips")
+}
+
+var watchTripCounts = &cobra.Command{
+	Use:   "watch-trip-counts",
+	Short: "Prints the daily trip counts of cabs, then the counts that change until interrupted",
+	Long: `Prints the daily trip counts of cabs, then the counts that change when trips are imported or the cache is cleared or refreshed, until interrupted
+Example: ./ny_cab_client_rest watch-trip-counts --cab-ids="cab1,cab2" --start-date="2013-12-01" --end-date="2013-12-31" --dataset=green`,
+	Run: func(cmd *cobra.Command, args []string) {
+		now := time.Now()
+		log.Printf("watchTripCounts REST started at %s", now)
+		defer trackTime(now, "watchTripCounts REST")
+		server, _ := cmd.Flags().GetString("server")
+		cabIds, _ := cmd.Flags().GetStringSlice("cab-ids")
+		if len(cabIds) <= 0 {
+			log.Fatal("empty cab ID list")
+		}
+		startDate, _ := cmd.Flags().GetString("start-date")
+		endDate, _ := cmd.Flags().GetString("end-date")
+		dataset, _ := cmd.Flags().GetString("dataset")
+
+		if _, found := pbdata.Dataset_value[strings.ToUpper(dataset)]; !found {
+			log.Fatalf("invalid dataset [%s], expecting yellow, green or fhv", dataset)
+		}
+
+		// Call WatchTripCountsV1
+		query := url.Values{
+			"cab_ids":    cabIds,
+			"start_date": {startDate},
+			"end_date":   {endDate},
+			"dataset":    {strings.ToUpper(dataset)},
+		}
+
+		recv := streamRPC(http.MethodGet, server+"/v1/cabtrips/watch?"+query.Encode(), "WatchTripCountsV1", "")
+		for {
+			response := &pbsvc.WatchTripCountsResponseV1{}
+			err := recv(response)
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				log.Fatalf("failed to read WatchTripCountsV1 response: %v", err)
+			}
+			if response.Error != "" {
+				log.Fatalf("WatchTripCountsV1 returned error: %s", response.Error)
+			}
+			log.Printf("WatchTripCountsV1 %s: trip counts=[%+v]", response.Trigger, response.CabTripsPerDay)
+		}
+	},
+}
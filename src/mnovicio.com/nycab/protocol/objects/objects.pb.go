@@ -18,12 +18,155 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Dataset is a TLC trip dataset
+type Dataset int32
+
+const (
+	Dataset_YELLOW Dataset = 0
+	Dataset_GREEN  Dataset = 1
+	Dataset_FHV    Dataset = 2
+)
+
+var Dataset_name = map[int32]string{
+	0: "YELLOW",
+	1: "GREEN",
+	2: "FHV",
+}
+
+var Dataset_value = map[string]int32{
+	"YELLOW": 0,
+	"GREEN":  1,
+	"FHV":    2,
+}
+
+func (x Dataset) String() string {
+	return proto.EnumName(Dataset_name, int32(x))
+}
+
+func (Dataset) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{0}
+}
+
+// HolidayFilter is how dates found in the holiday calendar are handled
+type HolidayFilter int32
+
+const (
+	HolidayFilter_INCLUDE_HOLIDAYS HolidayFilter = 0
+	HolidayFilter_TAG_HOLIDAYS     HolidayFilter = 1
+	HolidayFilter_EXCLUDE_HOLIDAYS HolidayFilter = 2
+)
+
+var HolidayFilter_name = map[int32]string{
+	0: "INCLUDE_HOLIDAYS",
+	1: "TAG_HOLIDAYS",
+	2: "EXCLUDE_HOLIDAYS",
+}
+
+var HolidayFilter_value = map[string]int32{
+	"INCLUDE_HOLIDAYS": 0,
+	"TAG_HOLIDAYS":     1,
+	"EXCLUDE_HOLIDAYS": 2,
+}
+
+func (x HolidayFilter) String() string {
+	return proto.EnumName(HolidayFilter_name, int32(x))
+}
+
+func (HolidayFilter) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{1}
+}
+
+// BaselineMethod is how the expected trip count of a day is computed from the prior days
+type BaselineMethod int32
+
+const (
+	BaselineMethod_ROLLING_MEAN              BaselineMethod = 0
+	BaselineMethod_MEDIAN_ABSOLUTE_DEVIATION BaselineMethod = 1
+)
+
+var BaselineMethod_name = map[int32]string{
+	0: "ROLLING_MEAN",
+	1: "MEDIAN_ABSOLUTE_DEVIATION",
+}
+
+var BaselineMethod_value = map[string]int32{
+	"ROLLING_MEAN":              0,
+	"MEDIAN_ABSOLUTE_DEVIATION": 1,
+}
+
+func (x BaselineMethod) String() string {
+	return proto.EnumName(BaselineMethod_name, int32(x))
+}
+
+func (BaselineMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{2}
+}
+
+// ForecastMethod is the model fitted to the daily trip counts
+type ForecastMethod int32
+
+const (
+	ForecastMethod_SEASONAL_NAIVE ForecastMethod = 0
+	ForecastMethod_HOLT_WINTERS   ForecastMethod = 1
+)
+
+var ForecastMethod_name = map[int32]string{
+	0: "SEASONAL_NAIVE",
+	1: "HOLT_WINTERS",
+}
+
+var ForecastMethod_value = map[string]int32{
+	"SEASONAL_NAIVE": 0,
+	"HOLT_WINTERS":   1,
+}
+
+func (x ForecastMethod) String() string {
+	return proto.EnumName(ForecastMethod_name, int32(x))
+}
+
+func (ForecastMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{3}
+}
+
+// TripAnomalyType is the category of a trip anomaly
+type TripAnomalyType int32
+
+const (
+	TripAnomalyType_UNKNOWN_ANOMALY   TripAnomalyType = 0
+	TripAnomalyType_OVERLAPPING_TRIPS TripAnomalyType = 1
+	TripAnomalyType_ZERO_DURATION     TripAnomalyType = 2
+	TripAnomalyType_IMPLAUSIBLE_SPEED TripAnomalyType = 3
+)
+
+var TripAnomalyType_name = map[int32]string{
+	0: "UNKNOWN_ANOMALY",
+	1: "OVERLAPPING_TRIPS",
+	2: "ZERO_DURATION",
+	3: "IMPLAUSIBLE_SPEED",
+}
+
+var TripAnomalyType_value = map[string]int32{
+	"UNKNOWN_ANOMALY":   0,
+	"OVERLAPPING_TRIPS": 1,
+	"ZERO_DURATION":     2,
+	"IMPLAUSIBLE_SPEED": 3,
+}
+
+func (x TripAnomalyType) String() string {
+	return proto.EnumName(TripAnomalyType_name, int32(x))
+}
+
+func (TripAnomalyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{4}
+}
 
 // TripsPerDay encapsulates the total number of trips in a given day
 // Uses date in format 'YYY-MM-DD' as the key
 type TripsPerDay struct {
 	TripsPerDay          map[string]uint32 `protobuf:"bytes,1,rep,name=trips_per_day,json=tripsPerDay,proto3" json:"trips_per_day,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	IsHoliday            map[string]bool   `protobuf:"bytes,2,rep,name=is_holiday,json=isHoliday,proto3" json:"is_holiday,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -61,6 +204,13 @@ func (m *TripsPerDay) GetTripsPerDay() map[string]uint32 {
 	return nil
 }
 
+func (m *TripsPerDay) GetIsHoliday() map[string]bool {
+	if m != nil {
+		return m.IsHoliday
+	}
+	return nil
+}
+
 // CabTripsPerDay is a dictionary of the total number of trips a particular cab has made in a given day
 // Uses the medalion(cab id) as the key
 type CabTripsPerDay struct {
@@ -102,30 +252,2044 @@ func (m *CabTripsPerDay) GetCabTrips() map[string]*TripsPerDay {
 	return nil
 }
 
+// DriverTripsPerDay is a dictionary of the total number of trips a particular driver has made in a given day
+// Uses the hack license(driver id) as the key
+type DriverTripsPerDay struct {
+	DriverTrips          map[string]*TripsPerDay `protobuf:"bytes,1,rep,name=driver_trips,json=driverTrips,proto3" json:"driver_trips,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DriverTripsPerDay) Reset()         { *m = DriverTripsPerDay{} }
+func (m *DriverTripsPerDay) String() string { return proto.CompactTextString(m) }
+func (*DriverTripsPerDay) ProtoMessage()    {}
+func (*DriverTripsPerDay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{2}
+}
+
+func (m *DriverTripsPerDay) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DriverTripsPerDay.Unmarshal(m, b)
+}
+func (m *DriverTripsPerDay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DriverTripsPerDay.Marshal(b, m, deterministic)
+}
+func (m *DriverTripsPerDay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DriverTripsPerDay.Merge(m, src)
+}
+func (m *DriverTripsPerDay) XXX_Size() int {
+	return xxx_messageInfo_DriverTripsPerDay.Size(m)
+}
+func (m *DriverTripsPerDay) XXX_DiscardUnknown() {
+	xxx_messageInfo_DriverTripsPerDay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DriverTripsPerDay proto.InternalMessageInfo
+
+func (m *DriverTripsPerDay) GetDriverTrips() map[string]*TripsPerDay {
+	if m != nil {
+		return m.DriverTrips
+	}
+	return nil
+}
+
+// IDList is a list of cab IDs(medallions) or driver IDs(hack licenses)
+type IDList struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IDList) Reset()         { *m = IDList{} }
+func (m *IDList) String() string { return proto.CompactTextString(m) }
+func (*IDList) ProtoMessage()    {}
+func (*IDList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{3}
+}
+
+func (m *IDList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IDList.Unmarshal(m, b)
+}
+func (m *IDList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IDList.Marshal(b, m, deterministic)
+}
+func (m *IDList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IDList.Merge(m, src)
+}
+func (m *IDList) XXX_Size() int {
+	return xxx_messageInfo_IDList.Size(m)
+}
+func (m *IDList) XXX_DiscardUnknown() {
+	xxx_messageInfo_IDList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IDList proto.InternalMessageInfo
+
+func (m *IDList) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+// CabDriverMapping maps cabs to the drivers who drove them and vice versa for a given day
+type CabDriverMapping struct {
+	// drivers_per_cab uses the medallion(cab id) as the key
+	DriversPerCab map[string]*IDList `protobuf:"bytes,1,rep,name=drivers_per_cab,json=driversPerCab,proto3" json:"drivers_per_cab,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// cabs_per_driver uses the hack license(driver id) as the key
+	CabsPerDriver        map[string]*IDList `protobuf:"bytes,2,rep,name=cabs_per_driver,json=cabsPerDriver,proto3" json:"cabs_per_driver,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CabDriverMapping) Reset()         { *m = CabDriverMapping{} }
+func (m *CabDriverMapping) String() string { return proto.CompactTextString(m) }
+func (*CabDriverMapping) ProtoMessage()    {}
+func (*CabDriverMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{4}
+}
+
+func (m *CabDriverMapping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CabDriverMapping.Unmarshal(m, b)
+}
+func (m *CabDriverMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CabDriverMapping.Marshal(b, m, deterministic)
+}
+func (m *CabDriverMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CabDriverMapping.Merge(m, src)
+}
+func (m *CabDriverMapping) XXX_Size() int {
+	return xxx_messageInfo_CabDriverMapping.Size(m)
+}
+func (m *CabDriverMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_CabDriverMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CabDriverMapping proto.InternalMessageInfo
+
+func (m *CabDriverMapping) GetDriversPerCab() map[string]*IDList {
+	if m != nil {
+		return m.DriversPerCab
+	}
+	return nil
+}
+
+func (m *CabDriverMapping) GetCabsPerDriver() map[string]*IDList {
+	if m != nil {
+		return m.CabsPerDriver
+	}
+	return nil
+}
+
+// GeoPoint is a WGS84 coordinate
+type GeoPoint struct {
+	Latitude             float64  `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude            float64  `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GeoPoint) Reset()         { *m = GeoPoint{} }
+func (m *GeoPoint) String() string { return proto.CompactTextString(m) }
+func (*GeoPoint) ProtoMessage()    {}
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{5}
+}
+
+func (m *GeoPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GeoPoint.Unmarshal(m, b)
+}
+func (m *GeoPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GeoPoint.Marshal(b, m, deterministic)
+}
+func (m *GeoPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GeoPoint.Merge(m, src)
+}
+func (m *GeoPoint) XXX_Size() int {
+	return xxx_messageInfo_GeoPoint.Size(m)
+}
+func (m *GeoPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_GeoPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GeoPoint proto.InternalMessageInfo
+
+func (m *GeoPoint) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *GeoPoint) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+// BoundingBox is a rectangular area delimited by its south west and north east corners
+type BoundingBox struct {
+	SouthWest            *GeoPoint `protobuf:"bytes,1,opt,name=south_west,json=southWest,proto3" json:"south_west,omitempty"`
+	NorthEast            *GeoPoint `protobuf:"bytes,2,opt,name=north_east,json=northEast,proto3" json:"north_east,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BoundingBox) Reset()         { *m = BoundingBox{} }
+func (m *BoundingBox) String() string { return proto.CompactTextString(m) }
+func (*BoundingBox) ProtoMessage()    {}
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{6}
+}
+
+func (m *BoundingBox) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoundingBox.Unmarshal(m, b)
+}
+func (m *BoundingBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoundingBox.Marshal(b, m, deterministic)
+}
+func (m *BoundingBox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundingBox.Merge(m, src)
+}
+func (m *BoundingBox) XXX_Size() int {
+	return xxx_messageInfo_BoundingBox.Size(m)
+}
+func (m *BoundingBox) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundingBox.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundingBox proto.InternalMessageInfo
+
+func (m *BoundingBox) GetSouthWest() *GeoPoint {
+	if m != nil {
+		return m.SouthWest
+	}
+	return nil
+}
+
+func (m *BoundingBox) GetNorthEast() *GeoPoint {
+	if m != nil {
+		return m.NorthEast
+	}
+	return nil
+}
+
+// HeatmapCell is the number of trips inside a geohash cell
+type HeatmapCell struct {
+	Geohash              string       `protobuf:"bytes,1,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	TripCount            uint32       `protobuf:"varint,2,opt,name=trip_count,json=tripCount,proto3" json:"trip_count,omitempty"`
+	Bounds               *BoundingBox `protobuf:"bytes,3,opt,name=bounds,proto3" json:"bounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *HeatmapCell) Reset()         { *m = HeatmapCell{} }
+func (m *HeatmapCell) String() string { return proto.CompactTextString(m) }
+func (*HeatmapCell) ProtoMessage()    {}
+func (*HeatmapCell) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{7}
+}
+
+func (m *HeatmapCell) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeatmapCell.Unmarshal(m, b)
+}
+func (m *HeatmapCell) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeatmapCell.Marshal(b, m, deterministic)
+}
+func (m *HeatmapCell) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeatmapCell.Merge(m, src)
+}
+func (m *HeatmapCell) XXX_Size() int {
+	return xxx_messageInfo_HeatmapCell.Size(m)
+}
+func (m *HeatmapCell) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeatmapCell.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeatmapCell proto.InternalMessageInfo
+
+func (m *HeatmapCell) GetGeohash() string {
+	if m != nil {
+		return m.Geohash
+	}
+	return ""
+}
+
+func (m *HeatmapCell) GetTripCount() uint32 {
+	if m != nil {
+		return m.TripCount
+	}
+	return 0
+}
+
+func (m *HeatmapCell) GetBounds() *BoundingBox {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+// ODMatrixEntry is the number of trips from an origin(pickup) cell to a destination(dropoff) cell
+// Cells are geohashes, or 'row:col' grid indices where row = floor(latitude / grid_size) and col = floor(longitude / grid_size)
+type ODMatrixEntry struct {
+	OriginCell           string   `protobuf:"bytes,1,opt,name=origin_cell,json=originCell,proto3" json:"origin_cell,omitempty"`
+	DestinationCell      string   `protobuf:"bytes,2,opt,name=destination_cell,json=destinationCell,proto3" json:"destination_cell,omitempty"`
+	TripCount            uint32   `protobuf:"varint,3,opt,name=trip_count,json=tripCount,proto3" json:"trip_count,omitempty"`
+	AvgTripDurationSecs  float64  `protobuf:"fixed64,4,opt,name=avg_trip_duration_secs,json=avgTripDurationSecs,proto3" json:"avg_trip_duration_secs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ODMatrixEntry) Reset()         { *m = ODMatrixEntry{} }
+func (m *ODMatrixEntry) String() string { return proto.CompactTextString(m) }
+func (*ODMatrixEntry) ProtoMessage()    {}
+func (*ODMatrixEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{8}
+}
+
+func (m *ODMatrixEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ODMatrixEntry.Unmarshal(m, b)
+}
+func (m *ODMatrixEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ODMatrixEntry.Marshal(b, m, deterministic)
+}
+func (m *ODMatrixEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ODMatrixEntry.Merge(m, src)
+}
+func (m *ODMatrixEntry) XXX_Size() int {
+	return xxx_messageInfo_ODMatrixEntry.Size(m)
+}
+func (m *ODMatrixEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ODMatrixEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ODMatrixEntry proto.InternalMessageInfo
+
+func (m *ODMatrixEntry) GetOriginCell() string {
+	if m != nil {
+		return m.OriginCell
+	}
+	return ""
+}
+
+func (m *ODMatrixEntry) GetDestinationCell() string {
+	if m != nil {
+		return m.DestinationCell
+	}
+	return ""
+}
+
+func (m *ODMatrixEntry) GetTripCount() uint32 {
+	if m != nil {
+		return m.TripCount
+	}
+	return 0
+}
+
+func (m *ODMatrixEntry) GetAvgTripDurationSecs() float64 {
+	if m != nil {
+		return m.AvgTripDurationSecs
+	}
+	return 0
+}
+
+// Shift is a group of consecutive trips of a cab driven by the same driver, split where idle gaps exceed a threshold
+// Uses date/time in format 'YYYY-MM-DD HH:MM:SS'
+type Shift struct {
+	CabId                string   `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	HackLicense          string   `protobuf:"bytes,2,opt,name=hack_license,json=hackLicense,proto3" json:"hack_license,omitempty"`
+	StartTime            string   `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string   `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	TripCount            uint32   `protobuf:"varint,5,opt,name=trip_count,json=tripCount,proto3" json:"trip_count,omitempty"`
+	ActiveSecs           uint32   `protobuf:"varint,6,opt,name=active_secs,json=activeSecs,proto3" json:"active_secs,omitempty"`
+	IdleSecs             uint32   `protobuf:"varint,7,opt,name=idle_secs,json=idleSecs,proto3" json:"idle_secs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Shift) Reset()         { *m = Shift{} }
+func (m *Shift) String() string { return proto.CompactTextString(m) }
+func (*Shift) ProtoMessage()    {}
+func (*Shift) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{9}
+}
+
+func (m *Shift) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Shift.Unmarshal(m, b)
+}
+func (m *Shift) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Shift.Marshal(b, m, deterministic)
+}
+func (m *Shift) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Shift.Merge(m, src)
+}
+func (m *Shift) XXX_Size() int {
+	return xxx_messageInfo_Shift.Size(m)
+}
+func (m *Shift) XXX_DiscardUnknown() {
+	xxx_messageInfo_Shift.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Shift proto.InternalMessageInfo
+
+func (m *Shift) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *Shift) GetHackLicense() string {
+	if m != nil {
+		return m.HackLicense
+	}
+	return ""
+}
+
+func (m *Shift) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *Shift) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *Shift) GetTripCount() uint32 {
+	if m != nil {
+		return m.TripCount
+	}
+	return 0
+}
+
+func (m *Shift) GetActiveSecs() uint32 {
+	if m != nil {
+		return m.ActiveSecs
+	}
+	return 0
+}
+
+func (m *Shift) GetIdleSecs() uint32 {
+	if m != nil {
+		return m.IdleSecs
+	}
+	return 0
+}
+
+// TripAnomaly is a trip flagged as physically impossible
+// A trip is identified by its medallion, hack license and pickup date/time in format 'YYYY-MM-DD HH:MM:SS'
+type TripAnomaly struct {
+	Type                   TripAnomalyType `protobuf:"varint,1,opt,name=type,proto3,enum=nycab.data.objects.TripAnomalyType" json:"type,omitempty"`
+	CabId                  string          `protobuf:"bytes,2,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	HackLicense            string          `protobuf:"bytes,3,opt,name=hack_license,json=hackLicense,proto3" json:"hack_license,omitempty"`
+	PickupTime             string          `protobuf:"bytes,4,opt,name=pickup_time,json=pickupTime,proto3" json:"pickup_time,omitempty"`
+	DropoffTime            string          `protobuf:"bytes,5,opt,name=dropoff_time,json=dropoffTime,proto3" json:"dropoff_time,omitempty"`
+	TripDistance           float64         `protobuf:"fixed64,6,opt,name=trip_distance,json=tripDistance,proto3" json:"trip_distance,omitempty"`
+	ConflictingPickupTime  string          `protobuf:"bytes,7,opt,name=conflicting_pickup_time,json=conflictingPickupTime,proto3" json:"conflicting_pickup_time,omitempty"`
+	ConflictingHackLicense string          `protobuf:"bytes,8,opt,name=conflicting_hack_license,json=conflictingHackLicense,proto3" json:"conflicting_hack_license,omitempty"`
+	Detail                 string          `protobuf:"bytes,9,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_unrecognized       []byte          `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *TripAnomaly) Reset()         { *m = TripAnomaly{} }
+func (m *TripAnomaly) String() string { return proto.CompactTextString(m) }
+func (*TripAnomaly) ProtoMessage()    {}
+func (*TripAnomaly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{10}
+}
+
+func (m *TripAnomaly) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TripAnomaly.Unmarshal(m, b)
+}
+func (m *TripAnomaly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TripAnomaly.Marshal(b, m, deterministic)
+}
+func (m *TripAnomaly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripAnomaly.Merge(m, src)
+}
+func (m *TripAnomaly) XXX_Size() int {
+	return xxx_messageInfo_TripAnomaly.Size(m)
+}
+func (m *TripAnomaly) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripAnomaly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripAnomaly proto.InternalMessageInfo
+
+func (m *TripAnomaly) GetType() TripAnomalyType {
+	if m != nil {
+		return m.Type
+	}
+	return TripAnomalyType_UNKNOWN_ANOMALY
+}
+
+func (m *TripAnomaly) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *TripAnomaly) GetHackLicense() string {
+	if m != nil {
+		return m.HackLicense
+	}
+	return ""
+}
+
+func (m *TripAnomaly) GetPickupTime() string {
+	if m != nil {
+		return m.PickupTime
+	}
+	return ""
+}
+
+func (m *TripAnomaly) GetDropoffTime() string {
+	if m != nil {
+		return m.DropoffTime
+	}
+	return ""
+}
+
+func (m *TripAnomaly) GetTripDistance() float64 {
+	if m != nil {
+		return m.TripDistance
+	}
+	return 0
+}
+
+func (m *TripAnomaly) GetConflictingPickupTime() string {
+	if m != nil {
+		return m.ConflictingPickupTime
+	}
+	return ""
+}
+
+func (m *TripAnomaly) GetConflictingHackLicense() string {
+	if m != nil {
+		return m.ConflictingHackLicense
+	}
+	return ""
+}
+
+func (m *TripAnomaly) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+// TripRecord is a single trip as recorded in the raw trip data
+// Uses date/time in format 'YYYY-MM-DD HH:MM:SS', fields not selected in the request are left empty
+type TripRecord struct {
+	CabId                string    `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	HackLicense          string    `protobuf:"bytes,2,opt,name=hack_license,json=hackLicense,proto3" json:"hack_license,omitempty"`
+	PickupTime           string    `protobuf:"bytes,3,opt,name=pickup_time,json=pickupTime,proto3" json:"pickup_time,omitempty"`
+	DropoffTime          string    `protobuf:"bytes,4,opt,name=dropoff_time,json=dropoffTime,proto3" json:"dropoff_time,omitempty"`
+	PickupLocation       *GeoPoint `protobuf:"bytes,5,opt,name=pickup_location,json=pickupLocation,proto3" json:"pickup_location,omitempty"`
+	DropoffLocation      *GeoPoint `protobuf:"bytes,6,opt,name=dropoff_location,json=dropoffLocation,proto3" json:"dropoff_location,omitempty"`
+	TripDistance         float64   `protobuf:"fixed64,7,opt,name=trip_distance,json=tripDistance,proto3" json:"trip_distance,omitempty"`
+	PassengerCount       uint32    `protobuf:"varint,8,opt,name=passenger_count,json=passengerCount,proto3" json:"passenger_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *TripRecord) Reset()         { *m = TripRecord{} }
+func (m *TripRecord) String() string { return proto.CompactTextString(m) }
+func (*TripRecord) ProtoMessage()    {}
+func (*TripRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{11}
+}
+
+func (m *TripRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TripRecord.Unmarshal(m, b)
+}
+func (m *TripRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TripRecord.Marshal(b, m, deterministic)
+}
+func (m *TripRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripRecord.Merge(m, src)
+}
+func (m *TripRecord) XXX_Size() int {
+	return xxx_messageInfo_TripRecord.Size(m)
+}
+func (m *TripRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripRecord proto.InternalMessageInfo
+
+func (m *TripRecord) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *TripRecord) GetHackLicense() string {
+	if m != nil {
+		return m.HackLicense
+	}
+	return ""
+}
+
+func (m *TripRecord) GetPickupTime() string {
+	if m != nil {
+		return m.PickupTime
+	}
+	return ""
+}
+
+func (m *TripRecord) GetDropoffTime() string {
+	if m != nil {
+		return m.DropoffTime
+	}
+	return ""
+}
+
+func (m *TripRecord) GetPickupLocation() *GeoPoint {
+	if m != nil {
+		return m.PickupLocation
+	}
+	return nil
+}
+
+func (m *TripRecord) GetDropoffLocation() *GeoPoint {
+	if m != nil {
+		return m.DropoffLocation
+	}
+	return nil
+}
+
+func (m *TripRecord) GetTripDistance() float64 {
+	if m != nil {
+		return m.TripDistance
+	}
+	return 0
+}
+
+func (m *TripRecord) GetPassengerCount() uint32 {
+	if m != nil {
+		return m.PassengerCount
+	}
+	return 0
+}
+
+// CabUtilization is how much of its active window a cab spent with a passenger in a given day
+// The active window goes from the first pickup to the last dropoff of the day, uses date in format 'YYYY-MM-DD'
+type CabUtilization struct {
+	CabId                string   `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	Date                 string   `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	TripCount            uint32   `protobuf:"varint,3,opt,name=trip_count,json=tripCount,proto3" json:"trip_count,omitempty"`
+	BusySecs             uint32   `protobuf:"varint,4,opt,name=busy_secs,json=busySecs,proto3" json:"busy_secs,omitempty"`
+	ActiveSecs           uint32   `protobuf:"varint,5,opt,name=active_secs,json=activeSecs,proto3" json:"active_secs,omitempty"`
+	Utilization          float64  `protobuf:"fixed64,6,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	TripsPerActiveHour   float64  `protobuf:"fixed64,7,opt,name=trips_per_active_hour,json=tripsPerActiveHour,proto3" json:"trips_per_active_hour,omitempty"`
+	IsHoliday            bool     `protobuf:"varint,8,opt,name=is_holiday,json=isHoliday,proto3" json:"is_holiday,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CabUtilization) Reset()         { *m = CabUtilization{} }
+func (m *CabUtilization) String() string { return proto.CompactTextString(m) }
+func (*CabUtilization) ProtoMessage()    {}
+func (*CabUtilization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{12}
+}
+
+func (m *CabUtilization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CabUtilization.Unmarshal(m, b)
+}
+func (m *CabUtilization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CabUtilization.Marshal(b, m, deterministic)
+}
+func (m *CabUtilization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CabUtilization.Merge(m, src)
+}
+func (m *CabUtilization) XXX_Size() int {
+	return xxx_messageInfo_CabUtilization.Size(m)
+}
+func (m *CabUtilization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CabUtilization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CabUtilization proto.InternalMessageInfo
+
+func (m *CabUtilization) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *CabUtilization) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *CabUtilization) GetTripCount() uint32 {
+	if m != nil {
+		return m.TripCount
+	}
+	return 0
+}
+
+func (m *CabUtilization) GetBusySecs() uint32 {
+	if m != nil {
+		return m.BusySecs
+	}
+	return 0
+}
+
+func (m *CabUtilization) GetActiveSecs() uint32 {
+	if m != nil {
+		return m.ActiveSecs
+	}
+	return 0
+}
+
+func (m *CabUtilization) GetUtilization() float64 {
+	if m != nil {
+		return m.Utilization
+	}
+	return 0
+}
+
+func (m *CabUtilization) GetTripsPerActiveHour() float64 {
+	if m != nil {
+		return m.TripsPerActiveHour
+	}
+	return 0
+}
+
+func (m *CabUtilization) GetIsHoliday() bool {
+	if m != nil {
+		return m.IsHoliday
+	}
+	return false
+}
+
+// TripCountSummary describes the daily trip counts of a period
+type TripCountSummary struct {
+	Period               string   `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Days                 uint32   `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	TotalTrips           uint64   `protobuf:"varint,3,opt,name=total_trips,json=totalTrips,proto3" json:"total_trips,omitempty"`
+	Mean                 float64  `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	StdDev               float64  `protobuf:"fixed64,5,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TripCountSummary) Reset()         { *m = TripCountSummary{} }
+func (m *TripCountSummary) String() string { return proto.CompactTextString(m) }
+func (*TripCountSummary) ProtoMessage()    {}
+func (*TripCountSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{13}
+}
+
+func (m *TripCountSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TripCountSummary.Unmarshal(m, b)
+}
+func (m *TripCountSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TripCountSummary.Marshal(b, m, deterministic)
+}
+func (m *TripCountSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripCountSummary.Merge(m, src)
+}
+func (m *TripCountSummary) XXX_Size() int {
+	return xxx_messageInfo_TripCountSummary.Size(m)
+}
+func (m *TripCountSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripCountSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripCountSummary proto.InternalMessageInfo
+
+func (m *TripCountSummary) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *TripCountSummary) GetDays() uint32 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+func (m *TripCountSummary) GetTotalTrips() uint64 {
+	if m != nil {
+		return m.TotalTrips
+	}
+	return 0
+}
+
+func (m *TripCountSummary) GetMean() float64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+func (m *TripCountSummary) GetStdDev() float64 {
+	if m != nil {
+		return m.StdDev
+	}
+	return 0
+}
+
+// TripPatterns are the daily trip counts of a cab (or the whole fleet) aggregated by day of the week and by month
+type TripPatterns struct {
+	CabId                string              `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	ByDayOfWeek          []*TripCountSummary `protobuf:"bytes,2,rep,name=by_day_of_week,json=byDayOfWeek,proto3" json:"by_day_of_week,omitempty"`
+	ByMonth              []*TripCountSummary `protobuf:"bytes,3,rep,name=by_month,json=byMonth,proto3" json:"by_month,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *TripPatterns) Reset()         { *m = TripPatterns{} }
+func (m *TripPatterns) String() string { return proto.CompactTextString(m) }
+func (*TripPatterns) ProtoMessage()    {}
+func (*TripPatterns) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{14}
+}
+
+func (m *TripPatterns) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TripPatterns.Unmarshal(m, b)
+}
+func (m *TripPatterns) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TripPatterns.Marshal(b, m, deterministic)
+}
+func (m *TripPatterns) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripPatterns.Merge(m, src)
+}
+func (m *TripPatterns) XXX_Size() int {
+	return xxx_messageInfo_TripPatterns.Size(m)
+}
+func (m *TripPatterns) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripPatterns.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripPatterns proto.InternalMessageInfo
+
+func (m *TripPatterns) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *TripPatterns) GetByDayOfWeek() []*TripCountSummary {
+	if m != nil {
+		return m.ByDayOfWeek
+	}
+	return nil
+}
+
+func (m *TripPatterns) GetByMonth() []*TripCountSummary {
+	if m != nil {
+		return m.ByMonth
+	}
+	return nil
+}
+
+// CountAnomaly is a day whose trip count deviates from the baseline of the prior days of the same cab (or the whole fleet)
+type CountAnomaly struct {
+	CabId                string   `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	Date                 string   `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Count                uint32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Expected             float64  `protobuf:"fixed64,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	Score                float64  `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	IsHoliday            bool     `protobuf:"varint,6,opt,name=is_holiday,json=isHoliday,proto3" json:"is_holiday,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CountAnomaly) Reset()         { *m = CountAnomaly{} }
+func (m *CountAnomaly) String() string { return proto.CompactTextString(m) }
+func (*CountAnomaly) ProtoMessage()    {}
+func (*CountAnomaly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{15}
+}
+
+func (m *CountAnomaly) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountAnomaly.Unmarshal(m, b)
+}
+func (m *CountAnomaly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountAnomaly.Marshal(b, m, deterministic)
+}
+func (m *CountAnomaly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountAnomaly.Merge(m, src)
+}
+func (m *CountAnomaly) XXX_Size() int {
+	return xxx_messageInfo_CountAnomaly.Size(m)
+}
+func (m *CountAnomaly) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountAnomaly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountAnomaly proto.InternalMessageInfo
+
+func (m *CountAnomaly) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *CountAnomaly) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *CountAnomaly) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CountAnomaly) GetExpected() float64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *CountAnomaly) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *CountAnomaly) GetIsHoliday() bool {
+	if m != nil {
+		return m.IsHoliday
+	}
+	return false
+}
+
+// TripForecast is the expected trip count of a day with its prediction interval
+type TripForecast struct {
+	Date                 string   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Expected             float64  `protobuf:"fixed64,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Lower                float64  `protobuf:"fixed64,3,opt,name=lower,proto3" json:"lower,omitempty"`
+	Upper                float64  `protobuf:"fixed64,4,opt,name=upper,proto3" json:"upper,omitempty"`
+	IsHoliday            bool     `protobuf:"varint,5,opt,name=is_holiday,json=isHoliday,proto3" json:"is_holiday,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TripForecast) Reset()         { *m = TripForecast{} }
+func (m *TripForecast) String() string { return proto.CompactTextString(m) }
+func (*TripForecast) ProtoMessage()    {}
+func (*TripForecast) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{16}
+}
+
+func (m *TripForecast) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TripForecast.Unmarshal(m, b)
+}
+func (m *TripForecast) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TripForecast.Marshal(b, m, deterministic)
+}
+func (m *TripForecast) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TripForecast.Merge(m, src)
+}
+func (m *TripForecast) XXX_Size() int {
+	return xxx_messageInfo_TripForecast.Size(m)
+}
+func (m *TripForecast) XXX_DiscardUnknown() {
+	xxx_messageInfo_TripForecast.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TripForecast proto.InternalMessageInfo
+
+func (m *TripForecast) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *TripForecast) GetExpected() float64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *TripForecast) GetLower() float64 {
+	if m != nil {
+		return m.Lower
+	}
+	return 0
+}
+
+func (m *TripForecast) GetUpper() float64 {
+	if m != nil {
+		return m.Upper
+	}
+	return 0
+}
+
+func (m *TripForecast) GetIsHoliday() bool {
+	if m != nil {
+		return m.IsHoliday
+	}
+	return false
+}
+
+// CabTripForecast are the trip forecasts of a cab (or the whole fleet)
+type CabTripForecast struct {
+	CabId                string          `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	Forecasts            []*TripForecast `protobuf:"bytes,2,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CabTripForecast) Reset()         { *m = CabTripForecast{} }
+func (m *CabTripForecast) String() string { return proto.CompactTextString(m) }
+func (*CabTripForecast) ProtoMessage()    {}
+func (*CabTripForecast) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{17}
+}
+
+func (m *CabTripForecast) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CabTripForecast.Unmarshal(m, b)
+}
+func (m *CabTripForecast) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CabTripForecast.Marshal(b, m, deterministic)
+}
+func (m *CabTripForecast) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CabTripForecast.Merge(m, src)
+}
+func (m *CabTripForecast) XXX_Size() int {
+	return xxx_messageInfo_CabTripForecast.Size(m)
+}
+func (m *CabTripForecast) XXX_DiscardUnknown() {
+	xxx_messageInfo_CabTripForecast.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CabTripForecast proto.InternalMessageInfo
+
+func (m *CabTripForecast) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *CabTripForecast) GetForecasts() []*TripForecast {
+	if m != nil {
+		return m.Forecasts
+	}
+	return nil
+}
+
+// PassengerCountBucket is the number of trips made with a given passenger count
+type PassengerCountBucket struct {
+	PassengerCount       int32    `protobuf:"varint,1,opt,name=passenger_count,json=passengerCount,proto3" json:"passenger_count,omitempty"`
+	Trips                uint64   `protobuf:"varint,2,opt,name=trips,proto3" json:"trips,omitempty"`
+	Share                float64  `protobuf:"fixed64,3,opt,name=share,proto3" json:"share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PassengerCountBucket) Reset()         { *m = PassengerCountBucket{} }
+func (m *PassengerCountBucket) String() string { return proto.CompactTextString(m) }
+func (*PassengerCountBucket) ProtoMessage()    {}
+func (*PassengerCountBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{18}
+}
+
+func (m *PassengerCountBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PassengerCountBucket.Unmarshal(m, b)
+}
+func (m *PassengerCountBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PassengerCountBucket.Marshal(b, m, deterministic)
+}
+func (m *PassengerCountBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PassengerCountBucket.Merge(m, src)
+}
+func (m *PassengerCountBucket) XXX_Size() int {
+	return xxx_messageInfo_PassengerCountBucket.Size(m)
+}
+func (m *PassengerCountBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PassengerCountBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PassengerCountBucket proto.InternalMessageInfo
+
+func (m *PassengerCountBucket) GetPassengerCount() int32 {
+	if m != nil {
+		return m.PassengerCount
+	}
+	return 0
+}
+
+func (m *PassengerCountBucket) GetTrips() uint64 {
+	if m != nil {
+		return m.Trips
+	}
+	return 0
+}
+
+func (m *PassengerCountBucket) GetShare() float64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+// PassengerCountDistribution is the number of trips per passenger count of a cab (or the whole fleet)
+// passenger counts of 0 or above 6 are invalid
+type PassengerCountDistribution struct {
+	CabId                string                  `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	TotalTrips           uint64                  `protobuf:"varint,2,opt,name=total_trips,json=totalTrips,proto3" json:"total_trips,omitempty"`
+	Buckets              []*PassengerCountBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	InvalidTrips         uint64                  `protobuf:"varint,4,opt,name=invalid_trips,json=invalidTrips,proto3" json:"invalid_trips,omitempty"`
+	InvalidShare         float64                 `protobuf:"fixed64,5,opt,name=invalid_share,json=invalidShare,proto3" json:"invalid_share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *PassengerCountDistribution) Reset()         { *m = PassengerCountDistribution{} }
+func (m *PassengerCountDistribution) String() string { return proto.CompactTextString(m) }
+func (*PassengerCountDistribution) ProtoMessage()    {}
+func (*PassengerCountDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{19}
+}
+
+func (m *PassengerCountDistribution) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PassengerCountDistribution.Unmarshal(m, b)
+}
+func (m *PassengerCountDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PassengerCountDistribution.Marshal(b, m, deterministic)
+}
+func (m *PassengerCountDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PassengerCountDistribution.Merge(m, src)
+}
+func (m *PassengerCountDistribution) XXX_Size() int {
+	return xxx_messageInfo_PassengerCountDistribution.Size(m)
+}
+func (m *PassengerCountDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PassengerCountDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PassengerCountDistribution proto.InternalMessageInfo
+
+func (m *PassengerCountDistribution) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *PassengerCountDistribution) GetTotalTrips() uint64 {
+	if m != nil {
+		return m.TotalTrips
+	}
+	return 0
+}
+
+func (m *PassengerCountDistribution) GetBuckets() []*PassengerCountBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *PassengerCountDistribution) GetInvalidTrips() uint64 {
+	if m != nil {
+		return m.InvalidTrips
+	}
+	return 0
+}
+
+func (m *PassengerCountDistribution) GetInvalidShare() float64 {
+	if m != nil {
+		return m.InvalidShare
+	}
+	return 0
+}
+
+// VendorStats describes the trips recorded by the meters of a vendor
+// a trip is anomalous if it has zero duration, an implausible speed, an invalid passenger count (0 or above 6) or no pickup location
+type VendorStats struct {
+	VendorId                   string   `protobuf:"bytes,1,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	TripCount                  uint64   `protobuf:"varint,2,opt,name=trip_count,json=tripCount,proto3" json:"trip_count,omitempty"`
+	AvgDistance                float64  `protobuf:"fixed64,3,opt,name=avg_distance,json=avgDistance,proto3" json:"avg_distance,omitempty"`
+	ZeroDurationTrips          uint64   `protobuf:"varint,4,opt,name=zero_duration_trips,json=zeroDurationTrips,proto3" json:"zero_duration_trips,omitempty"`
+	ImplausibleSpeedTrips      uint64   `protobuf:"varint,5,opt,name=implausible_speed_trips,json=implausibleSpeedTrips,proto3" json:"implausible_speed_trips,omitempty"`
+	InvalidPassengerCountTrips uint64   `protobuf:"varint,6,opt,name=invalid_passenger_count_trips,json=invalidPassengerCountTrips,proto3" json:"invalid_passenger_count_trips,omitempty"`
+	MissingPickupLocationTrips uint64   `protobuf:"varint,7,opt,name=missing_pickup_location_trips,json=missingPickupLocationTrips,proto3" json:"missing_pickup_location_trips,omitempty"`
+	AnomalousTrips             uint64   `protobuf:"varint,8,opt,name=anomalous_trips,json=anomalousTrips,proto3" json:"anomalous_trips,omitempty"`
+	AnomalyRate                float64  `protobuf:"fixed64,9,opt,name=anomaly_rate,json=anomalyRate,proto3" json:"anomaly_rate,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *VendorStats) Reset()         { *m = VendorStats{} }
+func (m *VendorStats) String() string { return proto.CompactTextString(m) }
+func (*VendorStats) ProtoMessage()    {}
+func (*VendorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{20}
+}
+
+func (m *VendorStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VendorStats.Unmarshal(m, b)
+}
+func (m *VendorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VendorStats.Marshal(b, m, deterministic)
+}
+func (m *VendorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VendorStats.Merge(m, src)
+}
+func (m *VendorStats) XXX_Size() int {
+	return xxx_messageInfo_VendorStats.Size(m)
+}
+func (m *VendorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_VendorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VendorStats proto.InternalMessageInfo
+
+func (m *VendorStats) GetVendorId() string {
+	if m != nil {
+		return m.VendorId
+	}
+	return ""
+}
+
+func (m *VendorStats) GetTripCount() uint64 {
+	if m != nil {
+		return m.TripCount
+	}
+	return 0
+}
+
+func (m *VendorStats) GetAvgDistance() float64 {
+	if m != nil {
+		return m.AvgDistance
+	}
+	return 0
+}
+
+func (m *VendorStats) GetZeroDurationTrips() uint64 {
+	if m != nil {
+		return m.ZeroDurationTrips
+	}
+	return 0
+}
+
+func (m *VendorStats) GetImplausibleSpeedTrips() uint64 {
+	if m != nil {
+		return m.ImplausibleSpeedTrips
+	}
+	return 0
+}
+
+func (m *VendorStats) GetInvalidPassengerCountTrips() uint64 {
+	if m != nil {
+		return m.InvalidPassengerCountTrips
+	}
+	return 0
+}
+
+func (m *VendorStats) GetMissingPickupLocationTrips() uint64 {
+	if m != nil {
+		return m.MissingPickupLocationTrips
+	}
+	return 0
+}
+
+func (m *VendorStats) GetAnomalousTrips() uint64 {
+	if m != nil {
+		return m.AnomalousTrips
+	}
+	return 0
+}
+
+func (m *VendorStats) GetAnomalyRate() float64 {
+	if m != nil {
+		return m.AnomalyRate
+	}
+	return 0
+}
+
+// ZoneTripCount is the number of trips of a cab starting or ending in a named zone on a given day
+type ZoneTripCount struct {
+	Zone                 string   `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	CabId                string   `protobuf:"bytes,2,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	Date                 string   `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Pickups              uint32   `protobuf:"varint,4,opt,name=pickups,proto3" json:"pickups,omitempty"`
+	Dropoffs             uint32   `protobuf:"varint,5,opt,name=dropoffs,proto3" json:"dropoffs,omitempty"`
+	Trips                uint32   `protobuf:"varint,6,opt,name=trips,proto3" json:"trips,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ZoneTripCount) Reset()         { *m = ZoneTripCount{} }
+func (m *ZoneTripCount) String() string { return proto.CompactTextString(m) }
+func (*ZoneTripCount) ProtoMessage()    {}
+func (*ZoneTripCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{21}
+}
+
+func (m *ZoneTripCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ZoneTripCount.Unmarshal(m, b)
+}
+func (m *ZoneTripCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ZoneTripCount.Marshal(b, m, deterministic)
+}
+func (m *ZoneTripCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ZoneTripCount.Merge(m, src)
+}
+func (m *ZoneTripCount) XXX_Size() int {
+	return xxx_messageInfo_ZoneTripCount.Size(m)
+}
+func (m *ZoneTripCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ZoneTripCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ZoneTripCount proto.InternalMessageInfo
+
+func (m *ZoneTripCount) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *ZoneTripCount) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *ZoneTripCount) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *ZoneTripCount) GetPickups() uint32 {
+	if m != nil {
+		return m.Pickups
+	}
+	return 0
+}
+
+func (m *ZoneTripCount) GetDropoffs() uint32 {
+	if m != nil {
+		return m.Dropoffs
+	}
+	return 0
+}
+
+func (m *ZoneTripCount) GetTrips() uint32 {
+	if m != nil {
+		return m.Trips
+	}
+	return 0
+}
+
+// TaxiZoneTripCount is the number of trips starting and ending in a TLC taxi zone
+type TaxiZoneTripCount struct {
+	LocationId           string   `protobuf:"bytes,1,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	Zone                 string   `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	Borough              string   `protobuf:"bytes,3,opt,name=borough,proto3" json:"borough,omitempty"`
+	Date                 string   `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Pickups              uint32   `protobuf:"varint,5,opt,name=pickups,proto3" json:"pickups,omitempty"`
+	Dropoffs             uint32   `protobuf:"varint,6,opt,name=dropoffs,proto3" json:"dropoffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaxiZoneTripCount) Reset()         { *m = TaxiZoneTripCount{} }
+func (m *TaxiZoneTripCount) String() string { return proto.CompactTextString(m) }
+func (*TaxiZoneTripCount) ProtoMessage()    {}
+func (*TaxiZoneTripCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{22}
+}
+
+func (m *TaxiZoneTripCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TaxiZoneTripCount.Unmarshal(m, b)
+}
+func (m *TaxiZoneTripCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TaxiZoneTripCount.Marshal(b, m, deterministic)
+}
+func (m *TaxiZoneTripCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaxiZoneTripCount.Merge(m, src)
+}
+func (m *TaxiZoneTripCount) XXX_Size() int {
+	return xxx_messageInfo_TaxiZoneTripCount.Size(m)
+}
+func (m *TaxiZoneTripCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaxiZoneTripCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaxiZoneTripCount proto.InternalMessageInfo
+
+func (m *TaxiZoneTripCount) GetLocationId() string {
+	if m != nil {
+		return m.LocationId
+	}
+	return ""
+}
+
+func (m *TaxiZoneTripCount) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+func (m *TaxiZoneTripCount) GetBorough() string {
+	if m != nil {
+		return m.Borough
+	}
+	return ""
+}
+
+func (m *TaxiZoneTripCount) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *TaxiZoneTripCount) GetPickups() uint32 {
+	if m != nil {
+		return m.Pickups
+	}
+	return 0
+}
+
+func (m *TaxiZoneTripCount) GetDropoffs() uint32 {
+	if m != nil {
+		return m.Dropoffs
+	}
+	return 0
+}
+
+// CabZoneCoverage describes the TLC taxi zones a cab picked up or dropped off passengers in
+type CabZoneCoverage struct {
+	CabId                string               `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	Trips                uint32               `protobuf:"varint,2,opt,name=trips,proto3" json:"trips,omitempty"`
+	ZonesVisited         uint32               `protobuf:"varint,3,opt,name=zones_visited,json=zonesVisited,proto3" json:"zones_visited,omitempty"`
+	Zones                []*TaxiZoneTripCount `protobuf:"bytes,4,rep,name=zones,proto3" json:"zones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CabZoneCoverage) Reset()         { *m = CabZoneCoverage{} }
+func (m *CabZoneCoverage) String() string { return proto.CompactTextString(m) }
+func (*CabZoneCoverage) ProtoMessage()    {}
+func (*CabZoneCoverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{23}
+}
+
+func (m *CabZoneCoverage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CabZoneCoverage.Unmarshal(m, b)
+}
+func (m *CabZoneCoverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CabZoneCoverage.Marshal(b, m, deterministic)
+}
+func (m *CabZoneCoverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CabZoneCoverage.Merge(m, src)
+}
+func (m *CabZoneCoverage) XXX_Size() int {
+	return xxx_messageInfo_CabZoneCoverage.Size(m)
+}
+func (m *CabZoneCoverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CabZoneCoverage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CabZoneCoverage proto.InternalMessageInfo
+
+func (m *CabZoneCoverage) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *CabZoneCoverage) GetTrips() uint32 {
+	if m != nil {
+		return m.Trips
+	}
+	return 0
+}
+
+func (m *CabZoneCoverage) GetZonesVisited() uint32 {
+	if m != nil {
+		return m.ZonesVisited
+	}
+	return 0
+}
+
+func (m *CabZoneCoverage) GetZones() []*TaxiZoneTripCount {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+// CabRevenue is the fares collected by a cab on a given day, amounts are in USD
+// trips without a matching fare record are not counted
+type CabRevenue struct {
+	CabId                string   `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	Date                 string   `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	TripCount            uint32   `protobuf:"varint,3,opt,name=trip_count,json=tripCount,proto3" json:"trip_count,omitempty"`
+	FareAmount           float64  `protobuf:"fixed64,4,opt,name=fare_amount,json=fareAmount,proto3" json:"fare_amount,omitempty"`
+	Surcharge            float64  `protobuf:"fixed64,5,opt,name=surcharge,proto3" json:"surcharge,omitempty"`
+	MtaTax               float64  `protobuf:"fixed64,6,opt,name=mta_tax,json=mtaTax,proto3" json:"mta_tax,omitempty"`
+	TipAmount            float64  `protobuf:"fixed64,7,opt,name=tip_amount,json=tipAmount,proto3" json:"tip_amount,omitempty"`
+	TollsAmount          float64  `protobuf:"fixed64,8,opt,name=tolls_amount,json=tollsAmount,proto3" json:"tolls_amount,omitempty"`
+	TotalAmount          float64  `protobuf:"fixed64,9,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	IsHoliday            bool     `protobuf:"varint,10,opt,name=is_holiday,json=isHoliday,proto3" json:"is_holiday,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CabRevenue) Reset()         { *m = CabRevenue{} }
+func (m *CabRevenue) String() string { return proto.CompactTextString(m) }
+func (*CabRevenue) ProtoMessage()    {}
+func (*CabRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{24}
+}
+
+func (m *CabRevenue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CabRevenue.Unmarshal(m, b)
+}
+func (m *CabRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CabRevenue.Marshal(b, m, deterministic)
+}
+func (m *CabRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CabRevenue.Merge(m, src)
+}
+func (m *CabRevenue) XXX_Size() int {
+	return xxx_messageInfo_CabRevenue.Size(m)
+}
+func (m *CabRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_CabRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CabRevenue proto.InternalMessageInfo
+
+func (m *CabRevenue) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *CabRevenue) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *CabRevenue) GetTripCount() uint32 {
+	if m != nil {
+		return m.TripCount
+	}
+	return 0
+}
+
+func (m *CabRevenue) GetFareAmount() float64 {
+	if m != nil {
+		return m.FareAmount
+	}
+	return 0
+}
+
+func (m *CabRevenue) GetSurcharge() float64 {
+	if m != nil {
+		return m.Surcharge
+	}
+	return 0
+}
+
+func (m *CabRevenue) GetMtaTax() float64 {
+	if m != nil {
+		return m.MtaTax
+	}
+	return 0
+}
+
+func (m *CabRevenue) GetTipAmount() float64 {
+	if m != nil {
+		return m.TipAmount
+	}
+	return 0
+}
+
+func (m *CabRevenue) GetTollsAmount() float64 {
+	if m != nil {
+		return m.TollsAmount
+	}
+	return 0
+}
+
+func (m *CabRevenue) GetTotalAmount() float64 {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return 0
+}
+
+func (m *CabRevenue) GetIsHoliday() bool {
+	if m != nil {
+		return m.IsHoliday
+	}
+	return false
+}
+
+// TipRate describes the tips of the trips of a cab (or the whole fleet) paid by card, cash tips are not recorded
+type TipRate struct {
+	CabId                string   `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	Trips                uint64   `protobuf:"varint,2,opt,name=trips,proto3" json:"trips,omitempty"`
+	CardTrips            uint64   `protobuf:"varint,3,opt,name=card_trips,json=cardTrips,proto3" json:"card_trips,omitempty"`
+	TippedCardTrips      uint64   `protobuf:"varint,4,opt,name=tipped_card_trips,json=tippedCardTrips,proto3" json:"tipped_card_trips,omitempty"`
+	TipAmount            float64  `protobuf:"fixed64,5,opt,name=tip_amount,json=tipAmount,proto3" json:"tip_amount,omitempty"`
+	FareAmount           float64  `protobuf:"fixed64,6,opt,name=fare_amount,json=fareAmount,proto3" json:"fare_amount,omitempty"`
+	TipRate              float64  `protobuf:"fixed64,7,opt,name=tip_rate,json=tipRate,proto3" json:"tip_rate,omitempty"`
+	TippedShare          float64  `protobuf:"fixed64,8,opt,name=tipped_share,json=tippedShare,proto3" json:"tipped_share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TipRate) Reset()         { *m = TipRate{} }
+func (m *TipRate) String() string { return proto.CompactTextString(m) }
+func (*TipRate) ProtoMessage()    {}
+func (*TipRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{25}
+}
+
+func (m *TipRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TipRate.Unmarshal(m, b)
+}
+func (m *TipRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TipRate.Marshal(b, m, deterministic)
+}
+func (m *TipRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TipRate.Merge(m, src)
+}
+func (m *TipRate) XXX_Size() int {
+	return xxx_messageInfo_TipRate.Size(m)
+}
+func (m *TipRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TipRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TipRate proto.InternalMessageInfo
+
+func (m *TipRate) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *TipRate) GetTrips() uint64 {
+	if m != nil {
+		return m.Trips
+	}
+	return 0
+}
+
+func (m *TipRate) GetCardTrips() uint64 {
+	if m != nil {
+		return m.CardTrips
+	}
+	return 0
+}
+
+func (m *TipRate) GetTippedCardTrips() uint64 {
+	if m != nil {
+		return m.TippedCardTrips
+	}
+	return 0
+}
+
+func (m *TipRate) GetTipAmount() float64 {
+	if m != nil {
+		return m.TipAmount
+	}
+	return 0
+}
+
+func (m *TipRate) GetFareAmount() float64 {
+	if m != nil {
+		return m.FareAmount
+	}
+	return 0
+}
+
+func (m *TipRate) GetTipRate() float64 {
+	if m != nil {
+		return m.TipRate
+	}
+	return 0
+}
+
+func (m *TipRate) GetTippedShare() float64 {
+	if m != nil {
+		return m.TippedShare
+	}
+	return 0
+}
+
+// PaymentTypeShare is the number of trips paid with a given payment type
+// payment types are 'CRD' (card), 'CSH' (cash), 'NOC' (no charge), 'DIS' (dispute) and 'UNK' (unknown)
+type PaymentTypeShare struct {
+	PaymentType          string   `protobuf:"bytes,1,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
+	Trips                uint64   `protobuf:"varint,2,opt,name=trips,proto3" json:"trips,omitempty"`
+	Share                float64  `protobuf:"fixed64,3,opt,name=share,proto3" json:"share,omitempty"`
+	TotalAmount          float64  `protobuf:"fixed64,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaymentTypeShare) Reset()         { *m = PaymentTypeShare{} }
+func (m *PaymentTypeShare) String() string { return proto.CompactTextString(m) }
+func (*PaymentTypeShare) ProtoMessage()    {}
+func (*PaymentTypeShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{26}
+}
+
+func (m *PaymentTypeShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentTypeShare.Unmarshal(m, b)
+}
+func (m *PaymentTypeShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentTypeShare.Marshal(b, m, deterministic)
+}
+func (m *PaymentTypeShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentTypeShare.Merge(m, src)
+}
+func (m *PaymentTypeShare) XXX_Size() int {
+	return xxx_messageInfo_PaymentTypeShare.Size(m)
+}
+func (m *PaymentTypeShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentTypeShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentTypeShare proto.InternalMessageInfo
+
+func (m *PaymentTypeShare) GetPaymentType() string {
+	if m != nil {
+		return m.PaymentType
+	}
+	return ""
+}
+
+func (m *PaymentTypeShare) GetTrips() uint64 {
+	if m != nil {
+		return m.Trips
+	}
+	return 0
+}
+
+func (m *PaymentTypeShare) GetShare() float64 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+func (m *PaymentTypeShare) GetTotalAmount() float64 {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return 0
+}
+
+// PaymentTypeMix is the number of trips per payment type of a cab (or the whole fleet)
+type PaymentTypeMix struct {
+	CabId                string              `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	TotalTrips           uint64              `protobuf:"varint,2,opt,name=total_trips,json=totalTrips,proto3" json:"total_trips,omitempty"`
+	PaymentTypes         []*PaymentTypeShare `protobuf:"bytes,3,rep,name=payment_types,json=paymentTypes,proto3" json:"payment_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *PaymentTypeMix) Reset()         { *m = PaymentTypeMix{} }
+func (m *PaymentTypeMix) String() string { return proto.CompactTextString(m) }
+func (*PaymentTypeMix) ProtoMessage()    {}
+func (*PaymentTypeMix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7da965bc36916fc1, []int{27}
+}
+
+func (m *PaymentTypeMix) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentTypeMix.Unmarshal(m, b)
+}
+func (m *PaymentTypeMix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentTypeMix.Marshal(b, m, deterministic)
+}
+func (m *PaymentTypeMix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentTypeMix.Merge(m, src)
+}
+func (m *PaymentTypeMix) XXX_Size() int {
+	return xxx_messageInfo_PaymentTypeMix.Size(m)
+}
+func (m *PaymentTypeMix) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentTypeMix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentTypeMix proto.InternalMessageInfo
+
+func (m *PaymentTypeMix) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *PaymentTypeMix) GetTotalTrips() uint64 {
+	if m != nil {
+		return m.TotalTrips
+	}
+	return 0
+}
+
+func (m *PaymentTypeMix) GetPaymentTypes() []*PaymentTypeShare {
+	if m != nil {
+		return m.PaymentTypes
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("nycab.data.objects.Dataset", Dataset_name, Dataset_value)
+	proto.RegisterEnum("nycab.data.objects.HolidayFilter", HolidayFilter_name, HolidayFilter_value)
+	proto.RegisterEnum("nycab.data.objects.BaselineMethod", BaselineMethod_name, BaselineMethod_value)
+	proto.RegisterEnum("nycab.data.objects.ForecastMethod", ForecastMethod_name, ForecastMethod_value)
+	proto.RegisterEnum("nycab.data.objects.TripAnomalyType", TripAnomalyType_name, TripAnomalyType_value)
 	proto.RegisterType((*TripsPerDay)(nil), "nycab.data.objects.TripsPerDay")
+	proto.RegisterMapType((map[string]bool)(nil), "nycab.data.objects.TripsPerDay.IsHolidayEntry")
 	proto.RegisterMapType((map[string]uint32)(nil), "nycab.data.objects.TripsPerDay.TripsPerDayEntry")
 	proto.RegisterType((*CabTripsPerDay)(nil), "nycab.data.objects.CabTripsPerDay")
 	proto.RegisterMapType((map[string]*TripsPerDay)(nil), "nycab.data.objects.CabTripsPerDay.CabTripsEntry")
+	proto.RegisterType((*DriverTripsPerDay)(nil), "nycab.data.objects.DriverTripsPerDay")
+	proto.RegisterMapType((map[string]*TripsPerDay)(nil), "nycab.data.objects.DriverTripsPerDay.DriverTripsEntry")
+	proto.RegisterType((*IDList)(nil), "nycab.data.objects.IDList")
+	proto.RegisterType((*CabDriverMapping)(nil), "nycab.data.objects.CabDriverMapping")
+	proto.RegisterMapType((map[string]*IDList)(nil), "nycab.data.objects.CabDriverMapping.CabsPerDriverEntry")
+	proto.RegisterMapType((map[string]*IDList)(nil), "nycab.data.objects.CabDriverMapping.DriversPerCabEntry")
+	proto.RegisterType((*GeoPoint)(nil), "nycab.data.objects.GeoPoint")
+	proto.RegisterType((*BoundingBox)(nil), "nycab.data.objects.BoundingBox")
+	proto.RegisterType((*HeatmapCell)(nil), "nycab.data.objects.HeatmapCell")
+	proto.RegisterType((*ODMatrixEntry)(nil), "nycab.data.objects.ODMatrixEntry")
+	proto.RegisterType((*Shift)(nil), "nycab.data.objects.Shift")
+	proto.RegisterType((*TripAnomaly)(nil), "nycab.data.objects.TripAnomaly")
+	proto.RegisterType((*TripRecord)(nil), "nycab.data.objects.TripRecord")
+	proto.RegisterType((*CabUtilization)(nil), "nycab.data.objects.CabUtilization")
+	proto.RegisterType((*TripCountSummary)(nil), "nycab.data.objects.TripCountSummary")
+	proto.RegisterType((*TripPatterns)(nil), "nycab.data.objects.TripPatterns")
+	proto.RegisterType((*CountAnomaly)(nil), "nycab.data.objects.CountAnomaly")
+	proto.RegisterType((*TripForecast)(nil), "nycab.data.objects.TripForecast")
+	proto.RegisterType((*CabTripForecast)(nil), "nycab.data.objects.CabTripForecast")
+	proto.RegisterType((*PassengerCountBucket)(nil), "nycab.data.objects.PassengerCountBucket")
+	proto.RegisterType((*PassengerCountDistribution)(nil), "nycab.data.objects.PassengerCountDistribution")
+	proto.RegisterType((*VendorStats)(nil), "nycab.data.objects.VendorStats")
+	proto.RegisterType((*ZoneTripCount)(nil), "nycab.data.objects.ZoneTripCount")
+	proto.RegisterType((*TaxiZoneTripCount)(nil), "nycab.data.objects.TaxiZoneTripCount")
+	proto.RegisterType((*CabZoneCoverage)(nil), "nycab.data.objects.CabZoneCoverage")
+	proto.RegisterType((*CabRevenue)(nil), "nycab.data.objects.CabRevenue")
+	proto.RegisterType((*TipRate)(nil), "nycab.data.objects.TipRate")
+	proto.RegisterType((*PaymentTypeShare)(nil), "nycab.data.objects.PaymentTypeShare")
+	proto.RegisterType((*PaymentTypeMix)(nil), "nycab.data.objects.PaymentTypeMix")
 }
 
 func init() { proto.RegisterFile("objects.proto", fileDescriptor_7da965bc36916fc1) }
 
 var fileDescriptor_7da965bc36916fc1 = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcd, 0x4f, 0xca, 0x4a,
-	0x4d, 0x2e, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0xab, 0x4c, 0x4e, 0x4c,
-	0xd2, 0x4b, 0x49, 0x2c, 0x49, 0xd4, 0x83, 0xca, 0x28, 0x2d, 0x66, 0xe4, 0xe2, 0x0e, 0x29, 0xca,
-	0x2c, 0x28, 0x0e, 0x48, 0x2d, 0x72, 0x49, 0xac, 0x14, 0x0a, 0xe1, 0xe2, 0x2d, 0x01, 0x71, 0xe3,
-	0x0b, 0x52, 0x8b, 0xe2, 0x53, 0x12, 0x2b, 0x25, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0x0c, 0xf4,
-	0x30, 0xf5, 0xea, 0x21, 0xe9, 0x43, 0x66, 0xbb, 0xe6, 0x95, 0x14, 0x55, 0x06, 0x71, 0x97, 0x20,
-	0x44, 0xa4, 0xec, 0xb8, 0x04, 0xd0, 0x15, 0x08, 0x09, 0x70, 0x31, 0x67, 0xa7, 0x82, 0xcc, 0x67,
-	0xd4, 0xe0, 0x0c, 0x02, 0x31, 0x85, 0x44, 0xb8, 0x58, 0xcb, 0x12, 0x73, 0x4a, 0x53, 0x25, 0x98,
-	0x14, 0x18, 0x35, 0x78, 0x83, 0x20, 0x1c, 0x2b, 0x26, 0x0b, 0x46, 0xa5, 0xbd, 0x8c, 0x5c, 0x7c,
-	0xce, 0x89, 0x49, 0xc8, 0x0e, 0xf5, 0xe5, 0xe2, 0x4c, 0x4e, 0x4c, 0x8a, 0x07, 0xdb, 0x82, 0xcf,
-	0x91, 0xa8, 0xda, 0xe0, 0x5c, 0x88, 0x23, 0x39, 0x92, 0xa1, 0x5c, 0xa9, 0x18, 0x2e, 0x5e, 0x14,
-	0x29, 0x2c, 0xce, 0x33, 0x45, 0x76, 0x1e, 0xb7, 0x91, 0x3c, 0x81, 0x20, 0x41, 0x72, 0xbf, 0x93,
-	0x6a, 0x94, 0x72, 0x6e, 0x5e, 0x7e, 0x59, 0x66, 0x72, 0x66, 0xbe, 0x5e, 0x72, 0x7e, 0xae, 0x3e,
-	0x58, 0xa7, 0x3e, 0x38, 0x56, 0x92, 0xf3, 0x73, 0xf4, 0xa1, 0xba, 0x93, 0xd8, 0xc0, 0x22, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0x31, 0x1f, 0x7c, 0xb8, 0x01, 0x00, 0x00,
+	// 2259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0x1b, 0xc9,
+	0xf1, 0xf7, 0x90, 0xe2, 0xab, 0xf8, 0x10, 0x35, 0xeb, 0x07, 0xad, 0x5d, 0x43, 0x36, 0xfd, 0x5f,
+	0xac, 0x57, 0x07, 0x79, 0xff, 0x5e, 0xc4, 0x5e, 0x64, 0x83, 0x0d, 0x28, 0x91, 0xb6, 0x88, 0xf0,
+	0x85, 0x21, 0x25, 0xc7, 0x86, 0x81, 0x41, 0xcf, 0x4c, 0x8b, 0xec, 0x68, 0x38, 0x3d, 0x98, 0x69,
+	0xd2, 0xa2, 0x2f, 0xb9, 0x04, 0x08, 0x02, 0xec, 0x21, 0x40, 0x6e, 0x39, 0xe5, 0x94, 0x53, 0x80,
+	0x3c, 0xee, 0xf9, 0x0c, 0xb9, 0xe6, 0x9c, 0x53, 0xee, 0xf9, 0x00, 0x09, 0xfa, 0x31, 0xe4, 0xf0,
+	0xe5, 0x47, 0xe2, 0xdb, 0xd4, 0xaf, 0xab, 0xaa, 0x7f, 0x55, 0xdd, 0x55, 0x5d, 0x24, 0x14, 0xa9,
+	0xf5, 0x33, 0x6c, 0xb3, 0xf0, 0xc8, 0x0f, 0x28, 0xa3, 0xba, 0xee, 0xcd, 0x6c, 0x64, 0x1d, 0x39,
+	0x88, 0xa1, 0x23, 0xb5, 0x52, 0xfd, 0x53, 0x02, 0xf2, 0x83, 0x80, 0xf8, 0x61, 0x0f, 0x07, 0x75,
+	0x34, 0xd3, 0x07, 0x50, 0x64, 0x5c, 0x34, 0x7d, 0x1c, 0x98, 0x0e, 0x9a, 0x55, 0xb4, 0xbb, 0xc9,
+	0x07, 0xf9, 0x47, 0x5f, 0x1d, 0xad, 0xdb, 0x1e, 0xc5, 0xec, 0xe2, 0xdf, 0x0d, 0x8f, 0x05, 0x33,
+	0x23, 0xcf, 0x62, 0x5e, 0xdb, 0x00, 0x24, 0x34, 0x47, 0xd4, 0x25, 0xdc, 0x65, 0x42, 0xb8, 0x3c,
+	0x7a, 0x97, 0xcb, 0x66, 0x78, 0x2a, 0x0d, 0xa4, 0xc3, 0x1c, 0x89, 0xe4, 0xfd, 0xef, 0xa0, 0xbc,
+	0xba, 0x9f, 0x5e, 0x86, 0xe4, 0x25, 0xe6, 0x74, 0xb5, 0x07, 0x39, 0x83, 0x7f, 0xea, 0xd7, 0x21,
+	0x35, 0x45, 0xee, 0x04, 0x57, 0x12, 0x77, 0xb5, 0x07, 0x45, 0x43, 0x0a, 0x3f, 0x4c, 0x7c, 0xa3,
+	0xed, 0xff, 0x08, 0x4a, 0xcb, 0xce, 0xdf, 0x65, 0x9d, 0x8d, 0x59, 0x57, 0xff, 0xaa, 0x41, 0xe9,
+	0x04, 0x59, 0x83, 0xa5, 0xf8, 0x72, 0x36, 0xb2, 0x4c, 0x11, 0xf2, 0xdb, 0x32, 0xb6, 0x6c, 0x36,
+	0x17, 0x65, 0x80, 0x59, 0x5b, 0x89, 0xfb, 0xaf, 0xa0, 0xb8, 0xb4, 0xb4, 0x81, 0xde, 0x0f, 0xe2,
+	0xf4, 0xf2, 0x8f, 0x0e, 0xde, 0x91, 0xcc, 0x38, 0xff, 0xbf, 0x69, 0xb0, 0x57, 0x0f, 0xc8, 0x14,
+	0x07, 0xf1, 0x10, 0x5e, 0x40, 0xc1, 0x11, 0xe0, 0x52, 0x14, 0x8f, 0x37, 0xf9, 0x5d, 0x33, 0x8e,
+	0x23, 0xea, 0xf4, 0x9d, 0x05, 0xb2, 0x6f, 0x42, 0x79, 0x55, 0xe1, 0xe3, 0x46, 0xb4, 0x0f, 0xe9,
+	0x66, 0xbd, 0x45, 0x42, 0xc6, 0xdd, 0x12, 0x47, 0x92, 0xcf, 0x19, 0xfc, 0xb3, 0xfa, 0xeb, 0x24,
+	0x94, 0x4f, 0x90, 0x25, 0x09, 0xb4, 0x91, 0xef, 0x13, 0x6f, 0xa8, 0x9b, 0xb0, 0x2b, 0x09, 0xca,
+	0x7b, 0x6e, 0x23, 0x4b, 0xc5, 0xfb, 0x64, 0xcb, 0xa9, 0x2d, 0x99, 0xab, 0x70, 0x39, 0x91, 0x13,
+	0x64, 0xc9, 0x80, 0x8b, 0x4e, 0x1c, 0xe3, 0x1b, 0xd8, 0xc8, 0x52, 0x55, 0x24, 0x56, 0x2a, 0x89,
+	0x0f, 0xd8, 0xe0, 0x04, 0x59, 0x22, 0x4c, 0x01, 0xaa, 0x0d, 0xec, 0x38, 0xb6, 0xff, 0x0a, 0xf4,
+	0x75, 0x16, 0x1b, 0xb2, 0xfa, 0xd5, 0x72, 0x56, 0xf7, 0x37, 0x6d, 0x2f, 0x73, 0x17, 0x2f, 0x90,
+	0x57, 0xa0, 0xaf, 0x53, 0xf8, 0x58, 0xde, 0xab, 0x75, 0xc8, 0x3e, 0xc3, 0xb4, 0x47, 0x89, 0xc7,
+	0xf4, 0x7d, 0xc8, 0xba, 0x88, 0x11, 0x36, 0x71, 0xb0, 0x70, 0xac, 0x19, 0x73, 0x59, 0xff, 0x0c,
+	0x72, 0x2e, 0xf5, 0x86, 0x72, 0x31, 0x21, 0x16, 0x17, 0x40, 0xf5, 0x97, 0x1a, 0xe4, 0x8f, 0xe9,
+	0xc4, 0x73, 0x88, 0x37, 0x3c, 0xa6, 0x57, 0xfa, 0xb7, 0x00, 0x21, 0x9d, 0xb0, 0x91, 0xf9, 0x1a,
+	0x87, 0x4c, 0xf8, 0xca, 0x3f, 0xfa, 0x6c, 0x13, 0xa1, 0x68, 0x6f, 0x23, 0x27, 0xf4, 0x9f, 0xe3,
+	0x90, 0x71, 0x63, 0x8f, 0x06, 0x6c, 0x64, 0x62, 0x14, 0xb2, 0x4a, 0xe2, 0x7d, 0x8c, 0x85, 0x7e,
+	0x03, 0x85, 0xac, 0xfa, 0x73, 0xc8, 0x9f, 0x62, 0xc4, 0xc6, 0xc8, 0x3f, 0xc1, 0xae, 0xab, 0x57,
+	0x20, 0x33, 0xc4, 0x74, 0x84, 0xc2, 0x91, 0x4a, 0x55, 0x24, 0xea, 0x77, 0x00, 0x78, 0x71, 0x99,
+	0x36, 0x9d, 0x78, 0x4c, 0xb5, 0xa5, 0x1c, 0x47, 0x4e, 0x38, 0xa0, 0x3f, 0x81, 0xb4, 0xc5, 0x03,
+	0x0a, 0x2b, 0xc9, 0xed, 0x25, 0x10, 0x0b, 0xd9, 0x50, 0xea, 0xd5, 0x3f, 0x6a, 0x50, 0xec, 0xd6,
+	0xdb, 0x88, 0x05, 0xe4, 0x4a, 0x1e, 0xd5, 0x01, 0xe4, 0x69, 0x40, 0x86, 0xc4, 0x33, 0x6d, 0xec,
+	0xba, 0x8a, 0x07, 0x48, 0x48, 0x90, 0xfc, 0x12, 0xca, 0x0e, 0x0e, 0x19, 0xf1, 0x10, 0x23, 0x54,
+	0x69, 0x25, 0x84, 0xd6, 0x6e, 0x0c, 0x17, 0xaa, 0xcb, 0xac, 0x93, 0xab, 0xac, 0xbf, 0x86, 0x9b,
+	0x68, 0x3a, 0x14, 0x5d, 0xc3, 0x74, 0x26, 0x81, 0xf4, 0x17, 0x62, 0x3b, 0xac, 0xec, 0x88, 0x23,
+	0xfb, 0x04, 0x4d, 0x87, 0xbc, 0x6e, 0xeb, 0x6a, 0xad, 0x8f, 0xed, 0xb0, 0xfa, 0x77, 0x0d, 0x52,
+	0xfd, 0x11, 0xb9, 0x60, 0xfa, 0x0d, 0x48, 0xf3, 0xd6, 0x49, 0x1c, 0x45, 0x32, 0x65, 0x23, 0xab,
+	0xe9, 0xe8, 0xf7, 0xa0, 0x30, 0x42, 0xf6, 0xa5, 0xe9, 0x12, 0x1b, 0x7b, 0x21, 0x56, 0xdc, 0xf2,
+	0x1c, 0x6b, 0x49, 0x88, 0xf3, 0x0a, 0x19, 0x0a, 0x98, 0xc9, 0xc8, 0x18, 0x0b, 0x5e, 0x39, 0x23,
+	0x27, 0x90, 0x01, 0x19, 0x63, 0xfd, 0x36, 0x64, 0xb1, 0xe7, 0xc8, 0xc5, 0x1d, 0x79, 0x0e, 0xd8,
+	0x73, 0xc4, 0xd2, 0x72, 0x44, 0xa9, 0xd5, 0x88, 0x0e, 0x20, 0x8f, 0x6c, 0x46, 0xa6, 0x58, 0x86,
+	0x91, 0x16, 0xeb, 0x20, 0x21, 0xce, 0x5e, 0xff, 0x14, 0x72, 0xc4, 0x71, 0xd5, 0x72, 0x46, 0x2c,
+	0x67, 0x39, 0x20, 0x42, 0xfb, 0x97, 0x7a, 0x51, 0x6b, 0x1e, 0x1d, 0x23, 0x77, 0xa6, 0x3f, 0x81,
+	0x1d, 0x36, 0xf3, 0xe5, 0xed, 0x2e, 0x3d, 0xba, 0xbf, 0xad, 0xad, 0x29, 0xf5, 0xc1, 0xcc, 0xc7,
+	0x86, 0x30, 0x88, 0x65, 0x26, 0xf1, 0xb6, 0xcc, 0x24, 0xd7, 0x33, 0x73, 0x00, 0x79, 0x9f, 0xd8,
+	0x97, 0x13, 0x3f, 0x1e, 0x3d, 0x48, 0x48, 0x24, 0xe0, 0x1e, 0x6f, 0xf6, 0xd4, 0xa7, 0x17, 0x17,
+	0x52, 0x23, 0x25, 0x7d, 0x28, 0x4c, 0xa8, 0xdc, 0x97, 0x83, 0x80, 0xe9, 0x90, 0x90, 0x21, 0xcf,
+	0xc6, 0x22, 0x0d, 0x9a, 0x51, 0xe0, 0x60, 0x5d, 0x61, 0xfa, 0x63, 0xb8, 0x65, 0x53, 0xef, 0xc2,
+	0x25, 0x36, 0x23, 0xde, 0xd0, 0x8c, 0x6f, 0x9a, 0x11, 0x2e, 0x6f, 0xc4, 0x96, 0x7b, 0x8b, 0xfd,
+	0xbf, 0x81, 0x4a, 0xdc, 0x6e, 0x29, 0x9e, 0xac, 0x30, 0xbc, 0x19, 0x5b, 0x3f, 0x8d, 0x85, 0x76,
+	0x13, 0xd2, 0x0e, 0x66, 0x88, 0xb8, 0x95, 0x9c, 0xd0, 0x53, 0x52, 0xf5, 0x9f, 0x09, 0x00, 0x9e,
+	0x46, 0x03, 0xdb, 0x34, 0x70, 0xfe, 0x87, 0x5b, 0xb5, 0x92, 0xbb, 0xe4, 0x3b, 0x73, 0xb7, 0xb3,
+	0x9e, 0xbb, 0x06, 0xec, 0x2a, 0x1f, 0x2e, 0xb5, 0xc5, 0xa5, 0xaf, 0xa4, 0xde, 0xa3, 0xa5, 0x94,
+	0xa4, 0x51, 0x4b, 0xd9, 0xe8, 0xcf, 0xa0, 0x1c, 0xed, 0x34, 0xf7, 0x93, 0x7e, 0x0f, 0x3f, 0xbb,
+	0xca, 0x6a, 0xee, 0x68, 0xed, 0x2c, 0x33, 0x1b, 0xce, 0xf2, 0x0b, 0xd8, 0xf5, 0x51, 0x18, 0x62,
+	0x6f, 0xc8, 0x5f, 0x44, 0x51, 0x19, 0x59, 0x71, 0xb5, 0x4b, 0x73, 0x58, 0x94, 0x47, 0xf5, 0xfb,
+	0x84, 0x98, 0x7f, 0xce, 0x18, 0x71, 0xc9, 0x1b, 0xb9, 0xc1, 0x96, 0x74, 0xeb, 0xb0, 0xe3, 0x20,
+	0x16, 0xa5, 0x59, 0x7c, 0xbf, 0xab, 0x9b, 0x7c, 0x0a, 0x39, 0x6b, 0x12, 0xce, 0x16, 0x0d, 0xa4,
+	0x68, 0x64, 0x39, 0x20, 0xea, 0x6e, 0xa5, 0x30, 0x53, 0x6b, 0x85, 0x79, 0x17, 0xf2, 0x93, 0x05,
+	0x2d, 0x75, 0x65, 0xe3, 0x90, 0xfe, 0xff, 0x70, 0x63, 0x31, 0xdf, 0x2a, 0x67, 0x23, 0x3a, 0x09,
+	0x54, 0x4a, 0xf4, 0x68, 0x6a, 0xad, 0x89, 0xa5, 0x53, 0x3a, 0x09, 0xf4, 0x3b, 0x4b, 0xc3, 0x6b,
+	0x56, 0x8c, 0x83, 0x8b, 0x61, 0xb4, 0xfa, 0xbd, 0x26, 0xa7, 0x51, 0xc1, 0xbf, 0x3f, 0x19, 0x8f,
+	0x51, 0x30, 0xe3, 0xd7, 0xd4, 0xc7, 0x01, 0xa1, 0x51, 0x42, 0x94, 0x24, 0x33, 0x32, 0x0b, 0x55,
+	0xef, 0x17, 0xdf, 0x3c, 0x2a, 0x46, 0x19, 0x72, 0xd5, 0xe0, 0xc5, 0x53, 0xb2, 0x63, 0x80, 0x80,
+	0xb8, 0xdf, 0x90, 0x1b, 0x8d, 0x31, 0xf2, 0x54, 0x3f, 0x15, 0xdf, 0xfa, 0x2d, 0xc8, 0x84, 0xcc,
+	0x31, 0x1d, 0x3c, 0x15, 0x69, 0xd0, 0x8c, 0x74, 0xc8, 0x9c, 0x3a, 0x9e, 0x56, 0xff, 0xac, 0x41,
+	0x81, 0x9b, 0xf5, 0x10, 0x63, 0x38, 0xf0, 0xc2, 0x6d, 0x67, 0xd3, 0x84, 0x92, 0x35, 0xe3, 0x13,
+	0xbe, 0x49, 0x2f, 0xcc, 0xd7, 0x18, 0x5f, 0xaa, 0x01, 0xe5, 0xff, 0xb6, 0x35, 0xa8, 0x78, 0x7c,
+	0x46, 0xde, 0x9a, 0xd5, 0xd1, 0xac, 0x7b, 0xf1, 0x1c, 0xe3, 0x4b, 0xfd, 0xc7, 0x90, 0xb5, 0x66,
+	0xe6, 0x98, 0x7a, 0x6c, 0x54, 0x49, 0x7e, 0x80, 0x93, 0x8c, 0x35, 0x6b, 0x73, 0xa3, 0xea, 0xef,
+	0x34, 0x28, 0x88, 0x95, 0xa8, 0x67, 0x7e, 0xc0, 0x7d, 0xba, 0x0e, 0xa9, 0xf8, 0x55, 0x92, 0x02,
+	0x1f, 0x2b, 0xf0, 0x95, 0x8f, 0x6d, 0x86, 0x1d, 0x95, 0xb6, 0xb9, 0xcc, 0x2d, 0x42, 0x9b, 0x06,
+	0x58, 0x25, 0x4e, 0x0a, 0x2b, 0xa7, 0x9c, 0x5e, 0x3d, 0xe5, 0x5f, 0xa9, 0xb4, 0x3e, 0xa5, 0x01,
+	0xb6, 0x51, 0xc8, 0xe6, 0x5c, 0xb4, 0x18, 0x97, 0xf8, 0xae, 0x89, 0xf5, 0x5d, 0x5d, 0xfa, 0x1a,
+	0x07, 0x82, 0xa7, 0x66, 0x48, 0x81, 0xa3, 0x13, 0xdf, 0xc7, 0x81, 0x22, 0x29, 0x85, 0x15, 0x2e,
+	0xa9, 0x55, 0x2e, 0x23, 0xd8, 0x55, 0x3f, 0x0f, 0xe6, 0x6c, 0xb6, 0x24, 0xec, 0x3b, 0xc8, 0x5d,
+	0x28, 0x95, 0x50, 0x9d, 0xef, 0xdd, 0x6d, 0x47, 0x13, 0xf9, 0x32, 0x16, 0x26, 0xd5, 0x4b, 0xb8,
+	0xde, 0x5b, 0x2a, 0xfe, 0xe3, 0x89, 0x7d, 0x89, 0xd9, 0xa6, 0x5e, 0xc1, 0xf7, 0x4d, 0xad, 0xf6,
+	0x0a, 0x1e, 0x9f, 0xbc, 0xd5, 0x09, 0x71, 0xab, 0xa5, 0x20, 0x4e, 0x60, 0x84, 0x02, 0x1c, 0xe5,
+	0x42, 0x08, 0xd5, 0x7f, 0x68, 0xb0, 0xbf, 0xbc, 0x1b, 0xef, 0x4d, 0x01, 0xb1, 0x26, 0x6f, 0xeb,
+	0x31, 0x2b, 0xd5, 0x93, 0x58, 0xab, 0x9e, 0x63, 0xc8, 0x58, 0x82, 0x75, 0xa8, 0x2e, 0xe7, 0x83,
+	0x4d, 0x19, 0xd8, 0x14, 0xa6, 0x11, 0x19, 0xf2, 0x06, 0x4a, 0xbc, 0x29, 0x72, 0x89, 0xa3, 0xb6,
+	0xd9, 0x11, 0xdb, 0x14, 0x14, 0x28, 0x37, 0x8a, 0x29, 0xc9, 0xe8, 0xe4, 0xfd, 0x8a, 0x94, 0xfa,
+	0x22, 0xc8, 0xdf, 0x27, 0x21, 0x7f, 0x8e, 0x3d, 0x87, 0x06, 0x7d, 0x86, 0x98, 0x18, 0x25, 0xa6,
+	0x42, 0x5c, 0x04, 0x96, 0x95, 0x40, 0xd3, 0xd9, 0x30, 0x2f, 0xee, 0xc4, 0x7b, 0xe5, 0x3d, 0x28,
+	0xf0, 0xc9, 0x6b, 0xde, 0xd5, 0x65, 0x36, 0xf3, 0x68, 0x3a, 0x9c, 0x37, 0xf5, 0x23, 0xf8, 0xe4,
+	0x0d, 0x0e, 0xe8, 0x62, 0x30, 0x8b, 0xd3, 0xdf, 0xe3, 0x4b, 0xd1, 0x58, 0x26, 0x63, 0x78, 0x0c,
+	0xb7, 0xc8, 0xd8, 0x77, 0xd1, 0x24, 0x24, 0x16, 0x1f, 0x70, 0x7c, 0x8c, 0xa3, 0x90, 0x53, 0xc2,
+	0xe6, 0x46, 0x6c, 0xb9, 0xcf, 0x57, 0xa5, 0x5d, 0x0d, 0xee, 0x44, 0xb1, 0xaf, 0x5c, 0x0c, 0x65,
+	0x9d, 0x16, 0xd6, 0xfb, 0x4a, 0x69, 0x39, 0xdb, 0x73, 0x17, 0x63, 0x12, 0x86, 0xb1, 0x39, 0x22,
+	0x7a, 0xf4, 0x94, 0x8b, 0x8c, 0x74, 0xa1, 0x94, 0x7a, 0x4b, 0x6f, 0xa5, 0x74, 0xf1, 0x05, 0xec,
+	0x22, 0xd1, 0x41, 0xe8, 0x24, 0x54, 0x46, 0x59, 0x61, 0x54, 0x9a, 0xc3, 0x52, 0x91, 0x67, 0x4e,
+	0x20, 0x33, 0x33, 0xe0, 0x45, 0x9c, 0x53, 0x99, 0x93, 0x98, 0x81, 0x18, 0xae, 0xfe, 0x56, 0x83,
+	0xe2, 0x4b, 0xea, 0xe1, 0x79, 0xd7, 0xe2, 0x15, 0xff, 0x86, 0x7a, 0xf3, 0x8a, 0xe7, 0xdf, 0xdb,
+	0x66, 0xb4, 0xa8, 0x39, 0x24, 0x63, 0xcd, 0xa1, 0x02, 0x19, 0x19, 0x57, 0xf4, 0xae, 0x45, 0x22,
+	0x6f, 0x1b, 0xea, 0xc5, 0x8e, 0xde, 0xb4, 0xb9, 0xbc, 0x28, 0x20, 0x39, 0x85, 0x4a, 0xa1, 0xfa,
+	0x07, 0x0d, 0xf6, 0x06, 0xe8, 0x8a, 0x2c, 0x13, 0x3c, 0x80, 0xfc, 0x3c, 0x65, 0xf3, 0xdb, 0x04,
+	0x11, 0x24, 0x69, 0x89, 0x08, 0x12, 0xb1, 0x08, 0x2a, 0x90, 0xb1, 0x68, 0x40, 0x27, 0xc3, 0x91,
+	0x62, 0x1b, 0x89, 0xf3, 0x20, 0x76, 0x36, 0x07, 0x91, 0xda, 0x1e, 0x44, 0x7a, 0x39, 0x08, 0xde,
+	0xdf, 0x79, 0xc7, 0xe2, 0x6c, 0x4f, 0xe8, 0x14, 0x07, 0x68, 0x88, 0xb7, 0x95, 0xf3, 0x52, 0xc3,
+	0x88, 0xe2, 0xe5, 0xa5, 0xc5, 0xc9, 0x86, 0xe6, 0x94, 0x84, 0x84, 0x77, 0x57, 0xd9, 0xec, 0x0b,
+	0x02, 0x3c, 0x97, 0x98, 0xfe, 0x2d, 0xa4, 0x84, 0x5c, 0xd9, 0x11, 0x65, 0xfe, 0xf9, 0xc6, 0x46,
+	0xb7, 0x9a, 0x34, 0x43, 0xda, 0x54, 0xff, 0x92, 0x00, 0x38, 0x41, 0x96, 0x81, 0xa7, 0xd8, 0x9b,
+	0xe0, 0x8f, 0x38, 0xd0, 0x1c, 0x40, 0xfe, 0x02, 0x05, 0xd8, 0x44, 0x63, 0xb1, 0x2e, 0xfb, 0x3c,
+	0x70, 0xa8, 0x26, 0x10, 0xfe, 0x2b, 0x37, 0x9c, 0x04, 0xf6, 0x08, 0x05, 0xc3, 0xa8, 0x65, 0x2c,
+	0x00, 0xfe, 0xce, 0x8f, 0x19, 0x32, 0x19, 0xba, 0x52, 0xd3, 0x4c, 0x7a, 0xcc, 0xd0, 0x00, 0x5d,
+	0x89, 0x6d, 0x89, 0x1f, 0xb9, 0x95, 0xd3, 0x4b, 0x8e, 0x11, 0x5f, 0x79, 0xbd, 0x07, 0x05, 0x46,
+	0x5d, 0x37, 0x8c, 0x14, 0xb2, 0xf2, 0x86, 0x0b, 0x2c, 0xae, 0xc2, 0x3b, 0xa7, 0x52, 0xc9, 0x45,
+	0x2a, 0x0c, 0xb9, 0x4a, 0x65, 0xf9, 0x21, 0x82, 0xd5, 0x87, 0xe8, 0xdf, 0x1a, 0x64, 0x06, 0xc4,
+	0xe7, 0xf5, 0xf2, 0x5e, 0xe7, 0x39, 0x7f, 0x00, 0xee, 0x00, 0xd8, 0x28, 0x70, 0x96, 0x26, 0x9e,
+	0x1c, 0x47, 0x64, 0x79, 0x1e, 0xc2, 0x1e, 0x23, 0xbe, 0x8f, 0x1d, 0x33, 0xa6, 0x25, 0x7b, 0xd6,
+	0xae, 0x5c, 0x38, 0x99, 0xeb, 0x2e, 0xe7, 0x21, 0xb5, 0x9a, 0x87, 0x95, 0xf4, 0xa7, 0xd7, 0xd2,
+	0x7f, 0x1b, 0xb2, 0xdc, 0x5e, 0xb4, 0x01, 0x99, 0xc5, 0x0c, 0x53, 0x21, 0xf1, 0x04, 0x49, 0x1a,
+	0xb2, 0x9f, 0x47, 0x39, 0x14, 0x98, 0x6c, 0xe7, 0xbf, 0xd0, 0xa0, 0xdc, 0x43, 0xb3, 0x31, 0xf6,
+	0x18, 0xff, 0xe5, 0x26, 0x40, 0x6e, 0xe7, 0x4b, 0xcc, 0x9c, 0xff, 0xf2, 0xcb, 0x19, 0x79, 0x7f,
+	0xa1, 0xf7, 0x21, 0xef, 0xe2, 0xda, 0x39, 0xed, 0xac, 0x9d, 0x53, 0xf5, 0x37, 0x1a, 0x94, 0x62,
+	0x34, 0xda, 0xe4, 0xea, 0xbf, 0x7e, 0x2e, 0x9b, 0x50, 0x8c, 0x93, 0x0f, 0xdf, 0x36, 0xd1, 0xad,
+	0x46, 0x6e, 0x14, 0x62, 0x31, 0x86, 0x87, 0x5f, 0x42, 0xa6, 0x8e, 0x18, 0x0a, 0x31, 0xd3, 0x01,
+	0xd2, 0x2f, 0x1a, 0xad, 0x56, 0xf7, 0x79, 0xf9, 0x9a, 0x9e, 0x83, 0xd4, 0x33, 0xa3, 0xd1, 0xe8,
+	0x94, 0x35, 0x3d, 0x03, 0xc9, 0xa7, 0xa7, 0xe7, 0xe5, 0xc4, 0x61, 0x1b, 0x8a, 0xea, 0x52, 0x3d,
+	0x25, 0x2e, 0x13, 0x83, 0x51, 0xb9, 0xd9, 0x39, 0x69, 0x9d, 0xd5, 0x1b, 0xe6, 0x69, 0xb7, 0xd5,
+	0xac, 0xd7, 0x5e, 0xf4, 0xcb, 0xd7, 0xf4, 0x32, 0x14, 0x06, 0xb5, 0x67, 0x0b, 0x44, 0xe3, 0x7a,
+	0x8d, 0x9f, 0xae, 0xe8, 0x25, 0x0e, 0x6b, 0x50, 0x3a, 0x46, 0x21, 0x76, 0x89, 0x87, 0xdb, 0x98,
+	0x8d, 0xa8, 0xc3, 0x2d, 0x8d, 0x6e, 0xab, 0xd5, 0xec, 0x3c, 0x33, 0xdb, 0x8d, 0x5a, 0xa7, 0x7c,
+	0x4d, 0xbf, 0x03, 0xb7, 0xdb, 0x8d, 0x7a, 0xb3, 0xd6, 0x31, 0x6b, 0xc7, 0xfd, 0x6e, 0xeb, 0x6c,
+	0xd0, 0x30, 0xeb, 0x8d, 0xf3, 0x66, 0x6d, 0xd0, 0xec, 0x76, 0xca, 0xda, 0xe1, 0x63, 0x28, 0x45,
+	0x13, 0x91, 0x72, 0xa1, 0x43, 0xa9, 0xdf, 0xa8, 0xf5, 0xbb, 0x9d, 0x5a, 0xcb, 0xec, 0xd4, 0x9a,
+	0xe7, 0x0d, 0x49, 0xe8, 0xb4, 0xdb, 0x1a, 0x98, 0xcf, 0x9b, 0x9d, 0x41, 0xc3, 0xe8, 0x97, 0xb5,
+	0xc3, 0x21, 0xec, 0xae, 0xfc, 0x9c, 0xd7, 0x3f, 0x81, 0xdd, 0xb3, 0xce, 0x4f, 0x3a, 0xdd, 0xe7,
+	0x1d, 0xb3, 0xd6, 0xe9, 0xb6, 0x6b, 0xad, 0x17, 0xe5, 0x6b, 0xfa, 0x0d, 0xd8, 0xeb, 0x9e, 0x37,
+	0x8c, 0x56, 0xad, 0xd7, 0xe3, 0xa4, 0x06, 0x46, 0xb3, 0xc7, 0xe3, 0xd9, 0x83, 0xe2, 0xcb, 0x86,
+	0xd1, 0x35, 0xeb, 0x67, 0x86, 0x64, 0x92, 0xe0, 0x9a, 0xcd, 0x76, 0xaf, 0x55, 0x3b, 0xeb, 0x37,
+	0x8f, 0x5b, 0x0d, 0xb3, 0xdf, 0x6b, 0x34, 0xea, 0xe5, 0xe4, 0xf1, 0xe7, 0x2f, 0xef, 0x8f, 0x3d,
+	0x3a, 0x25, 0x36, 0xa1, 0x47, 0x36, 0x1d, 0x3f, 0x14, 0xe7, 0xf3, 0x50, 0xfc, 0xd3, 0x6f, 0x53,
+	0xf7, 0xa1, 0x3a, 0x23, 0x2b, 0x2d, 0x90, 0xaf, 0xff, 0x33, 0x00, 0xc4, 0x80, 0xf0, 0xfc, 0x0c,
+	0x18, 0x00, 0x00,
 }
@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: objectsV2.proto
+
+package objects
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	date "google.golang.org/genproto/googleapis/type/date"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// CabTripCount is the number of trips a particular cab has made in a given day
+// More per-day metrics may be added, clients should ignore unknown fields
+type CabTripCount struct {
+	CabId                string     `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	Date                 *date.Date `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	TripCount            uint32     `protobuf:"varint,3,opt,name=trip_count,json=tripCount,proto3" json:"trip_count,omitempty"`
+	IsHoliday            bool       `protobuf:"varint,4,opt,name=is_holiday,json=isHoliday,proto3" json:"is_holiday,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CabTripCount) Reset()         { *m = CabTripCount{} }
+func (m *CabTripCount) String() string { return proto.CompactTextString(m) }
+func (*CabTripCount) ProtoMessage()    {}
+func (*CabTripCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5798a0d2fca5454, []int{0}
+}
+
+func (m *CabTripCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CabTripCount.Unmarshal(m, b)
+}
+func (m *CabTripCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CabTripCount.Marshal(b, m, deterministic)
+}
+func (m *CabTripCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CabTripCount.Merge(m, src)
+}
+func (m *CabTripCount) XXX_Size() int {
+	return xxx_messageInfo_CabTripCount.Size(m)
+}
+func (m *CabTripCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_CabTripCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CabTripCount proto.InternalMessageInfo
+
+func (m *CabTripCount) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *CabTripCount) GetDate() *date.Date {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *CabTripCount) GetTripCount() uint32 {
+	if m != nil {
+		return m.TripCount
+	}
+	return 0
+}
+
+func (m *CabTripCount) GetIsHoliday() bool {
+	if m != nil {
+		return m.IsHoliday
+	}
+	return false
+}
+
+// DriverTripCount is the number of trips a particular driver has made in a given day
+// More per-day metrics may be added, clients should ignore unknown fields
+type DriverTripCount struct {
+	HackLicense          string     `protobuf:"bytes,1,opt,name=hack_license,json=hackLicense,proto3" json:"hack_license,omitempty"`
+	Date                 *date.Date `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	TripCount            uint32     `protobuf:"varint,3,opt,name=trip_count,json=tripCount,proto3" json:"trip_count,omitempty"`
+	IsHoliday            bool       `protobuf:"varint,4,opt,name=is_holiday,json=isHoliday,proto3" json:"is_holiday,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *DriverTripCount) Reset()         { *m = DriverTripCount{} }
+func (m *DriverTripCount) String() string { return proto.CompactTextString(m) }
+func (*DriverTripCount) ProtoMessage()    {}
+func (*DriverTripCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5798a0d2fca5454, []int{1}
+}
+
+func (m *DriverTripCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DriverTripCount.Unmarshal(m, b)
+}
+func (m *DriverTripCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DriverTripCount.Marshal(b, m, deterministic)
+}
+func (m *DriverTripCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DriverTripCount.Merge(m, src)
+}
+func (m *DriverTripCount) XXX_Size() int {
+	return xxx_messageInfo_DriverTripCount.Size(m)
+}
+func (m *DriverTripCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DriverTripCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DriverTripCount proto.InternalMessageInfo
+
+func (m *DriverTripCount) GetHackLicense() string {
+	if m != nil {
+		return m.HackLicense
+	}
+	return ""
+}
+
+func (m *DriverTripCount) GetDate() *date.Date {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *DriverTripCount) GetTripCount() uint32 {
+	if m != nil {
+		return m.TripCount
+	}
+	return 0
+}
+
+func (m *DriverTripCount) GetIsHoliday() bool {
+	if m != nil {
+		return m.IsHoliday
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*CabTripCount)(nil), "nycab.data.objects.CabTripCount")
+	proto.RegisterType((*DriverTripCount)(nil), "nycab.data.objects.DriverTripCount")
+}
+
+func init() { proto.RegisterFile("objectsV2.proto", fileDescriptor_b5798a0d2fca5454) }
+
+var fileDescriptor_b5798a0d2fca5454 = []byte{
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x90, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0xc6, 0x59, 0xad, 0xc5, 0x4e, 0x2b, 0xc5, 0x80, 0x12, 0x84, 0x42, 0xac, 0x14, 0x72, 0xda,
+	0x40, 0x7d, 0x03, 0xdb, 0x83, 0x82, 0xa7, 0x20, 0x1e, 0xbc, 0x84, 0xd9, 0xc9, 0xd2, 0x8e, 0xa6,
+	0x99, 0xb0, 0x59, 0x0b, 0x79, 0x05, 0xdf, 0xc0, 0xb7, 0x95, 0xfc, 0x41, 0xdf, 0xc0, 0xeb, 0xef,
+	0x9b, 0xf9, 0xf1, 0xf1, 0xc1, 0x5c, 0xcc, 0xbb, 0x25, 0x5f, 0xbf, 0xae, 0x75, 0xe5, 0xc4, 0x4b,
+	0x10, 0x94, 0x0d, 0xa1, 0xd1, 0x39, 0x7a, 0xd4, 0x43, 0x76, 0x73, 0xbd, 0x13, 0xd9, 0x15, 0x36,
+	0xf1, 0x4d, 0x65, 0x93, 0x1c, 0xbd, 0xed, 0x6f, 0x97, 0x5f, 0x0a, 0x66, 0x1b, 0x34, 0x2f, 0x8e,
+	0xab, 0x8d, 0x7c, 0x96, 0x3e, 0xb8, 0x82, 0x31, 0xa1, 0xc9, 0x38, 0x0f, 0x55, 0xa4, 0xe2, 0x49,
+	0x7a, 0x46, 0x68, 0x9e, 0xf2, 0x60, 0x05, 0xa3, 0xf6, 0x2b, 0x3c, 0x89, 0x54, 0x3c, 0x5d, 0x5f,
+	0xea, 0x5e, 0xa7, 0x5b, 0x9d, 0xde, 0xa2, 0xb7, 0x69, 0x17, 0x07, 0x0b, 0x00, 0xef, 0xb8, 0xca,
+	0xa8, 0x75, 0x85, 0xa7, 0x91, 0x8a, 0x2f, 0xd2, 0x89, 0xff, 0x95, 0x2f, 0x00, 0xb8, 0xce, 0xf6,
+	0x52, 0x70, 0x8e, 0x4d, 0x38, 0x8a, 0x54, 0x7c, 0x9e, 0x4e, 0xb8, 0x7e, 0xec, 0xc1, 0xf2, 0x5b,
+	0xc1, 0x7c, 0xeb, 0xf8, 0x68, 0xdd, 0x5f, 0x9f, 0x5b, 0x98, 0xed, 0x91, 0x3e, 0xb2, 0x82, 0xc9,
+	0x96, 0xb5, 0x1d, 0x5a, 0x4d, 0x5b, 0xf6, 0xdc, 0xa3, 0x7f, 0xe9, 0xf6, 0xb0, 0x7a, 0xbb, 0x3b,
+	0x94, 0x72, 0x64, 0x62, 0xd1, 0x24, 0x87, 0xa4, 0xdb, 0x38, 0xe9, 0x46, 0x24, 0x29, 0x92, 0x61,
+	0x67, 0x33, 0xee, 0xc8, 0xfd, 0xcf, 0x00, 0x7f, 0x9d, 0xff, 0x24, 0x95, 0x01, 0x00, 0x00,
+}
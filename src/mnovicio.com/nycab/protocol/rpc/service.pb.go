@@ -4,12 +4,14 @@
 package rpc
 
 import (
+	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
-	context "golang.org/x/net/context"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
 	math "math"
 	objects "mnovicio.com/nycab/protocol/objects"
 )
@@ -23,13 +25,108 @@ var _ = math.Inf
 // is compatible with the proto package it is being compiled against.
 // A compilation error at this line likely means your copy of the
 // proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryJobState is the state of an asynchronous query job
+type QueryJobState int32
+
+const (
+	QueryJobState_PENDING   QueryJobState = 0
+	QueryJobState_RUNNING   QueryJobState = 1
+	QueryJobState_SUCCEEDED QueryJobState = 2
+	QueryJobState_FAILED    QueryJobState = 3
+	QueryJobState_CANCELLED QueryJobState = 4
+)
+
+var QueryJobState_name = map[int32]string{
+	0: "PENDING",
+	1: "RUNNING",
+	2: "SUCCEEDED",
+	3: "FAILED",
+	4: "CANCELLED",
+}
+
+var QueryJobState_value = map[string]int32{
+	"PENDING":   0,
+	"RUNNING":   1,
+	"SUCCEEDED": 2,
+	"FAILED":    3,
+	"CANCELLED": 4,
+}
+
+func (x QueryJobState) String() string {
+	return proto.EnumName(QueryJobState_name, int32(x))
+}
+
+func (QueryJobState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{0}
+}
+
+// ExportFormat is the file format of exported query results
+type ExportFormat int32
+
+const (
+	ExportFormat_CSV     ExportFormat = 0
+	ExportFormat_PARQUET ExportFormat = 1
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "CSV",
+	1: "PARQUET",
+}
+
+var ExportFormat_value = map[string]int32{
+	"CSV":     0,
+	"PARQUET": 1,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{1}
+}
+
+// WatchTrigger tells why watched trip counts were sent
+type WatchTrigger int32
+
+const (
+	WatchTrigger_STARTED         WatchTrigger = 0
+	WatchTrigger_TRIPS_IMPORTED  WatchTrigger = 1
+	WatchTrigger_CACHE_CLEARED   WatchTrigger = 2
+	WatchTrigger_CACHE_REFRESHED WatchTrigger = 3
+)
+
+var WatchTrigger_name = map[int32]string{
+	0: "STARTED",
+	1: "TRIPS_IMPORTED",
+	2: "CACHE_CLEARED",
+	3: "CACHE_REFRESHED",
+}
+
+var WatchTrigger_value = map[string]int32{
+	"STARTED":         0,
+	"TRIPS_IMPORTED":  1,
+	"CACHE_CLEARED":   2,
+	"CACHE_REFRESHED": 3,
+}
+
+func (x WatchTrigger) String() string {
+	return proto.EnumName(WatchTrigger_name, int32(x))
+}
+
+func (WatchTrigger) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{2}
+}
 
 type GetAllCabTripsRequestV1 struct {
-	IgnoreCache          bool     `protobuf:"varint,1,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	IgnoreCache          bool                  `protobuf:"varint,1,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,2,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,3,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *GetAllCabTripsRequestV1) Reset()         { *m = GetAllCabTripsRequestV1{} }
@@ -64,8 +161,23 @@ func (m *GetAllCabTripsRequestV1) GetIgnoreCache() bool {
 	return false
 }
 
+func (m *GetAllCabTripsRequestV1) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetAllCabTripsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
 type GetAllCabTripsResponseV1 struct {
 	CabTripsPerDay       *objects.CabTripsPerDay `protobuf:"bytes,1,opt,name=cab_trips_per_day,json=cabTripsPerDay,proto3" json:"cab_trips_per_day,omitempty"`
+	Error                string                  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -103,11 +215,19 @@ func (m *GetAllCabTripsResponseV1) GetCabTripsPerDay() *objects.CabTripsPerDay {
 	return nil
 }
 
+func (m *GetAllCabTripsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type ClearCacheRequestV1 struct {
-	ClearCache           bool     `protobuf:"varint,1,opt,name=clear_cache,json=clearCache,proto3" json:"clear_cache,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ClearCache           bool            `protobuf:"varint,1,opt,name=clear_cache,json=clearCache,proto3" json:"clear_cache,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,2,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *ClearCacheRequestV1) Reset()         { *m = ClearCacheRequestV1{} }
@@ -142,8 +262,16 @@ func (m *ClearCacheRequestV1) GetClearCache() bool {
 	return false
 }
 
+func (m *ClearCacheRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
 type ClearCacheResponseV1 struct {
 	CacheCleared         bool     `protobuf:"varint,1,opt,name=cache_cleared,json=cacheCleared,proto3" json:"cache_cleared,omitempty"`
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -181,13 +309,22 @@ func (m *ClearCacheResponseV1) GetCacheCleared() bool {
 	return false
 }
 
+func (m *ClearCacheResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type GetTripCountsForCabIDsRequestV1 struct {
-	CabIds               []string `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
-	IgnoreCache          bool     `protobuf:"varint,2,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
-	PickupDate           string   `protobuf:"bytes,3,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,2,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	PickupDate           string                `protobuf:"bytes,3,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,4,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *GetTripCountsForCabIDsRequestV1) Reset()         { *m = GetTripCountsForCabIDsRequestV1{} }
@@ -236,6 +373,20 @@ func (m *GetTripCountsForCabIDsRequestV1) GetPickupDate() string {
 	return ""
 }
 
+func (m *GetTripCountsForCabIDsRequestV1) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetTripCountsForCabIDsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
 type GetTripCountsForCabIDsResponseV1 struct {
 	CabTripsPerDay       *objects.CabTripsPerDay `protobuf:"bytes,1,opt,name=cab_trips_per_day,json=cabTripsPerDay,proto3" json:"cab_trips_per_day,omitempty"`
 	Error                string                  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -283,181 +434,5550 @@ func (m *GetTripCountsForCabIDsResponseV1) GetError() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*GetAllCabTripsRequestV1)(nil), "nycab.rpc.GetAllCabTripsRequestV1")
-	proto.RegisterType((*GetAllCabTripsResponseV1)(nil), "nycab.rpc.GetAllCabTripsResponseV1")
-	proto.RegisterType((*ClearCacheRequestV1)(nil), "nycab.rpc.ClearCacheRequestV1")
-	proto.RegisterType((*ClearCacheResponseV1)(nil), "nycab.rpc.ClearCacheResponseV1")
-	proto.RegisterType((*GetTripCountsForCabIDsRequestV1)(nil), "nycab.rpc.GetTripCountsForCabIDsRequestV1")
-	proto.RegisterType((*GetTripCountsForCabIDsResponseV1)(nil), "nycab.rpc.GetTripCountsForCabIDsResponseV1")
+type GetTripCountsForHackLicensesRequestV1 struct {
+	HackLicenses         []string              `protobuf:"bytes,1,rep,name=hack_licenses,json=hackLicenses,proto3" json:"hack_licenses,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,2,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	PickupDate           string                `protobuf:"bytes,3,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,4,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func init() { proto.RegisterFile("service.proto", fileDescriptor_a0b84a42fa06f626) }
+func (m *GetTripCountsForHackLicensesRequestV1) Reset()         { *m = GetTripCountsForHackLicensesRequestV1{} }
+func (m *GetTripCountsForHackLicensesRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetTripCountsForHackLicensesRequestV1) ProtoMessage()    {}
+func (*GetTripCountsForHackLicensesRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{6}
+}
 
-var fileDescriptor_a0b84a42fa06f626 = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6f, 0x13, 0x3b,
-	0x10, 0xd6, 0x26, 0xef, 0xb5, 0x2f, 0x6e, 0x5a, 0xbd, 0xee, 0xab, 0x5e, 0xd2, 0x08, 0x35, 0xcb,
-	0xb6, 0x87, 0x2a, 0xd0, 0xdd, 0x26, 0x54, 0x1c, 0x52, 0x0e, 0x94, 0xad, 0xa8, 0x7a, 0xa0, 0x42,
-	0x0b, 0x8a, 0x54, 0x0e, 0x44, 0x5e, 0xef, 0x68, 0xe3, 0xb2, 0xb1, 0x8d, 0xed, 0xb4, 0xe4, 0x52,
-	0x21, 0x2e, 0xdc, 0xe1, 0x04, 0xe2, 0xbf, 0xe2, 0xc8, 0x95, 0x3f, 0x04, 0xad, 0x37, 0x49, 0x93,
-	0xfe, 0x12, 0x17, 0x4e, 0x96, 0x3f, 0x7f, 0x33, 0xf3, 0xcd, 0xcc, 0x27, 0xa3, 0x45, 0x05, 0xf2,
-	0x94, 0x12, 0xf0, 0x84, 0xe4, 0x9a, 0xdb, 0x25, 0x36, 0x24, 0x38, 0xf2, 0xa4, 0x20, 0xb5, 0x3b,
-	0x09, 0xe7, 0x49, 0x0a, 0x3e, 0x16, 0xd4, 0xc7, 0x8c, 0x71, 0x8d, 0x35, 0xe5, 0x4c, 0xe5, 0xc4,
-	0xda, 0x7d, 0x73, 0x90, 0xad, 0x04, 0xd8, 0x96, 0x3a, 0xc3, 0x49, 0x02, 0xd2, 0xe7, 0xc2, 0x30,
-	0xae, 0x61, 0x6f, 0x98, 0xb4, 0x7e, 0x1e, 0xc3, 0x53, 0x9f, 0x47, 0x27, 0x40, 0xb4, 0x1a, 0x9f,
-	0x39, 0xcb, 0x7d, 0x84, 0x2a, 0x07, 0xa0, 0xf7, 0xd2, 0x34, 0xc0, 0xd1, 0x4b, 0x49, 0x85, 0x0a,
-	0xe1, 0xed, 0x00, 0x94, 0xee, 0x34, 0xed, 0xbb, 0xa8, 0x4c, 0x13, 0xc6, 0x25, 0x74, 0x09, 0x26,
-	0x3d, 0xa8, 0x5a, 0x8e, 0xb5, 0xf9, 0x4f, 0xb8, 0x90, 0x63, 0x41, 0x06, 0xb9, 0x14, 0x55, 0x2f,
-	0x47, 0x2b, 0xc1, 0x99, 0x82, 0x4e, 0xd3, 0x7e, 0x86, 0x96, 0x09, 0x8e, 0xba, 0x3a, 0x83, 0xbb,
-	0x02, 0x64, 0x37, 0xc6, 0x43, 0x93, 0x63, 0xa1, 0xe5, 0x7a, 0x79, 0xcb, 0x31, 0xd6, 0xd8, 0x1b,
-	0xeb, 0x19, 0xa7, 0x78, 0x0e, 0x72, 0x1f, 0x0f, 0xc3, 0x25, 0x32, 0x73, 0x77, 0x1f, 0xa2, 0xff,
-	0x82, 0x14, 0xb0, 0x34, 0x85, 0x2f, 0x44, 0xd6, 0xd1, 0x02, 0xc9, 0xe0, 0x19, 0x8d, 0x88, 0x4c,
-	0x98, 0xee, 0x2e, 0x5a, 0x99, 0x8e, 0x9b, 0xc8, 0x5b, 0x47, 0x8b, 0x26, 0xa4, 0x6b, 0xb8, 0x10,
-	0x8f, 0x42, 0xcb, 0x06, 0x0c, 0x72, 0xcc, 0x3d, 0x47, 0xf5, 0x03, 0xd0, 0x99, 0x8c, 0x80, 0x0f,
-	0x98, 0x56, 0x4f, 0xb9, 0x0c, 0x70, 0x74, 0xb8, 0x3f, 0x35, 0xa5, 0x0a, 0x9a, 0xcf, 0xda, 0xa4,
-	0xb1, 0xaa, 0x5a, 0x4e, 0x71, 0xb3, 0x14, 0xce, 0x11, 0x1c, 0x1d, 0xc6, 0xea, 0xca, 0xf8, 0x0a,
-	0x57, 0xc6, 0x97, 0x89, 0x17, 0x94, 0xbc, 0x19, 0x88, 0x6e, 0x8c, 0x35, 0x54, 0x8b, 0x8e, 0xb5,
-	0x59, 0x0a, 0x51, 0x0e, 0xed, 0x63, 0x0d, 0xee, 0x47, 0x0b, 0x39, 0x37, 0x09, 0xf8, 0x43, 0x83,
-	0xb6, 0x57, 0xd0, 0xdf, 0x20, 0x25, 0x97, 0x46, 0x70, 0x29, 0xcc, 0x2f, 0xad, 0x6f, 0x45, 0x54,
-	0x3e, 0x3a, 0x0e, 0x70, 0xf4, 0x22, 0xf7, 0xae, 0x7d, 0x8e, 0x6a, 0x33, 0xab, 0x37, 0xfa, 0xf2,
-	0x14, 0x9d, 0xa6, 0x3d, 0x2e, 0x2c, 0x05, 0xf1, 0x6e, 0xf0, 0x57, 0x6d, 0xfd, 0x16, 0xce, 0xb8,
-	0x39, 0xb7, 0xf2, 0xe1, 0xfb, 0xcf, 0xcf, 0x85, 0x65, 0xb7, 0xec, 0x9f, 0x36, 0x7d, 0x82, 0x23,
-	0xd3, 0x65, 0xdb, 0x6a, 0xd8, 0x02, 0x95, 0x2f, 0xf6, 0xda, 0x69, 0xda, 0x6b, 0x53, 0xd9, 0xae,
-	0x31, 0x4a, 0xad, 0x7e, 0xc3, 0xfb, 0xa4, 0x52, 0xdd, 0x54, 0x5a, 0xb5, 0x2b, 0xd3, 0x95, 0x7c,
-	0xe3, 0x0e, 0xb3, 0x40, 0xfb, 0x8b, 0x65, 0xdc, 0x7e, 0xcd, 0x32, 0x3a, 0x4d, 0xbb, 0x31, 0xdb,
-	0xcc, 0x6d, 0x96, 0xa9, 0xdd, 0xfb, 0x0d, 0xee, 0x44, 0xd6, 0x86, 0x91, 0xb5, 0xe6, 0xae, 0xce,
-	0xc8, 0x8a, 0x86, 0xb9, 0x4b, 0x32, 0xdf, 0xb4, 0xad, 0xc6, 0x93, 0xf7, 0x85, 0x4f, 0x7b, 0x3f,
-	0x2c, 0x7b, 0x80, 0x96, 0x8e, 0x8e, 0x9d, 0x00, 0x47, 0xce, 0xe8, 0x8b, 0x71, 0x5f, 0xa3, 0xff,
-	0x47, 0xc8, 0x68, 0x71, 0x8e, 0x90, 0x3c, 0xf3, 0x81, 0xed, 0xf6, 0xb4, 0x16, 0xaa, 0xed, 0xfb,
-	0x09, 0xd5, 0xbd, 0x41, 0xe4, 0x11, 0xde, 0xf7, 0xfb, 0x8c, 0x9f, 0x52, 0x42, 0xb9, 0xcf, 0x86,
-	0x5d, 0x82, 0xa3, 0x9a, 0xd3, 0xa7, 0xa4, 0x87, 0x21, 0xf5, 0x30, 0x4b, 0x20, 0xe5, 0xde, 0xe8,
-	0xf9, 0x71, 0xd2, 0xc7, 0x34, 0xcd, 0x22, 0x5a, 0xc5, 0xa6, 0xb7, 0xdd, 0xb0, 0xac, 0xd6, 0xbf,
-	0x58, 0x88, 0x94, 0x12, 0xf3, 0x03, 0xf9, 0x27, 0x8a, 0xb3, 0xf6, 0x15, 0x24, 0xdc, 0x45, 0xc5,
-	0x9d, 0xed, 0x1d, 0x7b, 0x07, 0x35, 0x42, 0xd0, 0x03, 0xc9, 0x20, 0x76, 0xce, 0x7a, 0xc0, 0x1c,
-	0xdd, 0x03, 0x47, 0x82, 0xe2, 0x03, 0x49, 0xc0, 0x89, 0x39, 0x28, 0x87, 0x71, 0xed, 0xc0, 0x3b,
-	0xaa, 0xb4, 0x67, 0xcf, 0xa1, 0xbf, 0xbe, 0x16, 0xac, 0xf9, 0x57, 0xf5, 0xb1, 0x36, 0x23, 0xf4,
-	0xd2, 0xdf, 0x26, 0x05, 0x89, 0xe6, 0xcc, 0xed, 0xc1, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5e,
-	0xce, 0x47, 0xd9, 0x5e, 0x05, 0x00, 0x00,
+func (m *GetTripCountsForHackLicensesRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTripCountsForHackLicensesRequestV1.Unmarshal(m, b)
+}
+func (m *GetTripCountsForHackLicensesRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTripCountsForHackLicensesRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetTripCountsForHackLicensesRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTripCountsForHackLicensesRequestV1.Merge(m, src)
+}
+func (m *GetTripCountsForHackLicensesRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetTripCountsForHackLicensesRequestV1.Size(m)
+}
+func (m *GetTripCountsForHackLicensesRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTripCountsForHackLicensesRequestV1.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_GetTripCountsForHackLicensesRequestV1 proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetTripCountsForHackLicensesRequestV1) GetHackLicenses() []string {
+	if m != nil {
+		return m.HackLicenses
+	}
+	return nil
+}
 
-// NYCabServiceClient is the client API for NYCabService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NYCabServiceClient interface {
-	GetAllCabTripCountPerDayV1(ctx context.Context, in *GetAllCabTripsRequestV1, opts ...grpc.CallOption) (*GetAllCabTripsResponseV1, error)
-	ClearCacheV1(ctx context.Context, in *ClearCacheRequestV1, opts ...grpc.CallOption) (*ClearCacheResponseV1, error)
-	GetTripCountsForCabIDsV1(ctx context.Context, in *GetTripCountsForCabIDsRequestV1, opts ...grpc.CallOption) (*GetTripCountsForCabIDsResponseV1, error)
+func (m *GetTripCountsForHackLicensesRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
 }
 
-type nYCabServiceClient struct {
-	cc *grpc.ClientConn
+func (m *GetTripCountsForHackLicensesRequestV1) GetPickupDate() string {
+	if m != nil {
+		return m.PickupDate
+	}
+	return ""
 }
 
-func NewNYCabServiceClient(cc *grpc.ClientConn) NYCabServiceClient {
-	return &nYCabServiceClient{cc}
+func (m *GetTripCountsForHackLicensesRequestV1) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
 }
 
-func (c *nYCabServiceClient) GetAllCabTripCountPerDayV1(ctx context.Context, in *GetAllCabTripsRequestV1, opts ...grpc.CallOption) (*GetAllCabTripsResponseV1, error) {
-	out := new(GetAllCabTripsResponseV1)
-	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetAllCabTripCountPerDayV1", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTripCountsForHackLicensesRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
 	}
-	return out, nil
+	return objects.Dataset_YELLOW
 }
 
-func (c *nYCabServiceClient) ClearCacheV1(ctx context.Context, in *ClearCacheRequestV1, opts ...grpc.CallOption) (*ClearCacheResponseV1, error) {
-	out := new(ClearCacheResponseV1)
-	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/ClearCacheV1", in, out, opts...)
-	if err != nil {
-		return nil, err
+type GetTripCountsForHackLicensesResponseV1 struct {
+	DriverTripsPerDay    *objects.DriverTripsPerDay `protobuf:"bytes,1,opt,name=driver_trips_per_day,json=driverTripsPerDay,proto3" json:"driver_trips_per_day,omitempty"`
+	Error                string                     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetTripCountsForHackLicensesResponseV1) Reset() {
+	*m = GetTripCountsForHackLicensesResponseV1{}
+}
+func (m *GetTripCountsForHackLicensesResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetTripCountsForHackLicensesResponseV1) ProtoMessage()    {}
+func (*GetTripCountsForHackLicensesResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{7}
+}
+
+func (m *GetTripCountsForHackLicensesResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTripCountsForHackLicensesResponseV1.Unmarshal(m, b)
+}
+func (m *GetTripCountsForHackLicensesResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTripCountsForHackLicensesResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetTripCountsForHackLicensesResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTripCountsForHackLicensesResponseV1.Merge(m, src)
+}
+func (m *GetTripCountsForHackLicensesResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetTripCountsForHackLicensesResponseV1.Size(m)
+}
+func (m *GetTripCountsForHackLicensesResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTripCountsForHackLicensesResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTripCountsForHackLicensesResponseV1 proto.InternalMessageInfo
+
+func (m *GetTripCountsForHackLicensesResponseV1) GetDriverTripsPerDay() *objects.DriverTripsPerDay {
+	if m != nil {
+		return m.DriverTripsPerDay
 	}
-	return out, nil
+	return nil
 }
 
-func (c *nYCabServiceClient) GetTripCountsForCabIDsV1(ctx context.Context, in *GetTripCountsForCabIDsRequestV1, opts ...grpc.CallOption) (*GetTripCountsForCabIDsResponseV1, error) {
-	out := new(GetTripCountsForCabIDsResponseV1)
-	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetTripCountsForCabIDsV1", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTripCountsForHackLicensesResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-// NYCabServiceServer is the server API for NYCabService service.
-type NYCabServiceServer interface {
-	GetAllCabTripCountPerDayV1(context.Context, *GetAllCabTripsRequestV1) (*GetAllCabTripsResponseV1, error)
-	ClearCacheV1(context.Context, *ClearCacheRequestV1) (*ClearCacheResponseV1, error)
-	GetTripCountsForCabIDsV1(context.Context, *GetTripCountsForCabIDsRequestV1) (*GetTripCountsForCabIDsResponseV1, error)
+type GetAllDriverTripsRequestV1 struct {
+	IgnoreCache          bool                  `protobuf:"varint,1,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,2,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,3,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func RegisterNYCabServiceServer(s *grpc.Server, srv NYCabServiceServer) {
-	s.RegisterService(&_NYCabService_serviceDesc, srv)
+func (m *GetAllDriverTripsRequestV1) Reset()         { *m = GetAllDriverTripsRequestV1{} }
+func (m *GetAllDriverTripsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetAllDriverTripsRequestV1) ProtoMessage()    {}
+func (*GetAllDriverTripsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{8}
 }
 
-func _NYCabService_GetAllCabTripCountPerDayV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCabTripsRequestV1)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetAllDriverTripsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAllDriverTripsRequestV1.Unmarshal(m, b)
+}
+func (m *GetAllDriverTripsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAllDriverTripsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetAllDriverTripsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllDriverTripsRequestV1.Merge(m, src)
+}
+func (m *GetAllDriverTripsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetAllDriverTripsRequestV1.Size(m)
+}
+func (m *GetAllDriverTripsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllDriverTripsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllDriverTripsRequestV1 proto.InternalMessageInfo
+
+func (m *GetAllDriverTripsRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
 	}
-	if interceptor == nil {
-		return srv.(NYCabServiceServer).GetAllCabTripCountPerDayV1(ctx, in)
+	return false
+}
+
+func (m *GetAllDriverTripsRequestV1) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nycab.rpc.NYCabService/GetAllCabTripCountPerDayV1",
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetAllDriverTripsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NYCabServiceServer).GetAllCabTripCountPerDayV1(ctx, req.(*GetAllCabTripsRequestV1))
+	return objects.Dataset_YELLOW
+}
+
+type GetAllDriverTripsResponseV1 struct {
+	DriverTripsPerDay    *objects.DriverTripsPerDay `protobuf:"bytes,1,opt,name=driver_trips_per_day,json=driverTripsPerDay,proto3" json:"driver_trips_per_day,omitempty"`
+	Error                string                     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetAllDriverTripsResponseV1) Reset()         { *m = GetAllDriverTripsResponseV1{} }
+func (m *GetAllDriverTripsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetAllDriverTripsResponseV1) ProtoMessage()    {}
+func (*GetAllDriverTripsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{9}
+}
+
+func (m *GetAllDriverTripsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAllDriverTripsResponseV1.Unmarshal(m, b)
+}
+func (m *GetAllDriverTripsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAllDriverTripsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetAllDriverTripsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllDriverTripsResponseV1.Merge(m, src)
+}
+func (m *GetAllDriverTripsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetAllDriverTripsResponseV1.Size(m)
+}
+func (m *GetAllDriverTripsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllDriverTripsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllDriverTripsResponseV1 proto.InternalMessageInfo
+
+func (m *GetAllDriverTripsResponseV1) GetDriverTripsPerDay() *objects.DriverTripsPerDay {
+	if m != nil {
+		return m.DriverTripsPerDay
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _NYCabService_ClearCacheV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearCacheRequestV1)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetAllDriverTripsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if interceptor == nil {
-		return srv.(NYCabServiceServer).ClearCacheV1(ctx, in)
+	return ""
+}
+
+type GetCabDriverMappingRequestV1 struct {
+	CabIds               []string        `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	HackLicenses         []string        `protobuf:"bytes,2,rep,name=hack_licenses,json=hackLicenses,proto3" json:"hack_licenses,omitempty"`
+	IgnoreCache          bool            `protobuf:"varint,3,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	PickupDate           string          `protobuf:"bytes,4,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCabDriverMappingRequestV1) Reset()         { *m = GetCabDriverMappingRequestV1{} }
+func (m *GetCabDriverMappingRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetCabDriverMappingRequestV1) ProtoMessage()    {}
+func (*GetCabDriverMappingRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{10}
+}
+
+func (m *GetCabDriverMappingRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabDriverMappingRequestV1.Unmarshal(m, b)
+}
+func (m *GetCabDriverMappingRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabDriverMappingRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetCabDriverMappingRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabDriverMappingRequestV1.Merge(m, src)
+}
+func (m *GetCabDriverMappingRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetCabDriverMappingRequestV1.Size(m)
+}
+func (m *GetCabDriverMappingRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabDriverMappingRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabDriverMappingRequestV1 proto.InternalMessageInfo
+
+func (m *GetCabDriverMappingRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nycab.rpc.NYCabService/ClearCacheV1",
+	return nil
+}
+
+func (m *GetCabDriverMappingRequestV1) GetHackLicenses() []string {
+	if m != nil {
+		return m.HackLicenses
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NYCabServiceServer).ClearCacheV1(ctx, req.(*ClearCacheRequestV1))
+	return nil
+}
+
+func (m *GetCabDriverMappingRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _NYCabService_GetTripCountsForCabIDsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTripCountsForCabIDsRequestV1)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetCabDriverMappingRequestV1) GetPickupDate() string {
+	if m != nil {
+		return m.PickupDate
 	}
-	if interceptor == nil {
+	return ""
+}
+
+func (m *GetCabDriverMappingRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetCabDriverMappingResponseV1 struct {
+	CabDriverMapping     *objects.CabDriverMapping `protobuf:"bytes,1,opt,name=cab_driver_mapping,json=cabDriverMapping,proto3" json:"cab_driver_mapping,omitempty"`
+	Error                string                    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetCabDriverMappingResponseV1) Reset()         { *m = GetCabDriverMappingResponseV1{} }
+func (m *GetCabDriverMappingResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetCabDriverMappingResponseV1) ProtoMessage()    {}
+func (*GetCabDriverMappingResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{11}
+}
+
+func (m *GetCabDriverMappingResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabDriverMappingResponseV1.Unmarshal(m, b)
+}
+func (m *GetCabDriverMappingResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabDriverMappingResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetCabDriverMappingResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabDriverMappingResponseV1.Merge(m, src)
+}
+func (m *GetCabDriverMappingResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetCabDriverMappingResponseV1.Size(m)
+}
+func (m *GetCabDriverMappingResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabDriverMappingResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabDriverMappingResponseV1 proto.InternalMessageInfo
+
+func (m *GetCabDriverMappingResponseV1) GetCabDriverMapping() *objects.CabDriverMapping {
+	if m != nil {
+		return m.CabDriverMapping
+	}
+	return nil
+}
+
+func (m *GetCabDriverMappingResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type CountTripsInAreaRequestV1 struct {
+	BoundingBox          *objects.BoundingBox `protobuf:"bytes,1,opt,name=bounding_box,json=boundingBox,proto3" json:"bounding_box,omitempty"`
+	Polygon              []*objects.GeoPoint  `protobuf:"bytes,2,rep,name=polygon,proto3" json:"polygon,omitempty"`
+	StartTime            string               `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string               `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IncludeCabIds        bool                 `protobuf:"varint,5,opt,name=include_cab_ids,json=includeCabIds,proto3" json:"include_cab_ids,omitempty"`
+	Dataset              objects.Dataset      `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CountTripsInAreaRequestV1) Reset()         { *m = CountTripsInAreaRequestV1{} }
+func (m *CountTripsInAreaRequestV1) String() string { return proto.CompactTextString(m) }
+func (*CountTripsInAreaRequestV1) ProtoMessage()    {}
+func (*CountTripsInAreaRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{12}
+}
+
+func (m *CountTripsInAreaRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountTripsInAreaRequestV1.Unmarshal(m, b)
+}
+func (m *CountTripsInAreaRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountTripsInAreaRequestV1.Marshal(b, m, deterministic)
+}
+func (m *CountTripsInAreaRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountTripsInAreaRequestV1.Merge(m, src)
+}
+func (m *CountTripsInAreaRequestV1) XXX_Size() int {
+	return xxx_messageInfo_CountTripsInAreaRequestV1.Size(m)
+}
+func (m *CountTripsInAreaRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountTripsInAreaRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountTripsInAreaRequestV1 proto.InternalMessageInfo
+
+func (m *CountTripsInAreaRequestV1) GetBoundingBox() *objects.BoundingBox {
+	if m != nil {
+		return m.BoundingBox
+	}
+	return nil
+}
+
+func (m *CountTripsInAreaRequestV1) GetPolygon() []*objects.GeoPoint {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *CountTripsInAreaRequestV1) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *CountTripsInAreaRequestV1) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *CountTripsInAreaRequestV1) GetIncludeCabIds() bool {
+	if m != nil {
+		return m.IncludeCabIds
+	}
+	return false
+}
+
+func (m *CountTripsInAreaRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type CountTripsInAreaResponseV1 struct {
+	TripCount            uint32            `protobuf:"varint,1,opt,name=trip_count,json=tripCount,proto3" json:"trip_count,omitempty"`
+	TripsPerCab          map[string]uint32 `protobuf:"bytes,2,rep,name=trips_per_cab,json=tripsPerCab,proto3" json:"trips_per_cab,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Error                string            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CountTripsInAreaResponseV1) Reset()         { *m = CountTripsInAreaResponseV1{} }
+func (m *CountTripsInAreaResponseV1) String() string { return proto.CompactTextString(m) }
+func (*CountTripsInAreaResponseV1) ProtoMessage()    {}
+func (*CountTripsInAreaResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{13}
+}
+
+func (m *CountTripsInAreaResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountTripsInAreaResponseV1.Unmarshal(m, b)
+}
+func (m *CountTripsInAreaResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountTripsInAreaResponseV1.Marshal(b, m, deterministic)
+}
+func (m *CountTripsInAreaResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountTripsInAreaResponseV1.Merge(m, src)
+}
+func (m *CountTripsInAreaResponseV1) XXX_Size() int {
+	return xxx_messageInfo_CountTripsInAreaResponseV1.Size(m)
+}
+func (m *CountTripsInAreaResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountTripsInAreaResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountTripsInAreaResponseV1 proto.InternalMessageInfo
+
+func (m *CountTripsInAreaResponseV1) GetTripCount() uint32 {
+	if m != nil {
+		return m.TripCount
+	}
+	return 0
+}
+
+func (m *CountTripsInAreaResponseV1) GetTripsPerCab() map[string]uint32 {
+	if m != nil {
+		return m.TripsPerCab
+	}
+	return nil
+}
+
+func (m *CountTripsInAreaResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetPickupHeatmapRequestV1 struct {
+	Precision            uint32          `protobuf:"varint,1,opt,name=precision,proto3" json:"precision,omitempty"`
+	StartTime            string          `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string          `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IgnoreCache          bool            `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetPickupHeatmapRequestV1) Reset()         { *m = GetPickupHeatmapRequestV1{} }
+func (m *GetPickupHeatmapRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetPickupHeatmapRequestV1) ProtoMessage()    {}
+func (*GetPickupHeatmapRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{14}
+}
+
+func (m *GetPickupHeatmapRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPickupHeatmapRequestV1.Unmarshal(m, b)
+}
+func (m *GetPickupHeatmapRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPickupHeatmapRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetPickupHeatmapRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPickupHeatmapRequestV1.Merge(m, src)
+}
+func (m *GetPickupHeatmapRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetPickupHeatmapRequestV1.Size(m)
+}
+func (m *GetPickupHeatmapRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPickupHeatmapRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPickupHeatmapRequestV1 proto.InternalMessageInfo
+
+func (m *GetPickupHeatmapRequestV1) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *GetPickupHeatmapRequestV1) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GetPickupHeatmapRequestV1) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *GetPickupHeatmapRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetPickupHeatmapRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetPickupHeatmapResponseV1 struct {
+	Cells                []*objects.HeatmapCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	Error                string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetPickupHeatmapResponseV1) Reset()         { *m = GetPickupHeatmapResponseV1{} }
+func (m *GetPickupHeatmapResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetPickupHeatmapResponseV1) ProtoMessage()    {}
+func (*GetPickupHeatmapResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{15}
+}
+
+func (m *GetPickupHeatmapResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPickupHeatmapResponseV1.Unmarshal(m, b)
+}
+func (m *GetPickupHeatmapResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPickupHeatmapResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetPickupHeatmapResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPickupHeatmapResponseV1.Merge(m, src)
+}
+func (m *GetPickupHeatmapResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetPickupHeatmapResponseV1.Size(m)
+}
+func (m *GetPickupHeatmapResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPickupHeatmapResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPickupHeatmapResponseV1 proto.InternalMessageInfo
+
+func (m *GetPickupHeatmapResponseV1) GetCells() []*objects.HeatmapCell {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *GetPickupHeatmapResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetOriginDestinationMatrixRequestV1 struct {
+	StartTime            string          `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string          `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	GeohashPrecision     uint32          `protobuf:"varint,3,opt,name=geohash_precision,json=geohashPrecision,proto3" json:"geohash_precision,omitempty"`
+	GridSize             float64         `protobuf:"fixed64,4,opt,name=grid_size,json=gridSize,proto3" json:"grid_size,omitempty"`
+	IgnoreCache          bool            `protobuf:"varint,5,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetOriginDestinationMatrixRequestV1) Reset()         { *m = GetOriginDestinationMatrixRequestV1{} }
+func (m *GetOriginDestinationMatrixRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetOriginDestinationMatrixRequestV1) ProtoMessage()    {}
+func (*GetOriginDestinationMatrixRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{16}
+}
+
+func (m *GetOriginDestinationMatrixRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOriginDestinationMatrixRequestV1.Unmarshal(m, b)
+}
+func (m *GetOriginDestinationMatrixRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOriginDestinationMatrixRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetOriginDestinationMatrixRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOriginDestinationMatrixRequestV1.Merge(m, src)
+}
+func (m *GetOriginDestinationMatrixRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetOriginDestinationMatrixRequestV1.Size(m)
+}
+func (m *GetOriginDestinationMatrixRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOriginDestinationMatrixRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOriginDestinationMatrixRequestV1 proto.InternalMessageInfo
+
+func (m *GetOriginDestinationMatrixRequestV1) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GetOriginDestinationMatrixRequestV1) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *GetOriginDestinationMatrixRequestV1) GetGeohashPrecision() uint32 {
+	if m != nil {
+		return m.GeohashPrecision
+	}
+	return 0
+}
+
+func (m *GetOriginDestinationMatrixRequestV1) GetGridSize() float64 {
+	if m != nil {
+		return m.GridSize
+	}
+	return 0
+}
+
+func (m *GetOriginDestinationMatrixRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetOriginDestinationMatrixRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetOriginDestinationMatrixResponseV1 struct {
+	Entries              []*objects.ODMatrixEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Error                string                   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetOriginDestinationMatrixResponseV1) Reset()         { *m = GetOriginDestinationMatrixResponseV1{} }
+func (m *GetOriginDestinationMatrixResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetOriginDestinationMatrixResponseV1) ProtoMessage()    {}
+func (*GetOriginDestinationMatrixResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{17}
+}
+
+func (m *GetOriginDestinationMatrixResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOriginDestinationMatrixResponseV1.Unmarshal(m, b)
+}
+func (m *GetOriginDestinationMatrixResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOriginDestinationMatrixResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetOriginDestinationMatrixResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOriginDestinationMatrixResponseV1.Merge(m, src)
+}
+func (m *GetOriginDestinationMatrixResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetOriginDestinationMatrixResponseV1.Size(m)
+}
+func (m *GetOriginDestinationMatrixResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOriginDestinationMatrixResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOriginDestinationMatrixResponseV1 proto.InternalMessageInfo
+
+func (m *GetOriginDestinationMatrixResponseV1) GetEntries() []*objects.ODMatrixEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *GetOriginDestinationMatrixResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetCabShiftsRequestV1 struct {
+	CabId                string          `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	PickupDate           string          `protobuf:"bytes,2,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"`
+	MaxIdleMinutes       uint32          `protobuf:"varint,3,opt,name=max_idle_minutes,json=maxIdleMinutes,proto3" json:"max_idle_minutes,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,4,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCabShiftsRequestV1) Reset()         { *m = GetCabShiftsRequestV1{} }
+func (m *GetCabShiftsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetCabShiftsRequestV1) ProtoMessage()    {}
+func (*GetCabShiftsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{18}
+}
+
+func (m *GetCabShiftsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabShiftsRequestV1.Unmarshal(m, b)
+}
+func (m *GetCabShiftsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabShiftsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetCabShiftsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabShiftsRequestV1.Merge(m, src)
+}
+func (m *GetCabShiftsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetCabShiftsRequestV1.Size(m)
+}
+func (m *GetCabShiftsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabShiftsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabShiftsRequestV1 proto.InternalMessageInfo
+
+func (m *GetCabShiftsRequestV1) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *GetCabShiftsRequestV1) GetPickupDate() string {
+	if m != nil {
+		return m.PickupDate
+	}
+	return ""
+}
+
+func (m *GetCabShiftsRequestV1) GetMaxIdleMinutes() uint32 {
+	if m != nil {
+		return m.MaxIdleMinutes
+	}
+	return 0
+}
+
+func (m *GetCabShiftsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetCabShiftsResponseV1 struct {
+	Shifts               []*objects.Shift `protobuf:"bytes,1,rep,name=shifts,proto3" json:"shifts,omitempty"`
+	Error                string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetCabShiftsResponseV1) Reset()         { *m = GetCabShiftsResponseV1{} }
+func (m *GetCabShiftsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetCabShiftsResponseV1) ProtoMessage()    {}
+func (*GetCabShiftsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{19}
+}
+
+func (m *GetCabShiftsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabShiftsResponseV1.Unmarshal(m, b)
+}
+func (m *GetCabShiftsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabShiftsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetCabShiftsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabShiftsResponseV1.Merge(m, src)
+}
+func (m *GetCabShiftsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetCabShiftsResponseV1.Size(m)
+}
+func (m *GetCabShiftsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabShiftsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabShiftsResponseV1 proto.InternalMessageInfo
+
+func (m *GetCabShiftsResponseV1) GetShifts() []*objects.Shift {
+	if m != nil {
+		return m.Shifts
+	}
+	return nil
+}
+
+func (m *GetCabShiftsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type FindTripAnomaliesRequestV1 struct {
+	CabIds               []string        `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartTime            string          `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string          `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	MaxSpeedMph          float64         `protobuf:"fixed64,4,opt,name=max_speed_mph,json=maxSpeedMph,proto3" json:"max_speed_mph,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FindTripAnomaliesRequestV1) Reset()         { *m = FindTripAnomaliesRequestV1{} }
+func (m *FindTripAnomaliesRequestV1) String() string { return proto.CompactTextString(m) }
+func (*FindTripAnomaliesRequestV1) ProtoMessage()    {}
+func (*FindTripAnomaliesRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{20}
+}
+
+func (m *FindTripAnomaliesRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindTripAnomaliesRequestV1.Unmarshal(m, b)
+}
+func (m *FindTripAnomaliesRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindTripAnomaliesRequestV1.Marshal(b, m, deterministic)
+}
+func (m *FindTripAnomaliesRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindTripAnomaliesRequestV1.Merge(m, src)
+}
+func (m *FindTripAnomaliesRequestV1) XXX_Size() int {
+	return xxx_messageInfo_FindTripAnomaliesRequestV1.Size(m)
+}
+func (m *FindTripAnomaliesRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindTripAnomaliesRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindTripAnomaliesRequestV1 proto.InternalMessageInfo
+
+func (m *FindTripAnomaliesRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *FindTripAnomaliesRequestV1) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *FindTripAnomaliesRequestV1) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *FindTripAnomaliesRequestV1) GetMaxSpeedMph() float64 {
+	if m != nil {
+		return m.MaxSpeedMph
+	}
+	return 0
+}
+
+func (m *FindTripAnomaliesRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type FindTripAnomaliesResponseV1 struct {
+	Anomalies            []*objects.TripAnomaly `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	Error                string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *FindTripAnomaliesResponseV1) Reset()         { *m = FindTripAnomaliesResponseV1{} }
+func (m *FindTripAnomaliesResponseV1) String() string { return proto.CompactTextString(m) }
+func (*FindTripAnomaliesResponseV1) ProtoMessage()    {}
+func (*FindTripAnomaliesResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{21}
+}
+
+func (m *FindTripAnomaliesResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindTripAnomaliesResponseV1.Unmarshal(m, b)
+}
+func (m *FindTripAnomaliesResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindTripAnomaliesResponseV1.Marshal(b, m, deterministic)
+}
+func (m *FindTripAnomaliesResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindTripAnomaliesResponseV1.Merge(m, src)
+}
+func (m *FindTripAnomaliesResponseV1) XXX_Size() int {
+	return xxx_messageInfo_FindTripAnomaliesResponseV1.Size(m)
+}
+func (m *FindTripAnomaliesResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindTripAnomaliesResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindTripAnomaliesResponseV1 proto.InternalMessageInfo
+
+func (m *FindTripAnomaliesResponseV1) GetAnomalies() []*objects.TripAnomaly {
+	if m != nil {
+		return m.Anomalies
+	}
+	return nil
+}
+
+func (m *FindTripAnomaliesResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ListTripsRequestV1 struct {
+	CabId     string `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PageSize  uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// optional, trip fields to return, all fields if empty. cab_id is always returned
+	// supported: hack_license, pickup_time, dropoff_time, pickup_location, dropoff_location, trip_distance, passenger_count
+	Fields               []string        `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,7,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListTripsRequestV1) Reset()         { *m = ListTripsRequestV1{} }
+func (m *ListTripsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*ListTripsRequestV1) ProtoMessage()    {}
+func (*ListTripsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{22}
+}
+
+func (m *ListTripsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTripsRequestV1.Unmarshal(m, b)
+}
+func (m *ListTripsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTripsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *ListTripsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTripsRequestV1.Merge(m, src)
+}
+func (m *ListTripsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_ListTripsRequestV1.Size(m)
+}
+func (m *ListTripsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTripsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTripsRequestV1 proto.InternalMessageInfo
+
+func (m *ListTripsRequestV1) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *ListTripsRequestV1) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *ListTripsRequestV1) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *ListTripsRequestV1) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListTripsRequestV1) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListTripsRequestV1) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListTripsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type ListTripsResponseV1 struct {
+	Trips                []*objects.TripRecord `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
+	NextPageToken        string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Error                string                `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListTripsResponseV1) Reset()         { *m = ListTripsResponseV1{} }
+func (m *ListTripsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*ListTripsResponseV1) ProtoMessage()    {}
+func (*ListTripsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{23}
+}
+
+func (m *ListTripsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTripsResponseV1.Unmarshal(m, b)
+}
+func (m *ListTripsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTripsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *ListTripsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTripsResponseV1.Merge(m, src)
+}
+func (m *ListTripsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_ListTripsResponseV1.Size(m)
+}
+func (m *ListTripsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTripsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTripsResponseV1 proto.InternalMessageInfo
+
+func (m *ListTripsResponseV1) GetTrips() []*objects.TripRecord {
+	if m != nil {
+		return m.Trips
+	}
+	return nil
+}
+
+func (m *ListTripsResponseV1) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListTripsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetCabUtilizationRequestV1 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,5,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCabUtilizationRequestV1) Reset()         { *m = GetCabUtilizationRequestV1{} }
+func (m *GetCabUtilizationRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetCabUtilizationRequestV1) ProtoMessage()    {}
+func (*GetCabUtilizationRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{24}
+}
+
+func (m *GetCabUtilizationRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabUtilizationRequestV1.Unmarshal(m, b)
+}
+func (m *GetCabUtilizationRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabUtilizationRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetCabUtilizationRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabUtilizationRequestV1.Merge(m, src)
+}
+func (m *GetCabUtilizationRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetCabUtilizationRequestV1.Size(m)
+}
+func (m *GetCabUtilizationRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabUtilizationRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabUtilizationRequestV1 proto.InternalMessageInfo
+
+func (m *GetCabUtilizationRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetCabUtilizationRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetCabUtilizationRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetCabUtilizationRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetCabUtilizationRequestV1) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetCabUtilizationRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetCabUtilizationResponseV1 struct {
+	Utilization          []*objects.CabUtilization `protobuf:"bytes,1,rep,name=utilization,proto3" json:"utilization,omitempty"`
+	Error                string                    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetCabUtilizationResponseV1) Reset()         { *m = GetCabUtilizationResponseV1{} }
+func (m *GetCabUtilizationResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetCabUtilizationResponseV1) ProtoMessage()    {}
+func (*GetCabUtilizationResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{25}
+}
+
+func (m *GetCabUtilizationResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabUtilizationResponseV1.Unmarshal(m, b)
+}
+func (m *GetCabUtilizationResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabUtilizationResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetCabUtilizationResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabUtilizationResponseV1.Merge(m, src)
+}
+func (m *GetCabUtilizationResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetCabUtilizationResponseV1.Size(m)
+}
+func (m *GetCabUtilizationResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabUtilizationResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabUtilizationResponseV1 proto.InternalMessageInfo
+
+func (m *GetCabUtilizationResponseV1) GetUtilization() []*objects.CabUtilization {
+	if m != nil {
+		return m.Utilization
+	}
+	return nil
+}
+
+func (m *GetCabUtilizationResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetTripPatternsRequestV1 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,5,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetTripPatternsRequestV1) Reset()         { *m = GetTripPatternsRequestV1{} }
+func (m *GetTripPatternsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetTripPatternsRequestV1) ProtoMessage()    {}
+func (*GetTripPatternsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{26}
+}
+
+func (m *GetTripPatternsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTripPatternsRequestV1.Unmarshal(m, b)
+}
+func (m *GetTripPatternsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTripPatternsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetTripPatternsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTripPatternsRequestV1.Merge(m, src)
+}
+func (m *GetTripPatternsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetTripPatternsRequestV1.Size(m)
+}
+func (m *GetTripPatternsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTripPatternsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTripPatternsRequestV1 proto.InternalMessageInfo
+
+func (m *GetTripPatternsRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetTripPatternsRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetTripPatternsRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetTripPatternsRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetTripPatternsRequestV1) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetTripPatternsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetTripPatternsResponseV1 struct {
+	Patterns             []*objects.TripPatterns `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	Error                string                  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetTripPatternsResponseV1) Reset()         { *m = GetTripPatternsResponseV1{} }
+func (m *GetTripPatternsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetTripPatternsResponseV1) ProtoMessage()    {}
+func (*GetTripPatternsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{27}
+}
+
+func (m *GetTripPatternsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTripPatternsResponseV1.Unmarshal(m, b)
+}
+func (m *GetTripPatternsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTripPatternsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetTripPatternsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTripPatternsResponseV1.Merge(m, src)
+}
+func (m *GetTripPatternsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetTripPatternsResponseV1.Size(m)
+}
+func (m *GetTripPatternsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTripPatternsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTripPatternsResponseV1 proto.InternalMessageInfo
+
+func (m *GetTripPatternsResponseV1) GetPatterns() []*objects.TripPatterns {
+	if m != nil {
+		return m.Patterns
+	}
+	return nil
+}
+
+func (m *GetTripPatternsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type DetectCountAnomaliesRequestV1 struct {
+	CabIds               []string               `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	WindowDays           uint32                 `protobuf:"varint,4,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	Method               objects.BaselineMethod `protobuf:"varint,5,opt,name=method,proto3,enum=nycab.data.objects.BaselineMethod" json:"method,omitempty"`
+	Threshold            float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	IgnoreCache          bool                   `protobuf:"varint,7,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter  `protobuf:"varint,8,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset        `protobuf:"varint,9,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DetectCountAnomaliesRequestV1) Reset()         { *m = DetectCountAnomaliesRequestV1{} }
+func (m *DetectCountAnomaliesRequestV1) String() string { return proto.CompactTextString(m) }
+func (*DetectCountAnomaliesRequestV1) ProtoMessage()    {}
+func (*DetectCountAnomaliesRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{28}
+}
+
+func (m *DetectCountAnomaliesRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectCountAnomaliesRequestV1.Unmarshal(m, b)
+}
+func (m *DetectCountAnomaliesRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectCountAnomaliesRequestV1.Marshal(b, m, deterministic)
+}
+func (m *DetectCountAnomaliesRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectCountAnomaliesRequestV1.Merge(m, src)
+}
+func (m *DetectCountAnomaliesRequestV1) XXX_Size() int {
+	return xxx_messageInfo_DetectCountAnomaliesRequestV1.Size(m)
+}
+func (m *DetectCountAnomaliesRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectCountAnomaliesRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectCountAnomaliesRequestV1 proto.InternalMessageInfo
+
+func (m *DetectCountAnomaliesRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *DetectCountAnomaliesRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *DetectCountAnomaliesRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *DetectCountAnomaliesRequestV1) GetWindowDays() uint32 {
+	if m != nil {
+		return m.WindowDays
+	}
+	return 0
+}
+
+func (m *DetectCountAnomaliesRequestV1) GetMethod() objects.BaselineMethod {
+	if m != nil {
+		return m.Method
+	}
+	return objects.BaselineMethod_ROLLING_MEAN
+}
+
+func (m *DetectCountAnomaliesRequestV1) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *DetectCountAnomaliesRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *DetectCountAnomaliesRequestV1) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *DetectCountAnomaliesRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type DetectCountAnomaliesResponseV1 struct {
+	Anomalies            []*objects.CountAnomaly `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	Error                string                  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DetectCountAnomaliesResponseV1) Reset()         { *m = DetectCountAnomaliesResponseV1{} }
+func (m *DetectCountAnomaliesResponseV1) String() string { return proto.CompactTextString(m) }
+func (*DetectCountAnomaliesResponseV1) ProtoMessage()    {}
+func (*DetectCountAnomaliesResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{29}
+}
+
+func (m *DetectCountAnomaliesResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectCountAnomaliesResponseV1.Unmarshal(m, b)
+}
+func (m *DetectCountAnomaliesResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectCountAnomaliesResponseV1.Marshal(b, m, deterministic)
+}
+func (m *DetectCountAnomaliesResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectCountAnomaliesResponseV1.Merge(m, src)
+}
+func (m *DetectCountAnomaliesResponseV1) XXX_Size() int {
+	return xxx_messageInfo_DetectCountAnomaliesResponseV1.Size(m)
+}
+func (m *DetectCountAnomaliesResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectCountAnomaliesResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectCountAnomaliesResponseV1 proto.InternalMessageInfo
+
+func (m *DetectCountAnomaliesResponseV1) GetAnomalies() []*objects.CountAnomaly {
+	if m != nil {
+		return m.Anomalies
+	}
+	return nil
+}
+
+func (m *DetectCountAnomaliesResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ForecastTripsRequestV1 struct {
+	CabIds               []string               `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	HistoryStartDate     string                 `protobuf:"bytes,2,opt,name=history_start_date,json=historyStartDate,proto3" json:"history_start_date,omitempty"`
+	HistoryEndDate       string                 `protobuf:"bytes,3,opt,name=history_end_date,json=historyEndDate,proto3" json:"history_end_date,omitempty"`
+	HorizonDays          uint32                 `protobuf:"varint,4,opt,name=horizon_days,json=horizonDays,proto3" json:"horizon_days,omitempty"`
+	Method               objects.ForecastMethod `protobuf:"varint,5,opt,name=method,proto3,enum=nycab.data.objects.ForecastMethod" json:"method,omitempty"`
+	PredictionLevel      float64                `protobuf:"fixed64,6,opt,name=prediction_level,json=predictionLevel,proto3" json:"prediction_level,omitempty"`
+	IgnoreCache          bool                   `protobuf:"varint,7,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset        `protobuf:"varint,8,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ForecastTripsRequestV1) Reset()         { *m = ForecastTripsRequestV1{} }
+func (m *ForecastTripsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*ForecastTripsRequestV1) ProtoMessage()    {}
+func (*ForecastTripsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{30}
+}
+
+func (m *ForecastTripsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForecastTripsRequestV1.Unmarshal(m, b)
+}
+func (m *ForecastTripsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForecastTripsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *ForecastTripsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForecastTripsRequestV1.Merge(m, src)
+}
+func (m *ForecastTripsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_ForecastTripsRequestV1.Size(m)
+}
+func (m *ForecastTripsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForecastTripsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForecastTripsRequestV1 proto.InternalMessageInfo
+
+func (m *ForecastTripsRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *ForecastTripsRequestV1) GetHistoryStartDate() string {
+	if m != nil {
+		return m.HistoryStartDate
+	}
+	return ""
+}
+
+func (m *ForecastTripsRequestV1) GetHistoryEndDate() string {
+	if m != nil {
+		return m.HistoryEndDate
+	}
+	return ""
+}
+
+func (m *ForecastTripsRequestV1) GetHorizonDays() uint32 {
+	if m != nil {
+		return m.HorizonDays
+	}
+	return 0
+}
+
+func (m *ForecastTripsRequestV1) GetMethod() objects.ForecastMethod {
+	if m != nil {
+		return m.Method
+	}
+	return objects.ForecastMethod_SEASONAL_NAIVE
+}
+
+func (m *ForecastTripsRequestV1) GetPredictionLevel() float64 {
+	if m != nil {
+		return m.PredictionLevel
+	}
+	return 0
+}
+
+func (m *ForecastTripsRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *ForecastTripsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type ForecastTripsResponseV1 struct {
+	Forecasts            []*objects.CabTripForecast `protobuf:"bytes,1,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
+	Error                string                     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ForecastTripsResponseV1) Reset()         { *m = ForecastTripsResponseV1{} }
+func (m *ForecastTripsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*ForecastTripsResponseV1) ProtoMessage()    {}
+func (*ForecastTripsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{31}
+}
+
+func (m *ForecastTripsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForecastTripsResponseV1.Unmarshal(m, b)
+}
+func (m *ForecastTripsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForecastTripsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *ForecastTripsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForecastTripsResponseV1.Merge(m, src)
+}
+func (m *ForecastTripsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_ForecastTripsResponseV1.Size(m)
+}
+func (m *ForecastTripsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForecastTripsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForecastTripsResponseV1 proto.InternalMessageInfo
+
+func (m *ForecastTripsResponseV1) GetForecasts() []*objects.CabTripForecast {
+	if m != nil {
+		return m.Forecasts
+	}
+	return nil
+}
+
+func (m *ForecastTripsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetPassengerCountsRequestV1 struct {
+	CabIds               []string        `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string          `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string          `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool            `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetPassengerCountsRequestV1) Reset()         { *m = GetPassengerCountsRequestV1{} }
+func (m *GetPassengerCountsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetPassengerCountsRequestV1) ProtoMessage()    {}
+func (*GetPassengerCountsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{32}
+}
+
+func (m *GetPassengerCountsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPassengerCountsRequestV1.Unmarshal(m, b)
+}
+func (m *GetPassengerCountsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPassengerCountsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetPassengerCountsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPassengerCountsRequestV1.Merge(m, src)
+}
+func (m *GetPassengerCountsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetPassengerCountsRequestV1.Size(m)
+}
+func (m *GetPassengerCountsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPassengerCountsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPassengerCountsRequestV1 proto.InternalMessageInfo
+
+func (m *GetPassengerCountsRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetPassengerCountsRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetPassengerCountsRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetPassengerCountsRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetPassengerCountsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetPassengerCountsResponseV1 struct {
+	Fleet                *objects.PassengerCountDistribution   `protobuf:"bytes,1,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	Cabs                 []*objects.PassengerCountDistribution `protobuf:"bytes,2,rep,name=cabs,proto3" json:"cabs,omitempty"`
+	Error                string                                `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *GetPassengerCountsResponseV1) Reset()         { *m = GetPassengerCountsResponseV1{} }
+func (m *GetPassengerCountsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetPassengerCountsResponseV1) ProtoMessage()    {}
+func (*GetPassengerCountsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{33}
+}
+
+func (m *GetPassengerCountsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPassengerCountsResponseV1.Unmarshal(m, b)
+}
+func (m *GetPassengerCountsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPassengerCountsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetPassengerCountsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPassengerCountsResponseV1.Merge(m, src)
+}
+func (m *GetPassengerCountsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetPassengerCountsResponseV1.Size(m)
+}
+func (m *GetPassengerCountsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPassengerCountsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPassengerCountsResponseV1 proto.InternalMessageInfo
+
+func (m *GetPassengerCountsResponseV1) GetFleet() *objects.PassengerCountDistribution {
+	if m != nil {
+		return m.Fleet
+	}
+	return nil
+}
+
+func (m *GetPassengerCountsResponseV1) GetCabs() []*objects.PassengerCountDistribution {
+	if m != nil {
+		return m.Cabs
+	}
+	return nil
+}
+
+func (m *GetPassengerCountsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetVendorStatsRequestV1 struct {
+	CabIds               []string        `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string          `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string          `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	MaxSpeedMph          float64         `protobuf:"fixed64,4,opt,name=max_speed_mph,json=maxSpeedMph,proto3" json:"max_speed_mph,omitempty"`
+	IgnoreCache          bool            `protobuf:"varint,5,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetVendorStatsRequestV1) Reset()         { *m = GetVendorStatsRequestV1{} }
+func (m *GetVendorStatsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetVendorStatsRequestV1) ProtoMessage()    {}
+func (*GetVendorStatsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{34}
+}
+
+func (m *GetVendorStatsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVendorStatsRequestV1.Unmarshal(m, b)
+}
+func (m *GetVendorStatsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVendorStatsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetVendorStatsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVendorStatsRequestV1.Merge(m, src)
+}
+func (m *GetVendorStatsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetVendorStatsRequestV1.Size(m)
+}
+func (m *GetVendorStatsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVendorStatsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVendorStatsRequestV1 proto.InternalMessageInfo
+
+func (m *GetVendorStatsRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetVendorStatsRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetVendorStatsRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetVendorStatsRequestV1) GetMaxSpeedMph() float64 {
+	if m != nil {
+		return m.MaxSpeedMph
+	}
+	return 0
+}
+
+func (m *GetVendorStatsRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetVendorStatsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetVendorStatsResponseV1 struct {
+	Vendors              []*objects.VendorStats `protobuf:"bytes,1,rep,name=vendors,proto3" json:"vendors,omitempty"`
+	Error                string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetVendorStatsResponseV1) Reset()         { *m = GetVendorStatsResponseV1{} }
+func (m *GetVendorStatsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetVendorStatsResponseV1) ProtoMessage()    {}
+func (*GetVendorStatsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{35}
+}
+
+func (m *GetVendorStatsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVendorStatsResponseV1.Unmarshal(m, b)
+}
+func (m *GetVendorStatsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVendorStatsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetVendorStatsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVendorStatsResponseV1.Merge(m, src)
+}
+func (m *GetVendorStatsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetVendorStatsResponseV1.Size(m)
+}
+func (m *GetVendorStatsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVendorStatsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVendorStatsResponseV1 proto.InternalMessageInfo
+
+func (m *GetVendorStatsResponseV1) GetVendors() []*objects.VendorStats {
+	if m != nil {
+		return m.Vendors
+	}
+	return nil
+}
+
+func (m *GetVendorStatsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type CountZoneTripsRequestV1 struct {
+	Zones                []string        `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	CabIds               []string        `protobuf:"bytes,2,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string          `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string          `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CountZoneTripsRequestV1) Reset()         { *m = CountZoneTripsRequestV1{} }
+func (m *CountZoneTripsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*CountZoneTripsRequestV1) ProtoMessage()    {}
+func (*CountZoneTripsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{36}
+}
+
+func (m *CountZoneTripsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountZoneTripsRequestV1.Unmarshal(m, b)
+}
+func (m *CountZoneTripsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountZoneTripsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *CountZoneTripsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountZoneTripsRequestV1.Merge(m, src)
+}
+func (m *CountZoneTripsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_CountZoneTripsRequestV1.Size(m)
+}
+func (m *CountZoneTripsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountZoneTripsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountZoneTripsRequestV1 proto.InternalMessageInfo
+
+func (m *CountZoneTripsRequestV1) GetZones() []string {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+func (m *CountZoneTripsRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *CountZoneTripsRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *CountZoneTripsRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *CountZoneTripsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type CountZoneTripsResponseV1 struct {
+	Counts               []*objects.ZoneTripCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	Error                string                   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CountZoneTripsResponseV1) Reset()         { *m = CountZoneTripsResponseV1{} }
+func (m *CountZoneTripsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*CountZoneTripsResponseV1) ProtoMessage()    {}
+func (*CountZoneTripsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{37}
+}
+
+func (m *CountZoneTripsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountZoneTripsResponseV1.Unmarshal(m, b)
+}
+func (m *CountZoneTripsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountZoneTripsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *CountZoneTripsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountZoneTripsResponseV1.Merge(m, src)
+}
+func (m *CountZoneTripsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_CountZoneTripsResponseV1.Size(m)
+}
+func (m *CountZoneTripsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountZoneTripsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountZoneTripsResponseV1 proto.InternalMessageInfo
+
+func (m *CountZoneTripsResponseV1) GetCounts() []*objects.ZoneTripCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *CountZoneTripsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetTaxiZoneTripCountsRequestV1 struct {
+	LocationIds          []string        `protobuf:"bytes,1,rep,name=location_ids,json=locationIds,proto3" json:"location_ids,omitempty"`
+	StartDate            string          `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string          `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,4,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetTaxiZoneTripCountsRequestV1) Reset()         { *m = GetTaxiZoneTripCountsRequestV1{} }
+func (m *GetTaxiZoneTripCountsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetTaxiZoneTripCountsRequestV1) ProtoMessage()    {}
+func (*GetTaxiZoneTripCountsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{38}
+}
+
+func (m *GetTaxiZoneTripCountsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTaxiZoneTripCountsRequestV1.Unmarshal(m, b)
+}
+func (m *GetTaxiZoneTripCountsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTaxiZoneTripCountsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetTaxiZoneTripCountsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaxiZoneTripCountsRequestV1.Merge(m, src)
+}
+func (m *GetTaxiZoneTripCountsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetTaxiZoneTripCountsRequestV1.Size(m)
+}
+func (m *GetTaxiZoneTripCountsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaxiZoneTripCountsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaxiZoneTripCountsRequestV1 proto.InternalMessageInfo
+
+func (m *GetTaxiZoneTripCountsRequestV1) GetLocationIds() []string {
+	if m != nil {
+		return m.LocationIds
+	}
+	return nil
+}
+
+func (m *GetTaxiZoneTripCountsRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetTaxiZoneTripCountsRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetTaxiZoneTripCountsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetTaxiZoneTripCountsResponseV1 struct {
+	Counts               []*objects.TaxiZoneTripCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	Error                string                       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *GetTaxiZoneTripCountsResponseV1) Reset()         { *m = GetTaxiZoneTripCountsResponseV1{} }
+func (m *GetTaxiZoneTripCountsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetTaxiZoneTripCountsResponseV1) ProtoMessage()    {}
+func (*GetTaxiZoneTripCountsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{39}
+}
+
+func (m *GetTaxiZoneTripCountsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTaxiZoneTripCountsResponseV1.Unmarshal(m, b)
+}
+func (m *GetTaxiZoneTripCountsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTaxiZoneTripCountsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetTaxiZoneTripCountsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaxiZoneTripCountsResponseV1.Merge(m, src)
+}
+func (m *GetTaxiZoneTripCountsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetTaxiZoneTripCountsResponseV1.Size(m)
+}
+func (m *GetTaxiZoneTripCountsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaxiZoneTripCountsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaxiZoneTripCountsResponseV1 proto.InternalMessageInfo
+
+func (m *GetTaxiZoneTripCountsResponseV1) GetCounts() []*objects.TaxiZoneTripCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+func (m *GetTaxiZoneTripCountsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetCabZoneCoverageRequestV1 struct {
+	CabIds               []string        `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string          `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string          `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,4,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCabZoneCoverageRequestV1) Reset()         { *m = GetCabZoneCoverageRequestV1{} }
+func (m *GetCabZoneCoverageRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetCabZoneCoverageRequestV1) ProtoMessage()    {}
+func (*GetCabZoneCoverageRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{40}
+}
+
+func (m *GetCabZoneCoverageRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabZoneCoverageRequestV1.Unmarshal(m, b)
+}
+func (m *GetCabZoneCoverageRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabZoneCoverageRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetCabZoneCoverageRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabZoneCoverageRequestV1.Merge(m, src)
+}
+func (m *GetCabZoneCoverageRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetCabZoneCoverageRequestV1.Size(m)
+}
+func (m *GetCabZoneCoverageRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabZoneCoverageRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabZoneCoverageRequestV1 proto.InternalMessageInfo
+
+func (m *GetCabZoneCoverageRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetCabZoneCoverageRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetCabZoneCoverageRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetCabZoneCoverageRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetCabZoneCoverageResponseV1 struct {
+	Coverage             []*objects.CabZoneCoverage `protobuf:"bytes,1,rep,name=coverage,proto3" json:"coverage,omitempty"`
+	Error                string                     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetCabZoneCoverageResponseV1) Reset()         { *m = GetCabZoneCoverageResponseV1{} }
+func (m *GetCabZoneCoverageResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetCabZoneCoverageResponseV1) ProtoMessage()    {}
+func (*GetCabZoneCoverageResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{41}
+}
+
+func (m *GetCabZoneCoverageResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabZoneCoverageResponseV1.Unmarshal(m, b)
+}
+func (m *GetCabZoneCoverageResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabZoneCoverageResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetCabZoneCoverageResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabZoneCoverageResponseV1.Merge(m, src)
+}
+func (m *GetCabZoneCoverageResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetCabZoneCoverageResponseV1.Size(m)
+}
+func (m *GetCabZoneCoverageResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabZoneCoverageResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabZoneCoverageResponseV1 proto.InternalMessageInfo
+
+func (m *GetCabZoneCoverageResponseV1) GetCoverage() []*objects.CabZoneCoverage {
+	if m != nil {
+		return m.Coverage
+	}
+	return nil
+}
+
+func (m *GetCabZoneCoverageResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetCabRevenueRequestV1 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,5,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCabRevenueRequestV1) Reset()         { *m = GetCabRevenueRequestV1{} }
+func (m *GetCabRevenueRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetCabRevenueRequestV1) ProtoMessage()    {}
+func (*GetCabRevenueRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{42}
+}
+
+func (m *GetCabRevenueRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabRevenueRequestV1.Unmarshal(m, b)
+}
+func (m *GetCabRevenueRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabRevenueRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetCabRevenueRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabRevenueRequestV1.Merge(m, src)
+}
+func (m *GetCabRevenueRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetCabRevenueRequestV1.Size(m)
+}
+func (m *GetCabRevenueRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabRevenueRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabRevenueRequestV1 proto.InternalMessageInfo
+
+func (m *GetCabRevenueRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetCabRevenueRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetCabRevenueRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetCabRevenueRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetCabRevenueRequestV1) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetCabRevenueRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetCabRevenueResponseV1 struct {
+	Revenue              []*objects.CabRevenue `protobuf:"bytes,1,rep,name=revenue,proto3" json:"revenue,omitempty"`
+	Error                string                `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCabRevenueResponseV1) Reset()         { *m = GetCabRevenueResponseV1{} }
+func (m *GetCabRevenueResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetCabRevenueResponseV1) ProtoMessage()    {}
+func (*GetCabRevenueResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{43}
+}
+
+func (m *GetCabRevenueResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabRevenueResponseV1.Unmarshal(m, b)
+}
+func (m *GetCabRevenueResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabRevenueResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetCabRevenueResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabRevenueResponseV1.Merge(m, src)
+}
+func (m *GetCabRevenueResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetCabRevenueResponseV1.Size(m)
+}
+func (m *GetCabRevenueResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabRevenueResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabRevenueResponseV1 proto.InternalMessageInfo
+
+func (m *GetCabRevenueResponseV1) GetRevenue() []*objects.CabRevenue {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+func (m *GetCabRevenueResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetTipRatesRequestV1 struct {
+	CabIds               []string        `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string          `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string          `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool            `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetTipRatesRequestV1) Reset()         { *m = GetTipRatesRequestV1{} }
+func (m *GetTipRatesRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetTipRatesRequestV1) ProtoMessage()    {}
+func (*GetTipRatesRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{44}
+}
+
+func (m *GetTipRatesRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTipRatesRequestV1.Unmarshal(m, b)
+}
+func (m *GetTipRatesRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTipRatesRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetTipRatesRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTipRatesRequestV1.Merge(m, src)
+}
+func (m *GetTipRatesRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetTipRatesRequestV1.Size(m)
+}
+func (m *GetTipRatesRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTipRatesRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTipRatesRequestV1 proto.InternalMessageInfo
+
+func (m *GetTipRatesRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetTipRatesRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetTipRatesRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetTipRatesRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetTipRatesRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetTipRatesResponseV1 struct {
+	Fleet                *objects.TipRate   `protobuf:"bytes,1,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	Cabs                 []*objects.TipRate `protobuf:"bytes,2,rep,name=cabs,proto3" json:"cabs,omitempty"`
+	Error                string             `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetTipRatesResponseV1) Reset()         { *m = GetTipRatesResponseV1{} }
+func (m *GetTipRatesResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetTipRatesResponseV1) ProtoMessage()    {}
+func (*GetTipRatesResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{45}
+}
+
+func (m *GetTipRatesResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTipRatesResponseV1.Unmarshal(m, b)
+}
+func (m *GetTipRatesResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTipRatesResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetTipRatesResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTipRatesResponseV1.Merge(m, src)
+}
+func (m *GetTipRatesResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetTipRatesResponseV1.Size(m)
+}
+func (m *GetTipRatesResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTipRatesResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTipRatesResponseV1 proto.InternalMessageInfo
+
+func (m *GetTipRatesResponseV1) GetFleet() *objects.TipRate {
+	if m != nil {
+		return m.Fleet
+	}
+	return nil
+}
+
+func (m *GetTipRatesResponseV1) GetCabs() []*objects.TipRate {
+	if m != nil {
+		return m.Cabs
+	}
+	return nil
+}
+
+func (m *GetTipRatesResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetPaymentTypeMixRequestV1 struct {
+	CabIds               []string        `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string          `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string          `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool            `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetPaymentTypeMixRequestV1) Reset()         { *m = GetPaymentTypeMixRequestV1{} }
+func (m *GetPaymentTypeMixRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetPaymentTypeMixRequestV1) ProtoMessage()    {}
+func (*GetPaymentTypeMixRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{46}
+}
+
+func (m *GetPaymentTypeMixRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaymentTypeMixRequestV1.Unmarshal(m, b)
+}
+func (m *GetPaymentTypeMixRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaymentTypeMixRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetPaymentTypeMixRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaymentTypeMixRequestV1.Merge(m, src)
+}
+func (m *GetPaymentTypeMixRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetPaymentTypeMixRequestV1.Size(m)
+}
+func (m *GetPaymentTypeMixRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaymentTypeMixRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPaymentTypeMixRequestV1 proto.InternalMessageInfo
+
+func (m *GetPaymentTypeMixRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetPaymentTypeMixRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetPaymentTypeMixRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetPaymentTypeMixRequestV1) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetPaymentTypeMixRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type GetPaymentTypeMixResponseV1 struct {
+	Fleet                *objects.PaymentTypeMix   `protobuf:"bytes,1,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	Cabs                 []*objects.PaymentTypeMix `protobuf:"bytes,2,rep,name=cabs,proto3" json:"cabs,omitempty"`
+	Error                string                    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetPaymentTypeMixResponseV1) Reset()         { *m = GetPaymentTypeMixResponseV1{} }
+func (m *GetPaymentTypeMixResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetPaymentTypeMixResponseV1) ProtoMessage()    {}
+func (*GetPaymentTypeMixResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{47}
+}
+
+func (m *GetPaymentTypeMixResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaymentTypeMixResponseV1.Unmarshal(m, b)
+}
+func (m *GetPaymentTypeMixResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaymentTypeMixResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetPaymentTypeMixResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaymentTypeMixResponseV1.Merge(m, src)
+}
+func (m *GetPaymentTypeMixResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetPaymentTypeMixResponseV1.Size(m)
+}
+func (m *GetPaymentTypeMixResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaymentTypeMixResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPaymentTypeMixResponseV1 proto.InternalMessageInfo
+
+func (m *GetPaymentTypeMixResponseV1) GetFleet() *objects.PaymentTypeMix {
+	if m != nil {
+		return m.Fleet
+	}
+	return nil
+}
+
+func (m *GetPaymentTypeMixResponseV1) GetCabs() []*objects.PaymentTypeMix {
+	if m != nil {
+		return m.Cabs
+	}
+	return nil
+}
+
+func (m *GetPaymentTypeMixResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type BatchGetTripCountsRequestV1 struct {
+	Requests             []*GetTripCountsForCabIDsRequestV1 `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *BatchGetTripCountsRequestV1) Reset()         { *m = BatchGetTripCountsRequestV1{} }
+func (m *BatchGetTripCountsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*BatchGetTripCountsRequestV1) ProtoMessage()    {}
+func (*BatchGetTripCountsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{48}
+}
+
+func (m *BatchGetTripCountsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchGetTripCountsRequestV1.Unmarshal(m, b)
+}
+func (m *BatchGetTripCountsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchGetTripCountsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *BatchGetTripCountsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetTripCountsRequestV1.Merge(m, src)
+}
+func (m *BatchGetTripCountsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_BatchGetTripCountsRequestV1.Size(m)
+}
+func (m *BatchGetTripCountsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetTripCountsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchGetTripCountsRequestV1 proto.InternalMessageInfo
+
+func (m *BatchGetTripCountsRequestV1) GetRequests() []*GetTripCountsForCabIDsRequestV1 {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+type BatchGetTripCountsResponseV1 struct {
+	Responses            []*GetTripCountsForCabIDsResponseV1 `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+	Error                string                              `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *BatchGetTripCountsResponseV1) Reset()         { *m = BatchGetTripCountsResponseV1{} }
+func (m *BatchGetTripCountsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*BatchGetTripCountsResponseV1) ProtoMessage()    {}
+func (*BatchGetTripCountsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{49}
+}
+
+func (m *BatchGetTripCountsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchGetTripCountsResponseV1.Unmarshal(m, b)
+}
+func (m *BatchGetTripCountsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchGetTripCountsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *BatchGetTripCountsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetTripCountsResponseV1.Merge(m, src)
+}
+func (m *BatchGetTripCountsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_BatchGetTripCountsResponseV1.Size(m)
+}
+func (m *BatchGetTripCountsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetTripCountsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchGetTripCountsResponseV1 proto.InternalMessageInfo
+
+func (m *BatchGetTripCountsResponseV1) GetResponses() []*GetTripCountsForCabIDsResponseV1 {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+func (m *BatchGetTripCountsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// QueryJob is a query running in the background, its result is kept until the job is evicted by newer jobs
+// Uses date/time in format 'YYYY-MM-DD HH:MM:SS'
+type QueryJob struct {
+	JobId      string        `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Query      string        `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	State      QueryJobState `protobuf:"varint,3,opt,name=state,proto3,enum=nycab.rpc.QueryJobState" json:"state,omitempty"`
+	Progress   float64       `protobuf:"fixed64,4,opt,name=progress,proto3" json:"progress,omitempty"`
+	SubmitTime string        `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3" json:"submit_time,omitempty"`
+	StartTime  string        `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    string        `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Error      string        `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// set when SUCCEEDED, named after the query
+	//
+	// Types that are valid to be assigned to Result:
+	//	*QueryJob_AllCabTrips
+	//	*QueryJob_AllDriverTrips
+	//	*QueryJob_BatchTripCounts
+	//	*QueryJob_PickupHeatmap
+	//	*QueryJob_OriginDestinationMatrix
+	//	*QueryJob_CabUtilization
+	//	*QueryJob_TripPatterns
+	//	*QueryJob_CountAnomalies
+	//	*QueryJob_Forecast
+	//	*QueryJob_PassengerCounts
+	//	*QueryJob_VendorStats
+	//	*QueryJob_TaxiZoneTripCounts
+	//	*QueryJob_CabZoneCoverage
+	//	*QueryJob_CabRevenue
+	//	*QueryJob_TipRates
+	//	*QueryJob_PaymentTypeMix
+	Result               isQueryJob_Result `protobuf_oneof:"result"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *QueryJob) Reset()         { *m = QueryJob{} }
+func (m *QueryJob) String() string { return proto.CompactTextString(m) }
+func (*QueryJob) ProtoMessage()    {}
+func (*QueryJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{50}
+}
+
+func (m *QueryJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryJob.Unmarshal(m, b)
+}
+func (m *QueryJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryJob.Marshal(b, m, deterministic)
+}
+func (m *QueryJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJob.Merge(m, src)
+}
+func (m *QueryJob) XXX_Size() int {
+	return xxx_messageInfo_QueryJob.Size(m)
+}
+func (m *QueryJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryJob proto.InternalMessageInfo
+
+func (m *QueryJob) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *QueryJob) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *QueryJob) GetState() QueryJobState {
+	if m != nil {
+		return m.State
+	}
+	return QueryJobState_PENDING
+}
+
+func (m *QueryJob) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *QueryJob) GetSubmitTime() string {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return ""
+}
+
+func (m *QueryJob) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *QueryJob) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *QueryJob) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type isQueryJob_Result interface {
+	isQueryJob_Result()
+}
+
+type QueryJob_AllCabTrips struct {
+	AllCabTrips *GetAllCabTripsResponseV1 `protobuf:"bytes,17,opt,name=all_cab_trips,json=allCabTrips,proto3,oneof"`
+}
+
+type QueryJob_AllDriverTrips struct {
+	AllDriverTrips *GetAllDriverTripsResponseV1 `protobuf:"bytes,18,opt,name=all_driver_trips,json=allDriverTrips,proto3,oneof"`
+}
+
+type QueryJob_BatchTripCounts struct {
+	BatchTripCounts *BatchGetTripCountsResponseV1 `protobuf:"bytes,19,opt,name=batch_trip_counts,json=batchTripCounts,proto3,oneof"`
+}
+
+type QueryJob_PickupHeatmap struct {
+	PickupHeatmap *GetPickupHeatmapResponseV1 `protobuf:"bytes,20,opt,name=pickup_heatmap,json=pickupHeatmap,proto3,oneof"`
+}
+
+type QueryJob_OriginDestinationMatrix struct {
+	OriginDestinationMatrix *GetOriginDestinationMatrixResponseV1 `protobuf:"bytes,21,opt,name=origin_destination_matrix,json=originDestinationMatrix,proto3,oneof"`
+}
+
+type QueryJob_CabUtilization struct {
+	CabUtilization *GetCabUtilizationResponseV1 `protobuf:"bytes,22,opt,name=cab_utilization,json=cabUtilization,proto3,oneof"`
+}
+
+type QueryJob_TripPatterns struct {
+	TripPatterns *GetTripPatternsResponseV1 `protobuf:"bytes,23,opt,name=trip_patterns,json=tripPatterns,proto3,oneof"`
+}
+
+type QueryJob_CountAnomalies struct {
+	CountAnomalies *DetectCountAnomaliesResponseV1 `protobuf:"bytes,24,opt,name=count_anomalies,json=countAnomalies,proto3,oneof"`
+}
+
+type QueryJob_Forecast struct {
+	Forecast *ForecastTripsResponseV1 `protobuf:"bytes,25,opt,name=forecast,proto3,oneof"`
+}
+
+type QueryJob_PassengerCounts struct {
+	PassengerCounts *GetPassengerCountsResponseV1 `protobuf:"bytes,26,opt,name=passenger_counts,json=passengerCounts,proto3,oneof"`
+}
+
+type QueryJob_VendorStats struct {
+	VendorStats *GetVendorStatsResponseV1 `protobuf:"bytes,27,opt,name=vendor_stats,json=vendorStats,proto3,oneof"`
+}
+
+type QueryJob_TaxiZoneTripCounts struct {
+	TaxiZoneTripCounts *GetTaxiZoneTripCountsResponseV1 `protobuf:"bytes,28,opt,name=taxi_zone_trip_counts,json=taxiZoneTripCounts,proto3,oneof"`
+}
+
+type QueryJob_CabZoneCoverage struct {
+	CabZoneCoverage *GetCabZoneCoverageResponseV1 `protobuf:"bytes,29,opt,name=cab_zone_coverage,json=cabZoneCoverage,proto3,oneof"`
+}
+
+type QueryJob_CabRevenue struct {
+	CabRevenue *GetCabRevenueResponseV1 `protobuf:"bytes,30,opt,name=cab_revenue,json=cabRevenue,proto3,oneof"`
+}
+
+type QueryJob_TipRates struct {
+	TipRates *GetTipRatesResponseV1 `protobuf:"bytes,31,opt,name=tip_rates,json=tipRates,proto3,oneof"`
+}
+
+type QueryJob_PaymentTypeMix struct {
+	PaymentTypeMix *GetPaymentTypeMixResponseV1 `protobuf:"bytes,32,opt,name=payment_type_mix,json=paymentTypeMix,proto3,oneof"`
+}
+
+func (*QueryJob_AllCabTrips) isQueryJob_Result() {}
+
+func (*QueryJob_AllDriverTrips) isQueryJob_Result() {}
+
+func (*QueryJob_BatchTripCounts) isQueryJob_Result() {}
+
+func (*QueryJob_PickupHeatmap) isQueryJob_Result() {}
+
+func (*QueryJob_OriginDestinationMatrix) isQueryJob_Result() {}
+
+func (*QueryJob_CabUtilization) isQueryJob_Result() {}
+
+func (*QueryJob_TripPatterns) isQueryJob_Result() {}
+
+func (*QueryJob_CountAnomalies) isQueryJob_Result() {}
+
+func (*QueryJob_Forecast) isQueryJob_Result() {}
+
+func (*QueryJob_PassengerCounts) isQueryJob_Result() {}
+
+func (*QueryJob_VendorStats) isQueryJob_Result() {}
+
+func (*QueryJob_TaxiZoneTripCounts) isQueryJob_Result() {}
+
+func (*QueryJob_CabZoneCoverage) isQueryJob_Result() {}
+
+func (*QueryJob_CabRevenue) isQueryJob_Result() {}
+
+func (*QueryJob_TipRates) isQueryJob_Result() {}
+
+func (*QueryJob_PaymentTypeMix) isQueryJob_Result() {}
+
+func (m *QueryJob) GetResult() isQueryJob_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *QueryJob) GetAllCabTrips() *GetAllCabTripsResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_AllCabTrips); ok {
+		return x.AllCabTrips
+	}
+	return nil
+}
+
+func (m *QueryJob) GetAllDriverTrips() *GetAllDriverTripsResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_AllDriverTrips); ok {
+		return x.AllDriverTrips
+	}
+	return nil
+}
+
+func (m *QueryJob) GetBatchTripCounts() *BatchGetTripCountsResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_BatchTripCounts); ok {
+		return x.BatchTripCounts
+	}
+	return nil
+}
+
+func (m *QueryJob) GetPickupHeatmap() *GetPickupHeatmapResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_PickupHeatmap); ok {
+		return x.PickupHeatmap
+	}
+	return nil
+}
+
+func (m *QueryJob) GetOriginDestinationMatrix() *GetOriginDestinationMatrixResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_OriginDestinationMatrix); ok {
+		return x.OriginDestinationMatrix
+	}
+	return nil
+}
+
+func (m *QueryJob) GetCabUtilization() *GetCabUtilizationResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_CabUtilization); ok {
+		return x.CabUtilization
+	}
+	return nil
+}
+
+func (m *QueryJob) GetTripPatterns() *GetTripPatternsResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_TripPatterns); ok {
+		return x.TripPatterns
+	}
+	return nil
+}
+
+func (m *QueryJob) GetCountAnomalies() *DetectCountAnomaliesResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_CountAnomalies); ok {
+		return x.CountAnomalies
+	}
+	return nil
+}
+
+func (m *QueryJob) GetForecast() *ForecastTripsResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_Forecast); ok {
+		return x.Forecast
+	}
+	return nil
+}
+
+func (m *QueryJob) GetPassengerCounts() *GetPassengerCountsResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_PassengerCounts); ok {
+		return x.PassengerCounts
+	}
+	return nil
+}
+
+func (m *QueryJob) GetVendorStats() *GetVendorStatsResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_VendorStats); ok {
+		return x.VendorStats
+	}
+	return nil
+}
+
+func (m *QueryJob) GetTaxiZoneTripCounts() *GetTaxiZoneTripCountsResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_TaxiZoneTripCounts); ok {
+		return x.TaxiZoneTripCounts
+	}
+	return nil
+}
+
+func (m *QueryJob) GetCabZoneCoverage() *GetCabZoneCoverageResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_CabZoneCoverage); ok {
+		return x.CabZoneCoverage
+	}
+	return nil
+}
+
+func (m *QueryJob) GetCabRevenue() *GetCabRevenueResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_CabRevenue); ok {
+		return x.CabRevenue
+	}
+	return nil
+}
+
+func (m *QueryJob) GetTipRates() *GetTipRatesResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_TipRates); ok {
+		return x.TipRates
+	}
+	return nil
+}
+
+func (m *QueryJob) GetPaymentTypeMix() *GetPaymentTypeMixResponseV1 {
+	if x, ok := m.GetResult().(*QueryJob_PaymentTypeMix); ok {
+		return x.PaymentTypeMix
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*QueryJob) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*QueryJob_AllCabTrips)(nil),
+		(*QueryJob_AllDriverTrips)(nil),
+		(*QueryJob_BatchTripCounts)(nil),
+		(*QueryJob_PickupHeatmap)(nil),
+		(*QueryJob_OriginDestinationMatrix)(nil),
+		(*QueryJob_CabUtilization)(nil),
+		(*QueryJob_TripPatterns)(nil),
+		(*QueryJob_CountAnomalies)(nil),
+		(*QueryJob_Forecast)(nil),
+		(*QueryJob_PassengerCounts)(nil),
+		(*QueryJob_VendorStats)(nil),
+		(*QueryJob_TaxiZoneTripCounts)(nil),
+		(*QueryJob_CabZoneCoverage)(nil),
+		(*QueryJob_CabRevenue)(nil),
+		(*QueryJob_TipRates)(nil),
+		(*QueryJob_PaymentTypeMix)(nil),
+	}
+}
+
+type SubmitQueryJobRequestV1 struct {
+	// the query to run and its parameters
+	//
+	// Types that are valid to be assigned to Query:
+	//	*SubmitQueryJobRequestV1_AllCabTrips
+	//	*SubmitQueryJobRequestV1_AllDriverTrips
+	//	*SubmitQueryJobRequestV1_BatchTripCounts
+	//	*SubmitQueryJobRequestV1_PickupHeatmap
+	//	*SubmitQueryJobRequestV1_OriginDestinationMatrix
+	//	*SubmitQueryJobRequestV1_CabUtilization
+	//	*SubmitQueryJobRequestV1_TripPatterns
+	//	*SubmitQueryJobRequestV1_CountAnomalies
+	//	*SubmitQueryJobRequestV1_Forecast
+	//	*SubmitQueryJobRequestV1_PassengerCounts
+	//	*SubmitQueryJobRequestV1_VendorStats
+	//	*SubmitQueryJobRequestV1_TaxiZoneTripCounts
+	//	*SubmitQueryJobRequestV1_CabZoneCoverage
+	//	*SubmitQueryJobRequestV1_CabRevenue
+	//	*SubmitQueryJobRequestV1_TipRates
+	//	*SubmitQueryJobRequestV1_PaymentTypeMix
+	Query                isSubmitQueryJobRequestV1_Query `protobuf_oneof:"query"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *SubmitQueryJobRequestV1) Reset()         { *m = SubmitQueryJobRequestV1{} }
+func (m *SubmitQueryJobRequestV1) String() string { return proto.CompactTextString(m) }
+func (*SubmitQueryJobRequestV1) ProtoMessage()    {}
+func (*SubmitQueryJobRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{51}
+}
+
+func (m *SubmitQueryJobRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitQueryJobRequestV1.Unmarshal(m, b)
+}
+func (m *SubmitQueryJobRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitQueryJobRequestV1.Marshal(b, m, deterministic)
+}
+func (m *SubmitQueryJobRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitQueryJobRequestV1.Merge(m, src)
+}
+func (m *SubmitQueryJobRequestV1) XXX_Size() int {
+	return xxx_messageInfo_SubmitQueryJobRequestV1.Size(m)
+}
+func (m *SubmitQueryJobRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitQueryJobRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitQueryJobRequestV1 proto.InternalMessageInfo
+
+type isSubmitQueryJobRequestV1_Query interface {
+	isSubmitQueryJobRequestV1_Query()
+}
+
+type SubmitQueryJobRequestV1_AllCabTrips struct {
+	AllCabTrips *GetAllCabTripsRequestV1 `protobuf:"bytes,1,opt,name=all_cab_trips,json=allCabTrips,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_AllDriverTrips struct {
+	AllDriverTrips *GetAllDriverTripsRequestV1 `protobuf:"bytes,2,opt,name=all_driver_trips,json=allDriverTrips,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_BatchTripCounts struct {
+	BatchTripCounts *BatchGetTripCountsRequestV1 `protobuf:"bytes,3,opt,name=batch_trip_counts,json=batchTripCounts,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_PickupHeatmap struct {
+	PickupHeatmap *GetPickupHeatmapRequestV1 `protobuf:"bytes,4,opt,name=pickup_heatmap,json=pickupHeatmap,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_OriginDestinationMatrix struct {
+	OriginDestinationMatrix *GetOriginDestinationMatrixRequestV1 `protobuf:"bytes,5,opt,name=origin_destination_matrix,json=originDestinationMatrix,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_CabUtilization struct {
+	CabUtilization *GetCabUtilizationRequestV1 `protobuf:"bytes,6,opt,name=cab_utilization,json=cabUtilization,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_TripPatterns struct {
+	TripPatterns *GetTripPatternsRequestV1 `protobuf:"bytes,7,opt,name=trip_patterns,json=tripPatterns,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_CountAnomalies struct {
+	CountAnomalies *DetectCountAnomaliesRequestV1 `protobuf:"bytes,8,opt,name=count_anomalies,json=countAnomalies,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_Forecast struct {
+	Forecast *ForecastTripsRequestV1 `protobuf:"bytes,9,opt,name=forecast,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_PassengerCounts struct {
+	PassengerCounts *GetPassengerCountsRequestV1 `protobuf:"bytes,10,opt,name=passenger_counts,json=passengerCounts,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_VendorStats struct {
+	VendorStats *GetVendorStatsRequestV1 `protobuf:"bytes,11,opt,name=vendor_stats,json=vendorStats,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_TaxiZoneTripCounts struct {
+	TaxiZoneTripCounts *GetTaxiZoneTripCountsRequestV1 `protobuf:"bytes,12,opt,name=taxi_zone_trip_counts,json=taxiZoneTripCounts,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_CabZoneCoverage struct {
+	CabZoneCoverage *GetCabZoneCoverageRequestV1 `protobuf:"bytes,13,opt,name=cab_zone_coverage,json=cabZoneCoverage,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_CabRevenue struct {
+	CabRevenue *GetCabRevenueRequestV1 `protobuf:"bytes,14,opt,name=cab_revenue,json=cabRevenue,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_TipRates struct {
+	TipRates *GetTipRatesRequestV1 `protobuf:"bytes,15,opt,name=tip_rates,json=tipRates,proto3,oneof"`
+}
+
+type SubmitQueryJobRequestV1_PaymentTypeMix struct {
+	PaymentTypeMix *GetPaymentTypeMixRequestV1 `protobuf:"bytes,16,opt,name=payment_type_mix,json=paymentTypeMix,proto3,oneof"`
+}
+
+func (*SubmitQueryJobRequestV1_AllCabTrips) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_AllDriverTrips) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_BatchTripCounts) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_PickupHeatmap) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_OriginDestinationMatrix) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_CabUtilization) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_TripPatterns) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_CountAnomalies) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_Forecast) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_PassengerCounts) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_VendorStats) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_TaxiZoneTripCounts) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_CabZoneCoverage) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_CabRevenue) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_TipRates) isSubmitQueryJobRequestV1_Query() {}
+
+func (*SubmitQueryJobRequestV1_PaymentTypeMix) isSubmitQueryJobRequestV1_Query() {}
+
+func (m *SubmitQueryJobRequestV1) GetQuery() isSubmitQueryJobRequestV1_Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetAllCabTrips() *GetAllCabTripsRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_AllCabTrips); ok {
+		return x.AllCabTrips
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetAllDriverTrips() *GetAllDriverTripsRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_AllDriverTrips); ok {
+		return x.AllDriverTrips
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetBatchTripCounts() *BatchGetTripCountsRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_BatchTripCounts); ok {
+		return x.BatchTripCounts
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetPickupHeatmap() *GetPickupHeatmapRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_PickupHeatmap); ok {
+		return x.PickupHeatmap
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetOriginDestinationMatrix() *GetOriginDestinationMatrixRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_OriginDestinationMatrix); ok {
+		return x.OriginDestinationMatrix
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetCabUtilization() *GetCabUtilizationRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_CabUtilization); ok {
+		return x.CabUtilization
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetTripPatterns() *GetTripPatternsRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_TripPatterns); ok {
+		return x.TripPatterns
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetCountAnomalies() *DetectCountAnomaliesRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_CountAnomalies); ok {
+		return x.CountAnomalies
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetForecast() *ForecastTripsRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_Forecast); ok {
+		return x.Forecast
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetPassengerCounts() *GetPassengerCountsRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_PassengerCounts); ok {
+		return x.PassengerCounts
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetVendorStats() *GetVendorStatsRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_VendorStats); ok {
+		return x.VendorStats
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetTaxiZoneTripCounts() *GetTaxiZoneTripCountsRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_TaxiZoneTripCounts); ok {
+		return x.TaxiZoneTripCounts
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetCabZoneCoverage() *GetCabZoneCoverageRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_CabZoneCoverage); ok {
+		return x.CabZoneCoverage
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetCabRevenue() *GetCabRevenueRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_CabRevenue); ok {
+		return x.CabRevenue
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetTipRates() *GetTipRatesRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_TipRates); ok {
+		return x.TipRates
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobRequestV1) GetPaymentTypeMix() *GetPaymentTypeMixRequestV1 {
+	if x, ok := m.GetQuery().(*SubmitQueryJobRequestV1_PaymentTypeMix); ok {
+		return x.PaymentTypeMix
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SubmitQueryJobRequestV1) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SubmitQueryJobRequestV1_AllCabTrips)(nil),
+		(*SubmitQueryJobRequestV1_AllDriverTrips)(nil),
+		(*SubmitQueryJobRequestV1_BatchTripCounts)(nil),
+		(*SubmitQueryJobRequestV1_PickupHeatmap)(nil),
+		(*SubmitQueryJobRequestV1_OriginDestinationMatrix)(nil),
+		(*SubmitQueryJobRequestV1_CabUtilization)(nil),
+		(*SubmitQueryJobRequestV1_TripPatterns)(nil),
+		(*SubmitQueryJobRequestV1_CountAnomalies)(nil),
+		(*SubmitQueryJobRequestV1_Forecast)(nil),
+		(*SubmitQueryJobRequestV1_PassengerCounts)(nil),
+		(*SubmitQueryJobRequestV1_VendorStats)(nil),
+		(*SubmitQueryJobRequestV1_TaxiZoneTripCounts)(nil),
+		(*SubmitQueryJobRequestV1_CabZoneCoverage)(nil),
+		(*SubmitQueryJobRequestV1_CabRevenue)(nil),
+		(*SubmitQueryJobRequestV1_TipRates)(nil),
+		(*SubmitQueryJobRequestV1_PaymentTypeMix)(nil),
+	}
+}
+
+type SubmitQueryJobResponseV1 struct {
+	Job                  *QueryJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Error                string    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SubmitQueryJobResponseV1) Reset()         { *m = SubmitQueryJobResponseV1{} }
+func (m *SubmitQueryJobResponseV1) String() string { return proto.CompactTextString(m) }
+func (*SubmitQueryJobResponseV1) ProtoMessage()    {}
+func (*SubmitQueryJobResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{52}
+}
+
+func (m *SubmitQueryJobResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitQueryJobResponseV1.Unmarshal(m, b)
+}
+func (m *SubmitQueryJobResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitQueryJobResponseV1.Marshal(b, m, deterministic)
+}
+func (m *SubmitQueryJobResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitQueryJobResponseV1.Merge(m, src)
+}
+func (m *SubmitQueryJobResponseV1) XXX_Size() int {
+	return xxx_messageInfo_SubmitQueryJobResponseV1.Size(m)
+}
+func (m *SubmitQueryJobResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitQueryJobResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitQueryJobResponseV1 proto.InternalMessageInfo
+
+func (m *SubmitQueryJobResponseV1) GetJob() *QueryJob {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *SubmitQueryJobResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type GetQueryJobRequestV1 struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetQueryJobRequestV1) Reset()         { *m = GetQueryJobRequestV1{} }
+func (m *GetQueryJobRequestV1) String() string { return proto.CompactTextString(m) }
+func (*GetQueryJobRequestV1) ProtoMessage()    {}
+func (*GetQueryJobRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{53}
+}
+
+func (m *GetQueryJobRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQueryJobRequestV1.Unmarshal(m, b)
+}
+func (m *GetQueryJobRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQueryJobRequestV1.Marshal(b, m, deterministic)
+}
+func (m *GetQueryJobRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueryJobRequestV1.Merge(m, src)
+}
+func (m *GetQueryJobRequestV1) XXX_Size() int {
+	return xxx_messageInfo_GetQueryJobRequestV1.Size(m)
+}
+func (m *GetQueryJobRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueryJobRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueryJobRequestV1 proto.InternalMessageInfo
+
+func (m *GetQueryJobRequestV1) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+type GetQueryJobResponseV1 struct {
+	Job                  *QueryJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Error                string    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetQueryJobResponseV1) Reset()         { *m = GetQueryJobResponseV1{} }
+func (m *GetQueryJobResponseV1) String() string { return proto.CompactTextString(m) }
+func (*GetQueryJobResponseV1) ProtoMessage()    {}
+func (*GetQueryJobResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{54}
+}
+
+func (m *GetQueryJobResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQueryJobResponseV1.Unmarshal(m, b)
+}
+func (m *GetQueryJobResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQueryJobResponseV1.Marshal(b, m, deterministic)
+}
+func (m *GetQueryJobResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQueryJobResponseV1.Merge(m, src)
+}
+func (m *GetQueryJobResponseV1) XXX_Size() int {
+	return xxx_messageInfo_GetQueryJobResponseV1.Size(m)
+}
+func (m *GetQueryJobResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQueryJobResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQueryJobResponseV1 proto.InternalMessageInfo
+
+func (m *GetQueryJobResponseV1) GetJob() *QueryJob {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *GetQueryJobResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type CancelQueryJobRequestV1 struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelQueryJobRequestV1) Reset()         { *m = CancelQueryJobRequestV1{} }
+func (m *CancelQueryJobRequestV1) String() string { return proto.CompactTextString(m) }
+func (*CancelQueryJobRequestV1) ProtoMessage()    {}
+func (*CancelQueryJobRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{55}
+}
+
+func (m *CancelQueryJobRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelQueryJobRequestV1.Unmarshal(m, b)
+}
+func (m *CancelQueryJobRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelQueryJobRequestV1.Marshal(b, m, deterministic)
+}
+func (m *CancelQueryJobRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelQueryJobRequestV1.Merge(m, src)
+}
+func (m *CancelQueryJobRequestV1) XXX_Size() int {
+	return xxx_messageInfo_CancelQueryJobRequestV1.Size(m)
+}
+func (m *CancelQueryJobRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelQueryJobRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelQueryJobRequestV1 proto.InternalMessageInfo
+
+func (m *CancelQueryJobRequestV1) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+type CancelQueryJobResponseV1 struct {
+	Job                  *QueryJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Error                string    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CancelQueryJobResponseV1) Reset()         { *m = CancelQueryJobResponseV1{} }
+func (m *CancelQueryJobResponseV1) String() string { return proto.CompactTextString(m) }
+func (*CancelQueryJobResponseV1) ProtoMessage()    {}
+func (*CancelQueryJobResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{56}
+}
+
+func (m *CancelQueryJobResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelQueryJobResponseV1.Unmarshal(m, b)
+}
+func (m *CancelQueryJobResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelQueryJobResponseV1.Marshal(b, m, deterministic)
+}
+func (m *CancelQueryJobResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelQueryJobResponseV1.Merge(m, src)
+}
+func (m *CancelQueryJobResponseV1) XXX_Size() int {
+	return xxx_messageInfo_CancelQueryJobResponseV1.Size(m)
+}
+func (m *CancelQueryJobResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelQueryJobResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelQueryJobResponseV1 proto.InternalMessageInfo
+
+func (m *CancelQueryJobResponseV1) GetJob() *QueryJob {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *CancelQueryJobResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// ExportColumn is a column of exported query results
+type ExportColumn struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportColumn) Reset()         { *m = ExportColumn{} }
+func (m *ExportColumn) String() string { return proto.CompactTextString(m) }
+func (*ExportColumn) ProtoMessage()    {}
+func (*ExportColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{57}
+}
+
+func (m *ExportColumn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportColumn.Unmarshal(m, b)
+}
+func (m *ExportColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportColumn.Marshal(b, m, deterministic)
+}
+func (m *ExportColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportColumn.Merge(m, src)
+}
+func (m *ExportColumn) XXX_Size() int {
+	return xxx_messageInfo_ExportColumn.Size(m)
+}
+func (m *ExportColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportColumn proto.InternalMessageInfo
+
+func (m *ExportColumn) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExportColumn) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// ExportSchema describes exported query results
+type ExportSchema struct {
+	Query                string          `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Request              string          `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	ExportTime           string          `protobuf:"bytes,3,opt,name=export_time,json=exportTime,proto3" json:"export_time,omitempty"`
+	RowCount             uint64          `protobuf:"varint,4,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Columns              []*ExportColumn `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ExportSchema) Reset()         { *m = ExportSchema{} }
+func (m *ExportSchema) String() string { return proto.CompactTextString(m) }
+func (*ExportSchema) ProtoMessage()    {}
+func (*ExportSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{58}
+}
+
+func (m *ExportSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportSchema.Unmarshal(m, b)
+}
+func (m *ExportSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportSchema.Marshal(b, m, deterministic)
+}
+func (m *ExportSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportSchema.Merge(m, src)
+}
+func (m *ExportSchema) XXX_Size() int {
+	return xxx_messageInfo_ExportSchema.Size(m)
+}
+func (m *ExportSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportSchema proto.InternalMessageInfo
+
+func (m *ExportSchema) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ExportSchema) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *ExportSchema) GetExportTime() string {
+	if m != nil {
+		return m.ExportTime
+	}
+	return ""
+}
+
+func (m *ExportSchema) GetRowCount() uint64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ExportSchema) GetColumns() []*ExportColumn {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+type ExportQueryRequestV1 struct {
+	// the aggregation query to export and its parameters, same as query jobs but batch_trip_counts
+	//
+	// Types that are valid to be assigned to Query:
+	//	*ExportQueryRequestV1_AllCabTrips
+	//	*ExportQueryRequestV1_AllDriverTrips
+	//	*ExportQueryRequestV1_PickupHeatmap
+	//	*ExportQueryRequestV1_OriginDestinationMatrix
+	//	*ExportQueryRequestV1_CabUtilization
+	//	*ExportQueryRequestV1_TripPatterns
+	//	*ExportQueryRequestV1_CountAnomalies
+	//	*ExportQueryRequestV1_Forecast
+	//	*ExportQueryRequestV1_PassengerCounts
+	//	*ExportQueryRequestV1_VendorStats
+	//	*ExportQueryRequestV1_TaxiZoneTripCounts
+	//	*ExportQueryRequestV1_CabZoneCoverage
+	//	*ExportQueryRequestV1_CabRevenue
+	//	*ExportQueryRequestV1_TipRates
+	//	*ExportQueryRequestV1_PaymentTypeMix
+	Query                isExportQueryRequestV1_Query `protobuf_oneof:"query"`
+	Format               ExportFormat                 `protobuf:"varint,17,opt,name=format,proto3,enum=nycab.rpc.ExportFormat" json:"format,omitempty"`
+	FileName             string                       `protobuf:"bytes,18,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ExportQueryRequestV1) Reset()         { *m = ExportQueryRequestV1{} }
+func (m *ExportQueryRequestV1) String() string { return proto.CompactTextString(m) }
+func (*ExportQueryRequestV1) ProtoMessage()    {}
+func (*ExportQueryRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{59}
+}
+
+func (m *ExportQueryRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportQueryRequestV1.Unmarshal(m, b)
+}
+func (m *ExportQueryRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportQueryRequestV1.Marshal(b, m, deterministic)
+}
+func (m *ExportQueryRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportQueryRequestV1.Merge(m, src)
+}
+func (m *ExportQueryRequestV1) XXX_Size() int {
+	return xxx_messageInfo_ExportQueryRequestV1.Size(m)
+}
+func (m *ExportQueryRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportQueryRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportQueryRequestV1 proto.InternalMessageInfo
+
+type isExportQueryRequestV1_Query interface {
+	isExportQueryRequestV1_Query()
+}
+
+type ExportQueryRequestV1_AllCabTrips struct {
+	AllCabTrips *GetAllCabTripsRequestV1 `protobuf:"bytes,1,opt,name=all_cab_trips,json=allCabTrips,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_AllDriverTrips struct {
+	AllDriverTrips *GetAllDriverTripsRequestV1 `protobuf:"bytes,2,opt,name=all_driver_trips,json=allDriverTrips,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_PickupHeatmap struct {
+	PickupHeatmap *GetPickupHeatmapRequestV1 `protobuf:"bytes,4,opt,name=pickup_heatmap,json=pickupHeatmap,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_OriginDestinationMatrix struct {
+	OriginDestinationMatrix *GetOriginDestinationMatrixRequestV1 `protobuf:"bytes,5,opt,name=origin_destination_matrix,json=originDestinationMatrix,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_CabUtilization struct {
+	CabUtilization *GetCabUtilizationRequestV1 `protobuf:"bytes,6,opt,name=cab_utilization,json=cabUtilization,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_TripPatterns struct {
+	TripPatterns *GetTripPatternsRequestV1 `protobuf:"bytes,7,opt,name=trip_patterns,json=tripPatterns,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_CountAnomalies struct {
+	CountAnomalies *DetectCountAnomaliesRequestV1 `protobuf:"bytes,8,opt,name=count_anomalies,json=countAnomalies,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_Forecast struct {
+	Forecast *ForecastTripsRequestV1 `protobuf:"bytes,9,opt,name=forecast,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_PassengerCounts struct {
+	PassengerCounts *GetPassengerCountsRequestV1 `protobuf:"bytes,10,opt,name=passenger_counts,json=passengerCounts,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_VendorStats struct {
+	VendorStats *GetVendorStatsRequestV1 `protobuf:"bytes,11,opt,name=vendor_stats,json=vendorStats,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_TaxiZoneTripCounts struct {
+	TaxiZoneTripCounts *GetTaxiZoneTripCountsRequestV1 `protobuf:"bytes,12,opt,name=taxi_zone_trip_counts,json=taxiZoneTripCounts,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_CabZoneCoverage struct {
+	CabZoneCoverage *GetCabZoneCoverageRequestV1 `protobuf:"bytes,13,opt,name=cab_zone_coverage,json=cabZoneCoverage,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_CabRevenue struct {
+	CabRevenue *GetCabRevenueRequestV1 `protobuf:"bytes,14,opt,name=cab_revenue,json=cabRevenue,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_TipRates struct {
+	TipRates *GetTipRatesRequestV1 `protobuf:"bytes,15,opt,name=tip_rates,json=tipRates,proto3,oneof"`
+}
+
+type ExportQueryRequestV1_PaymentTypeMix struct {
+	PaymentTypeMix *GetPaymentTypeMixRequestV1 `protobuf:"bytes,16,opt,name=payment_type_mix,json=paymentTypeMix,proto3,oneof"`
+}
+
+func (*ExportQueryRequestV1_AllCabTrips) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_AllDriverTrips) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_PickupHeatmap) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_OriginDestinationMatrix) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_CabUtilization) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_TripPatterns) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_CountAnomalies) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_Forecast) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_PassengerCounts) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_VendorStats) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_TaxiZoneTripCounts) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_CabZoneCoverage) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_CabRevenue) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_TipRates) isExportQueryRequestV1_Query() {}
+
+func (*ExportQueryRequestV1_PaymentTypeMix) isExportQueryRequestV1_Query() {}
+
+func (m *ExportQueryRequestV1) GetQuery() isExportQueryRequestV1_Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetAllCabTrips() *GetAllCabTripsRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_AllCabTrips); ok {
+		return x.AllCabTrips
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetAllDriverTrips() *GetAllDriverTripsRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_AllDriverTrips); ok {
+		return x.AllDriverTrips
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetPickupHeatmap() *GetPickupHeatmapRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_PickupHeatmap); ok {
+		return x.PickupHeatmap
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetOriginDestinationMatrix() *GetOriginDestinationMatrixRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_OriginDestinationMatrix); ok {
+		return x.OriginDestinationMatrix
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetCabUtilization() *GetCabUtilizationRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_CabUtilization); ok {
+		return x.CabUtilization
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetTripPatterns() *GetTripPatternsRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_TripPatterns); ok {
+		return x.TripPatterns
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetCountAnomalies() *DetectCountAnomaliesRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_CountAnomalies); ok {
+		return x.CountAnomalies
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetForecast() *ForecastTripsRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_Forecast); ok {
+		return x.Forecast
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetPassengerCounts() *GetPassengerCountsRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_PassengerCounts); ok {
+		return x.PassengerCounts
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetVendorStats() *GetVendorStatsRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_VendorStats); ok {
+		return x.VendorStats
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetTaxiZoneTripCounts() *GetTaxiZoneTripCountsRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_TaxiZoneTripCounts); ok {
+		return x.TaxiZoneTripCounts
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetCabZoneCoverage() *GetCabZoneCoverageRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_CabZoneCoverage); ok {
+		return x.CabZoneCoverage
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetCabRevenue() *GetCabRevenueRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_CabRevenue); ok {
+		return x.CabRevenue
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetTipRates() *GetTipRatesRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_TipRates); ok {
+		return x.TipRates
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetPaymentTypeMix() *GetPaymentTypeMixRequestV1 {
+	if x, ok := m.GetQuery().(*ExportQueryRequestV1_PaymentTypeMix); ok {
+		return x.PaymentTypeMix
+	}
+	return nil
+}
+
+func (m *ExportQueryRequestV1) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_CSV
+}
+
+func (m *ExportQueryRequestV1) GetFileName() string {
+	if m != nil {
+		return m.FileName
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ExportQueryRequestV1) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ExportQueryRequestV1_AllCabTrips)(nil),
+		(*ExportQueryRequestV1_AllDriverTrips)(nil),
+		(*ExportQueryRequestV1_PickupHeatmap)(nil),
+		(*ExportQueryRequestV1_OriginDestinationMatrix)(nil),
+		(*ExportQueryRequestV1_CabUtilization)(nil),
+		(*ExportQueryRequestV1_TripPatterns)(nil),
+		(*ExportQueryRequestV1_CountAnomalies)(nil),
+		(*ExportQueryRequestV1_Forecast)(nil),
+		(*ExportQueryRequestV1_PassengerCounts)(nil),
+		(*ExportQueryRequestV1_VendorStats)(nil),
+		(*ExportQueryRequestV1_TaxiZoneTripCounts)(nil),
+		(*ExportQueryRequestV1_CabZoneCoverage)(nil),
+		(*ExportQueryRequestV1_CabRevenue)(nil),
+		(*ExportQueryRequestV1_TipRates)(nil),
+		(*ExportQueryRequestV1_PaymentTypeMix)(nil),
+	}
+}
+
+// ExportQueryResponseV1 is a chunk of the exported file, the first chunk holds the schema
+type ExportQueryResponseV1 struct {
+	Schema               *ExportSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Path                 string        `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data                 []byte        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Error                string        `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ExportQueryResponseV1) Reset()         { *m = ExportQueryResponseV1{} }
+func (m *ExportQueryResponseV1) String() string { return proto.CompactTextString(m) }
+func (*ExportQueryResponseV1) ProtoMessage()    {}
+func (*ExportQueryResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{60}
+}
+
+func (m *ExportQueryResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportQueryResponseV1.Unmarshal(m, b)
+}
+func (m *ExportQueryResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportQueryResponseV1.Marshal(b, m, deterministic)
+}
+func (m *ExportQueryResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportQueryResponseV1.Merge(m, src)
+}
+func (m *ExportQueryResponseV1) XXX_Size() int {
+	return xxx_messageInfo_ExportQueryResponseV1.Size(m)
+}
+func (m *ExportQueryResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportQueryResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportQueryResponseV1 proto.InternalMessageInfo
+
+func (m *ExportQueryResponseV1) GetSchema() *ExportSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *ExportQueryResponseV1) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ExportQueryResponseV1) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ExportQueryResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type WatchTripCountsRequestV1 struct {
+	CabIds               []string        `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string          `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string          `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,4,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *WatchTripCountsRequestV1) Reset()         { *m = WatchTripCountsRequestV1{} }
+func (m *WatchTripCountsRequestV1) String() string { return proto.CompactTextString(m) }
+func (*WatchTripCountsRequestV1) ProtoMessage()    {}
+func (*WatchTripCountsRequestV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{61}
+}
+
+func (m *WatchTripCountsRequestV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchTripCountsRequestV1.Unmarshal(m, b)
+}
+func (m *WatchTripCountsRequestV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchTripCountsRequestV1.Marshal(b, m, deterministic)
+}
+func (m *WatchTripCountsRequestV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchTripCountsRequestV1.Merge(m, src)
+}
+func (m *WatchTripCountsRequestV1) XXX_Size() int {
+	return xxx_messageInfo_WatchTripCountsRequestV1.Size(m)
+}
+func (m *WatchTripCountsRequestV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchTripCountsRequestV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchTripCountsRequestV1 proto.InternalMessageInfo
+
+func (m *WatchTripCountsRequestV1) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *WatchTripCountsRequestV1) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *WatchTripCountsRequestV1) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *WatchTripCountsRequestV1) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+// WatchTripCountsResponseV1 holds the daily trip counts of the watched cabs that changed since the previous message
+type WatchTripCountsResponseV1 struct {
+	CabTripsPerDay       *objects.CabTripsPerDay `protobuf:"bytes,1,opt,name=cab_trips_per_day,json=cabTripsPerDay,proto3" json:"cab_trips_per_day,omitempty"`
+	Trigger              WatchTrigger            `protobuf:"varint,2,opt,name=trigger,proto3,enum=nycab.rpc.WatchTrigger" json:"trigger,omitempty"`
+	Error                string                  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *WatchTripCountsResponseV1) Reset()         { *m = WatchTripCountsResponseV1{} }
+func (m *WatchTripCountsResponseV1) String() string { return proto.CompactTextString(m) }
+func (*WatchTripCountsResponseV1) ProtoMessage()    {}
+func (*WatchTripCountsResponseV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0b84a42fa06f626, []int{62}
+}
+
+func (m *WatchTripCountsResponseV1) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchTripCountsResponseV1.Unmarshal(m, b)
+}
+func (m *WatchTripCountsResponseV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchTripCountsResponseV1.Marshal(b, m, deterministic)
+}
+func (m *WatchTripCountsResponseV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchTripCountsResponseV1.Merge(m, src)
+}
+func (m *WatchTripCountsResponseV1) XXX_Size() int {
+	return xxx_messageInfo_WatchTripCountsResponseV1.Size(m)
+}
+func (m *WatchTripCountsResponseV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchTripCountsResponseV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchTripCountsResponseV1 proto.InternalMessageInfo
+
+func (m *WatchTripCountsResponseV1) GetCabTripsPerDay() *objects.CabTripsPerDay {
+	if m != nil {
+		return m.CabTripsPerDay
+	}
+	return nil
+}
+
+func (m *WatchTripCountsResponseV1) GetTrigger() WatchTrigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return WatchTrigger_STARTED
+}
+
+func (m *WatchTripCountsResponseV1) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("nycab.rpc.QueryJobState", QueryJobState_name, QueryJobState_value)
+	proto.RegisterEnum("nycab.rpc.ExportFormat", ExportFormat_name, ExportFormat_value)
+	proto.RegisterEnum("nycab.rpc.WatchTrigger", WatchTrigger_name, WatchTrigger_value)
+	proto.RegisterType((*GetAllCabTripsRequestV1)(nil), "nycab.rpc.GetAllCabTripsRequestV1")
+	proto.RegisterType((*GetAllCabTripsResponseV1)(nil), "nycab.rpc.GetAllCabTripsResponseV1")
+	proto.RegisterType((*ClearCacheRequestV1)(nil), "nycab.rpc.ClearCacheRequestV1")
+	proto.RegisterType((*ClearCacheResponseV1)(nil), "nycab.rpc.ClearCacheResponseV1")
+	proto.RegisterType((*GetTripCountsForCabIDsRequestV1)(nil), "nycab.rpc.GetTripCountsForCabIDsRequestV1")
+	proto.RegisterType((*GetTripCountsForCabIDsResponseV1)(nil), "nycab.rpc.GetTripCountsForCabIDsResponseV1")
+	proto.RegisterType((*GetTripCountsForHackLicensesRequestV1)(nil), "nycab.rpc.GetTripCountsForHackLicensesRequestV1")
+	proto.RegisterType((*GetTripCountsForHackLicensesResponseV1)(nil), "nycab.rpc.GetTripCountsForHackLicensesResponseV1")
+	proto.RegisterType((*GetAllDriverTripsRequestV1)(nil), "nycab.rpc.GetAllDriverTripsRequestV1")
+	proto.RegisterType((*GetAllDriverTripsResponseV1)(nil), "nycab.rpc.GetAllDriverTripsResponseV1")
+	proto.RegisterType((*GetCabDriverMappingRequestV1)(nil), "nycab.rpc.GetCabDriverMappingRequestV1")
+	proto.RegisterType((*GetCabDriverMappingResponseV1)(nil), "nycab.rpc.GetCabDriverMappingResponseV1")
+	proto.RegisterType((*CountTripsInAreaRequestV1)(nil), "nycab.rpc.CountTripsInAreaRequestV1")
+	proto.RegisterType((*CountTripsInAreaResponseV1)(nil), "nycab.rpc.CountTripsInAreaResponseV1")
+	proto.RegisterMapType((map[string]uint32)(nil), "nycab.rpc.CountTripsInAreaResponseV1.TripsPerCabEntry")
+	proto.RegisterType((*GetPickupHeatmapRequestV1)(nil), "nycab.rpc.GetPickupHeatmapRequestV1")
+	proto.RegisterType((*GetPickupHeatmapResponseV1)(nil), "nycab.rpc.GetPickupHeatmapResponseV1")
+	proto.RegisterType((*GetOriginDestinationMatrixRequestV1)(nil), "nycab.rpc.GetOriginDestinationMatrixRequestV1")
+	proto.RegisterType((*GetOriginDestinationMatrixResponseV1)(nil), "nycab.rpc.GetOriginDestinationMatrixResponseV1")
+	proto.RegisterType((*GetCabShiftsRequestV1)(nil), "nycab.rpc.GetCabShiftsRequestV1")
+	proto.RegisterType((*GetCabShiftsResponseV1)(nil), "nycab.rpc.GetCabShiftsResponseV1")
+	proto.RegisterType((*FindTripAnomaliesRequestV1)(nil), "nycab.rpc.FindTripAnomaliesRequestV1")
+	proto.RegisterType((*FindTripAnomaliesResponseV1)(nil), "nycab.rpc.FindTripAnomaliesResponseV1")
+	proto.RegisterType((*ListTripsRequestV1)(nil), "nycab.rpc.ListTripsRequestV1")
+	proto.RegisterType((*ListTripsResponseV1)(nil), "nycab.rpc.ListTripsResponseV1")
+	proto.RegisterType((*GetCabUtilizationRequestV1)(nil), "nycab.rpc.GetCabUtilizationRequestV1")
+	proto.RegisterType((*GetCabUtilizationResponseV1)(nil), "nycab.rpc.GetCabUtilizationResponseV1")
+	proto.RegisterType((*GetTripPatternsRequestV1)(nil), "nycab.rpc.GetTripPatternsRequestV1")
+	proto.RegisterType((*GetTripPatternsResponseV1)(nil), "nycab.rpc.GetTripPatternsResponseV1")
+	proto.RegisterType((*DetectCountAnomaliesRequestV1)(nil), "nycab.rpc.DetectCountAnomaliesRequestV1")
+	proto.RegisterType((*DetectCountAnomaliesResponseV1)(nil), "nycab.rpc.DetectCountAnomaliesResponseV1")
+	proto.RegisterType((*ForecastTripsRequestV1)(nil), "nycab.rpc.ForecastTripsRequestV1")
+	proto.RegisterType((*ForecastTripsResponseV1)(nil), "nycab.rpc.ForecastTripsResponseV1")
+	proto.RegisterType((*GetPassengerCountsRequestV1)(nil), "nycab.rpc.GetPassengerCountsRequestV1")
+	proto.RegisterType((*GetPassengerCountsResponseV1)(nil), "nycab.rpc.GetPassengerCountsResponseV1")
+	proto.RegisterType((*GetVendorStatsRequestV1)(nil), "nycab.rpc.GetVendorStatsRequestV1")
+	proto.RegisterType((*GetVendorStatsResponseV1)(nil), "nycab.rpc.GetVendorStatsResponseV1")
+	proto.RegisterType((*CountZoneTripsRequestV1)(nil), "nycab.rpc.CountZoneTripsRequestV1")
+	proto.RegisterType((*CountZoneTripsResponseV1)(nil), "nycab.rpc.CountZoneTripsResponseV1")
+	proto.RegisterType((*GetTaxiZoneTripCountsRequestV1)(nil), "nycab.rpc.GetTaxiZoneTripCountsRequestV1")
+	proto.RegisterType((*GetTaxiZoneTripCountsResponseV1)(nil), "nycab.rpc.GetTaxiZoneTripCountsResponseV1")
+	proto.RegisterType((*GetCabZoneCoverageRequestV1)(nil), "nycab.rpc.GetCabZoneCoverageRequestV1")
+	proto.RegisterType((*GetCabZoneCoverageResponseV1)(nil), "nycab.rpc.GetCabZoneCoverageResponseV1")
+	proto.RegisterType((*GetCabRevenueRequestV1)(nil), "nycab.rpc.GetCabRevenueRequestV1")
+	proto.RegisterType((*GetCabRevenueResponseV1)(nil), "nycab.rpc.GetCabRevenueResponseV1")
+	proto.RegisterType((*GetTipRatesRequestV1)(nil), "nycab.rpc.GetTipRatesRequestV1")
+	proto.RegisterType((*GetTipRatesResponseV1)(nil), "nycab.rpc.GetTipRatesResponseV1")
+	proto.RegisterType((*GetPaymentTypeMixRequestV1)(nil), "nycab.rpc.GetPaymentTypeMixRequestV1")
+	proto.RegisterType((*GetPaymentTypeMixResponseV1)(nil), "nycab.rpc.GetPaymentTypeMixResponseV1")
+	proto.RegisterType((*BatchGetTripCountsRequestV1)(nil), "nycab.rpc.BatchGetTripCountsRequestV1")
+	proto.RegisterType((*BatchGetTripCountsResponseV1)(nil), "nycab.rpc.BatchGetTripCountsResponseV1")
+	proto.RegisterType((*QueryJob)(nil), "nycab.rpc.QueryJob")
+	proto.RegisterType((*SubmitQueryJobRequestV1)(nil), "nycab.rpc.SubmitQueryJobRequestV1")
+	proto.RegisterType((*SubmitQueryJobResponseV1)(nil), "nycab.rpc.SubmitQueryJobResponseV1")
+	proto.RegisterType((*GetQueryJobRequestV1)(nil), "nycab.rpc.GetQueryJobRequestV1")
+	proto.RegisterType((*GetQueryJobResponseV1)(nil), "nycab.rpc.GetQueryJobResponseV1")
+	proto.RegisterType((*CancelQueryJobRequestV1)(nil), "nycab.rpc.CancelQueryJobRequestV1")
+	proto.RegisterType((*CancelQueryJobResponseV1)(nil), "nycab.rpc.CancelQueryJobResponseV1")
+	proto.RegisterType((*ExportColumn)(nil), "nycab.rpc.ExportColumn")
+	proto.RegisterType((*ExportSchema)(nil), "nycab.rpc.ExportSchema")
+	proto.RegisterType((*ExportQueryRequestV1)(nil), "nycab.rpc.ExportQueryRequestV1")
+	proto.RegisterType((*ExportQueryResponseV1)(nil), "nycab.rpc.ExportQueryResponseV1")
+	proto.RegisterType((*WatchTripCountsRequestV1)(nil), "nycab.rpc.WatchTripCountsRequestV1")
+	proto.RegisterType((*WatchTripCountsResponseV1)(nil), "nycab.rpc.WatchTripCountsResponseV1")
+}
+
+func init() { proto.RegisterFile("service.proto", fileDescriptor_a0b84a42fa06f626) }
+
+var fileDescriptor_a0b84a42fa06f626 = []byte{
+	// 4278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4d, 0x6f, 0x1c, 0xc9,
+	0x75, 0xec, 0xe1, 0xd7, 0xcc, 0x1b, 0x0e, 0x39, 0x2c, 0x52, 0xe2, 0xb0, 0x49, 0x89, 0xa3, 0x96,
+	0x56, 0x96, 0x65, 0x2f, 0x47, 0xa3, 0xac, 0x17, 0x8e, 0x9c, 0xac, 0x4d, 0x0d, 0x29, 0x91, 0x1b,
+	0x91, 0x4b, 0x35, 0x29, 0x2e, 0xbc, 0x07, 0x0f, 0x6a, 0x7a, 0x4a, 0x33, 0x2d, 0xf5, 0x74, 0xb7,
+	0xbb, 0x7b, 0x28, 0x8e, 0x1c, 0xc7, 0x8e, 0x8d, 0xd8, 0x88, 0x1d, 0x20, 0x71, 0x8c, 0x04, 0x48,
+	0x2e, 0x41, 0x80, 0x04, 0xb9, 0x39, 0x01, 0x9c, 0x4b, 0x0e, 0x09, 0x02, 0xe4, 0x94, 0x00, 0x39,
+	0xe5, 0x90, 0x43, 0xae, 0x39, 0x05, 0x30, 0x90, 0x7f, 0x90, 0xa0, 0xaa, 0xba, 0x7b, 0xfa, 0xa3,
+	0x7a, 0x38, 0xe4, 0x7a, 0xe3, 0xac, 0x77, 0x4f, 0x9c, 0xae, 0x7a, 0xaf, 0xea, 0xd5, 0x7b, 0xaf,
+	0xde, 0x57, 0x55, 0x11, 0x4a, 0x2e, 0x71, 0x4e, 0x75, 0x8d, 0x6c, 0xda, 0x8e, 0xe5, 0x59, 0xa8,
+	0x60, 0x0e, 0x34, 0xdc, 0xda, 0x74, 0x6c, 0x4d, 0x5e, 0xef, 0x58, 0x56, 0xc7, 0x20, 0x35, 0x6c,
+	0xeb, 0x35, 0x6c, 0x9a, 0x96, 0x87, 0x3d, 0xdd, 0x32, 0x5d, 0x0e, 0x28, 0x7f, 0x9e, 0xfd, 0xd1,
+	0xde, 0xec, 0x10, 0xf3, 0x4d, 0xf7, 0x15, 0xee, 0x74, 0x88, 0x53, 0xb3, 0x6c, 0x06, 0x21, 0x80,
+	0xbe, 0xc5, 0x86, 0xad, 0x71, 0x1c, 0xcb, 0xa8, 0x59, 0xad, 0x17, 0x44, 0xf3, 0xdc, 0xe0, 0x2f,
+	0x87, 0x52, 0xfe, 0x5e, 0x82, 0x95, 0xc7, 0xc4, 0xdb, 0x32, 0x8c, 0x06, 0x6e, 0x1d, 0x3b, 0xba,
+	0xed, 0xaa, 0xe4, 0xeb, 0x7d, 0xe2, 0x7a, 0x27, 0x75, 0x74, 0x03, 0xe6, 0xf4, 0x8e, 0x69, 0x39,
+	0xa4, 0xa9, 0x61, 0xad, 0x4b, 0x2a, 0x52, 0x55, 0xba, 0x93, 0x57, 0x8b, 0xbc, 0xad, 0x41, 0x9b,
+	0xd0, 0x2e, 0xcc, 0x77, 0x2d, 0x43, 0x6f, 0xe3, 0x41, 0xf3, 0xb9, 0x6e, 0x78, 0xc4, 0xa9, 0xe4,
+	0xaa, 0xd2, 0x9d, 0xf9, 0xfb, 0x37, 0x36, 0xf9, 0xa2, 0xda, 0xd8, 0xc3, 0x9b, 0xc1, 0x8c, 0xbb,
+	0x1c, 0xf2, 0x11, 0x03, 0x54, 0x4b, 0xdd, 0xe8, 0x27, 0xfa, 0x02, 0xcc, 0x52, 0x60, 0x97, 0x78,
+	0x95, 0x49, 0x36, 0xc4, 0x9a, 0x68, 0x88, 0x6d, 0x0e, 0xa2, 0x06, 0xb0, 0xca, 0xb7, 0xa0, 0x92,
+	0x24, 0xdf, 0xb5, 0x2d, 0xd3, 0x25, 0x27, 0x75, 0xb4, 0x0f, 0x8b, 0x1a, 0x6e, 0x35, 0x3d, 0xda,
+	0xdc, 0xb4, 0x89, 0xd3, 0x6c, 0xe3, 0x01, 0x5b, 0x44, 0xf1, 0xbe, 0x22, 0x1a, 0x3c, 0x18, 0xe2,
+	0x90, 0x38, 0xdb, 0x78, 0xa0, 0xce, 0x6b, 0xb1, 0x6f, 0xb4, 0x0c, 0xd3, 0xc4, 0x71, 0x2c, 0xbe,
+	0xc4, 0x82, 0xca, 0x3f, 0x94, 0x1e, 0x2c, 0x35, 0x0c, 0x82, 0x1d, 0xc6, 0x8f, 0x21, 0xef, 0x36,
+	0xa0, 0xa8, 0xd1, 0xe6, 0x18, 0xeb, 0x40, 0x0b, 0x21, 0xa3, 0xeb, 0xcd, 0x5d, 0x60, 0xbd, 0x4f,
+	0x61, 0x39, 0x3a, 0x5d, 0xb8, 0xd6, 0x9b, 0x50, 0x62, 0x33, 0x35, 0xd9, 0x14, 0xa4, 0xed, 0xcf,
+	0x38, 0xc7, 0x1a, 0x1b, 0xbc, 0x2d, 0x63, 0x05, 0xff, 0x23, 0xc1, 0xc6, 0x63, 0xe2, 0xd1, 0xa5,
+	0x36, 0xac, 0xbe, 0xe9, 0xb9, 0x8f, 0x2c, 0xa7, 0x81, 0x5b, 0x7b, 0xdb, 0x11, 0x55, 0x58, 0x81,
+	0x59, 0xca, 0x4a, 0xbd, 0xed, 0x56, 0xa4, 0xea, 0xe4, 0x9d, 0x82, 0x3a, 0xa3, 0xe1, 0xd6, 0x5e,
+	0xdb, 0x4d, 0xe9, 0x48, 0x2e, 0xad, 0x23, 0x1b, 0x50, 0xb4, 0x75, 0xed, 0x65, 0xdf, 0x6e, 0xb6,
+	0xb1, 0x47, 0x98, 0x74, 0x0b, 0x2a, 0xf0, 0xa6, 0x6d, 0xec, 0x89, 0x94, 0x68, 0xea, 0xc3, 0x2b,
+	0xd1, 0xf4, 0x05, 0x98, 0xfa, 0x7d, 0x09, 0xaa, 0x59, 0x1c, 0xf8, 0xbf, 0xd5, 0xa6, 0x1f, 0xe5,
+	0xe0, 0x8d, 0x24, 0x25, 0xbb, 0x58, 0x7b, 0xf9, 0x44, 0xd7, 0x88, 0xe9, 0x92, 0x88, 0x44, 0x6e,
+	0x42, 0xa9, 0x8b, 0xb5, 0x97, 0x4d, 0xc3, 0xef, 0xf1, 0xe5, 0x32, 0xd7, 0x8d, 0x40, 0xff, 0x72,
+	0x48, 0xe7, 0x8f, 0x25, 0xb8, 0x3d, 0x9a, 0x27, 0xa1, 0x8c, 0x4e, 0x60, 0xb9, 0xed, 0xe8, 0xa7,
+	0xc4, 0x11, 0x8a, 0xe9, 0x0d, 0xe1, 0x74, 0x0c, 0x3e, 0x2a, 0xa9, 0xc5, 0x76, 0xb2, 0x29, 0x43,
+	0x58, 0xff, 0x28, 0x81, 0xcc, 0x8d, 0x4f, 0x64, 0x90, 0x8f, 0x97, 0xf9, 0xfc, 0xa1, 0x04, 0x6b,
+	0x82, 0x25, 0xfc, 0x82, 0x18, 0xfa, 0xef, 0x12, 0xac, 0x3f, 0x26, 0x5e, 0x03, 0xb7, 0xf8, 0x20,
+	0xfb, 0xd8, 0xb6, 0x75, 0xb3, 0x33, 0x86, 0x19, 0x4a, 0xed, 0x86, 0xdc, 0x18, 0xbb, 0x61, 0xf2,
+	0xdc, 0xdd, 0x30, 0x95, 0xda, 0x0d, 0x97, 0xd4, 0xe1, 0xdf, 0x95, 0xe0, 0x9a, 0x70, 0x65, 0x21,
+	0xa7, 0x55, 0x40, 0x74, 0x69, 0x3e, 0xb7, 0x7b, 0xbc, 0xdf, 0xe7, 0xf3, 0xad, 0x0c, 0xfb, 0x12,
+	0x1f, 0xab, 0xac, 0x25, 0x5a, 0x32, 0xb8, 0xfc, 0x37, 0x39, 0x58, 0x65, 0x1b, 0x89, 0x09, 0x64,
+	0xcf, 0xdc, 0x72, 0x08, 0x1e, 0xb2, 0xf8, 0x21, 0xcc, 0xb5, 0xac, 0xbe, 0xd9, 0xd6, 0xcd, 0x4e,
+	0xb3, 0x65, 0x9d, 0xf9, 0x14, 0x6c, 0x88, 0x28, 0x78, 0xe8, 0xc3, 0x3d, 0xb4, 0xce, 0xd4, 0x62,
+	0x6b, 0xf8, 0x81, 0xde, 0x86, 0x59, 0xdb, 0x32, 0x06, 0x1d, 0xcb, 0x64, 0x72, 0x28, 0xde, 0x5f,
+	0x17, 0xa1, 0x3f, 0x26, 0xd6, 0xa1, 0xa5, 0x9b, 0x9e, 0x1a, 0x00, 0xa3, 0x6b, 0x00, 0xae, 0x87,
+	0x1d, 0xaf, 0xe9, 0xe9, 0xbd, 0xc0, 0x14, 0x15, 0x58, 0xcb, 0xb1, 0xde, 0x23, 0x68, 0x15, 0xf2,
+	0xc4, 0x6c, 0xf3, 0x4e, 0x2e, 0x99, 0x59, 0x62, 0xb6, 0x59, 0xd7, 0x6d, 0x58, 0xd0, 0x4d, 0xcd,
+	0xe8, 0xb7, 0x49, 0x33, 0x50, 0x90, 0x69, 0x26, 0xdd, 0x92, 0xdf, 0xdc, 0xe0, 0x7a, 0x12, 0x11,
+	0xdf, 0xcc, 0x05, 0xc4, 0xf7, 0x33, 0x09, 0xe4, 0x34, 0xcb, 0x42, 0xd9, 0x5d, 0x03, 0xa0, 0xdb,
+	0xa3, 0xa9, 0x51, 0x10, 0xc6, 0xb1, 0x92, 0x5a, 0xf0, 0x02, 0x7b, 0x85, 0x3e, 0x80, 0xd2, 0x70,
+	0xf7, 0x68, 0xb8, 0xe5, 0x33, 0xe5, 0xed, 0xcd, 0x30, 0xf0, 0xdb, 0xcc, 0x1e, 0x7c, 0x33, 0xd8,
+	0x36, 0x0d, 0xdc, 0xda, 0x31, 0x3d, 0x67, 0xa0, 0x16, 0xbd, 0x61, 0xcb, 0x50, 0xc4, 0x93, 0x11,
+	0x11, 0xcb, 0xef, 0x40, 0x39, 0x89, 0x86, 0xca, 0x30, 0xf9, 0x92, 0xf0, 0x9d, 0x5b, 0x50, 0xe9,
+	0x4f, 0x8a, 0x7b, 0x8a, 0x8d, 0x3e, 0x77, 0x0b, 0x25, 0x95, 0x7f, 0x3c, 0xc8, 0x7d, 0x51, 0x52,
+	0xfe, 0x55, 0x82, 0xd5, 0xc7, 0xc4, 0x3b, 0x64, 0x7a, 0xbf, 0x4b, 0xb0, 0xd7, 0xc3, 0xf6, 0x50,
+	0x45, 0xd6, 0xa1, 0x60, 0x3b, 0x44, 0xd3, 0x5d, 0xdd, 0x32, 0x83, 0xd5, 0x86, 0x0d, 0x09, 0x21,
+	0xe6, 0x46, 0x09, 0x71, 0x32, 0x2e, 0xc4, 0xe4, 0xfe, 0x9c, 0x4a, 0xef, 0xcf, 0x4b, 0x6e, 0x3f,
+	0x1d, 0xe4, 0xf4, 0x72, 0x42, 0xf1, 0x7d, 0x01, 0xa6, 0x35, 0x62, 0x18, 0xdc, 0xa6, 0x64, 0xe8,
+	0xba, 0x8f, 0xd5, 0x20, 0x86, 0xa1, 0x72, 0xe8, 0x8c, 0xdd, 0xf5, 0xdd, 0x1c, 0xdc, 0x7c, 0x4c,
+	0xbc, 0xf7, 0x1c, 0xbd, 0xa3, 0x9b, 0xdb, 0xc4, 0xf5, 0x74, 0x93, 0x85, 0xe5, 0xfb, 0xd8, 0x73,
+	0xf4, 0xb3, 0x21, 0x13, 0xe3, 0x6c, 0x92, 0x46, 0xb1, 0x29, 0x17, 0x67, 0xd3, 0xe7, 0x60, 0xb1,
+	0x43, 0xac, 0x2e, 0x76, 0xbb, 0xcd, 0xa1, 0x18, 0x26, 0x99, 0x18, 0xca, 0x7e, 0xc7, 0x61, 0x28,
+	0x8d, 0x35, 0x28, 0x74, 0x1c, 0xbd, 0xdd, 0x74, 0xf5, 0xd7, 0x9c, 0xa1, 0x92, 0x9a, 0xa7, 0x0d,
+	0x47, 0xfa, 0xeb, 0x34, 0xc3, 0xa7, 0x47, 0x32, 0xfc, 0x22, 0x1b, 0x66, 0x00, 0xb7, 0x46, 0x31,
+	0x21, 0x64, 0xfd, 0x97, 0x60, 0x96, 0x98, 0x9e, 0xa3, 0x93, 0x80, 0xf9, 0x42, 0xcf, 0xf7, 0xde,
+	0x36, 0x47, 0xe4, 0xfa, 0x1f, 0x60, 0x64, 0x99, 0x37, 0x09, 0xae, 0x70, 0x53, 0x7b, 0xd4, 0xd5,
+	0x9f, 0x7b, 0x11, 0x87, 0x7c, 0x05, 0x66, 0xb8, 0x71, 0xf0, 0xd9, 0x3d, 0xcd, 0x9c, 0x47, 0xd2,
+	0xe6, 0xe7, 0x52, 0x36, 0xff, 0x0e, 0x94, 0x7b, 0xf8, 0xac, 0xa9, 0xb7, 0x0d, 0xd2, 0xec, 0xe9,
+	0x66, 0xdf, 0x23, 0xae, 0xcf, 0xef, 0xf9, 0x1e, 0x3e, 0xdb, 0x6b, 0x1b, 0x64, 0x9f, 0xb7, 0x46,
+	0xb9, 0x35, 0x75, 0x01, 0x6e, 0x61, 0xb8, 0x1a, 0xa7, 0x38, 0xe4, 0x4f, 0x1d, 0x66, 0x5c, 0xd6,
+	0xe6, 0xb3, 0x67, 0x55, 0x34, 0x1e, 0xc3, 0x52, 0x7d, 0xc0, 0x0c, 0xae, 0xfc, 0x8b, 0x04, 0xf2,
+	0x23, 0xdd, 0x6c, 0x53, 0xb3, 0xb0, 0x65, 0x5a, 0x3d, 0x6c, 0xe8, 0x64, 0x9c, 0xf8, 0xfe, 0xf2,
+	0xbb, 0x59, 0x81, 0x12, 0xe5, 0x9a, 0x6b, 0x13, 0xd2, 0x6e, 0xf6, 0xec, 0xae, 0xaf, 0x7d, 0xc5,
+	0x1e, 0x3e, 0x3b, 0xa2, 0x6d, 0xfb, 0x76, 0xf7, 0xb2, 0xdb, 0xd9, 0x81, 0x35, 0xc1, 0x5a, 0x42,
+	0xa6, 0xfd, 0x3a, 0x14, 0x70, 0xd0, 0x3c, 0x6a, 0x4f, 0x0f, 0xf1, 0x07, 0xea, 0x10, 0x23, 0x83,
+	0x81, 0xff, 0x2d, 0x01, 0x7a, 0xa2, 0xbb, 0x5e, 0x22, 0xc8, 0xcb, 0xd0, 0xa9, 0xcb, 0xb3, 0x6d,
+	0x0d, 0x0a, 0x36, 0xee, 0x90, 0xe1, 0x86, 0x2d, 0xa9, 0x79, 0xda, 0xc0, 0x36, 0xec, 0x35, 0x00,
+	0xd6, 0xe9, 0x59, 0x2f, 0x89, 0xc9, 0x58, 0x56, 0x50, 0x19, 0xf8, 0x31, 0x6d, 0x40, 0x57, 0x61,
+	0xe6, 0xb9, 0x4e, 0x8c, 0xb6, 0x5b, 0x99, 0xe1, 0x42, 0xe4, 0x5f, 0x51, 0x36, 0xcf, 0x5e, 0x2c,
+	0x68, 0x59, 0x8a, 0x2c, 0x39, 0xe4, 0xef, 0x5b, 0x30, 0xcd, 0x5c, 0x90, 0xcf, 0xdb, 0xeb, 0x59,
+	0xbc, 0x55, 0x89, 0x66, 0x39, 0x6d, 0x95, 0x03, 0x53, 0x17, 0x6d, 0x92, 0x33, 0xaf, 0x19, 0x59,
+	0x00, 0xe7, 0x4b, 0x89, 0x36, 0x1f, 0x86, 0x8b, 0x10, 0x7a, 0x34, 0xe5, 0xc7, 0x39, 0x66, 0xc2,
+	0x1b, 0xb8, 0xf5, 0xcc, 0xd3, 0x0d, 0xfd, 0x35, 0x33, 0x27, 0x17, 0xd1, 0xdf, 0xc8, 0xde, 0xe6,
+	0x82, 0x60, 0x5b, 0xdb, 0x17, 0x44, 0x24, 0xf5, 0xa1, 0x82, 0x60, 0x5d, 0x63, 0x78, 0xa3, 0x74,
+	0xf8, 0x3e, 0xfd, 0xe1, 0xc3, 0xf7, 0x8b, 0x99, 0xd9, 0x35, 0x01, 0x53, 0x42, 0x41, 0x6d, 0x43,
+	0xb1, 0x3f, 0xec, 0xf0, 0xc5, 0x95, 0x95, 0xac, 0x46, 0x87, 0x88, 0xa2, 0x65, 0x67, 0xaa, 0x15,
+	0x3f, 0x2b, 0x3b, 0xc4, 0x9e, 0x47, 0x1c, 0xd3, 0xfd, 0xc4, 0x8b, 0xc3, 0x82, 0xd5, 0x14, 0x4b,
+	0x42, 0x61, 0xfc, 0x1a, 0xe4, 0x6d, 0xbf, 0xd5, 0x97, 0x44, 0x35, 0x6b, 0xe3, 0x84, 0xd8, 0x21,
+	0x46, 0x96, 0x10, 0x26, 0xe1, 0xda, 0x36, 0xf1, 0x88, 0xe6, 0xb1, 0x00, 0xf2, 0x52, 0x86, 0xfd,
+	0xc2, 0x92, 0xd8, 0x80, 0xe2, 0x2b, 0xdd, 0x6c, 0x5b, 0xaf, 0x68, 0x26, 0xe8, 0xfa, 0x36, 0x0a,
+	0x78, 0xd3, 0x36, 0x1e, 0xb8, 0xe8, 0x01, 0xcc, 0xf4, 0x88, 0xd7, 0xb5, 0xda, 0x3e, 0xff, 0x85,
+	0x1a, 0xf7, 0x10, 0xbb, 0xc4, 0xd0, 0x4d, 0xb2, 0xcf, 0x20, 0x55, 0x1f, 0x83, 0xc6, 0x96, 0x5e,
+	0xd7, 0x21, 0x6e, 0xd7, 0x32, 0xda, 0x8c, 0xf7, 0x92, 0x3a, 0x6c, 0x48, 0x29, 0xc1, 0xec, 0x38,
+	0x4a, 0x90, 0xff, 0xf0, 0x4a, 0x50, 0xb8, 0x80, 0x12, 0x9c, 0xc2, 0x75, 0xb1, 0x48, 0x42, 0x4d,
+	0x78, 0x27, 0xed, 0x9f, 0x84, 0xaa, 0x10, 0x19, 0x60, 0x0c, 0x07, 0xf5, 0xb3, 0x1c, 0x5c, 0x7d,
+	0x64, 0x39, 0x44, 0xc3, 0x29, 0x27, 0x95, 0xa9, 0x04, 0x9f, 0x07, 0xd4, 0xd5, 0x5d, 0xcf, 0x72,
+	0x06, 0xcd, 0x94, 0x32, 0x94, 0xfd, 0x9e, 0xa3, 0x50, 0x27, 0xee, 0x40, 0xd0, 0xd6, 0x4c, 0xe8,
+	0xc6, 0xbc, 0xdf, 0xbe, 0x33, 0xdc, 0xac, 0x5d, 0xcb, 0xd1, 0x5f, 0x5b, 0x66, 0x54, 0x47, 0x8a,
+	0x7e, 0xdb, 0xf8, 0x4a, 0x12, 0xac, 0x27, 0xa1, 0x24, 0x9f, 0x85, 0xb2, 0xed, 0x90, 0xb6, 0xae,
+	0x51, 0xfb, 0xd4, 0x34, 0xc8, 0x29, 0x31, 0x7c, 0x5d, 0x59, 0x18, 0xb6, 0x3f, 0xa1, 0xcd, 0xe3,
+	0x68, 0x4c, 0x44, 0xce, 0xf9, 0x0b, 0x05, 0x21, 0x2b, 0x09, 0x76, 0x87, 0x02, 0xde, 0x82, 0xc2,
+	0x73, 0xbf, 0x2b, 0x10, 0xf0, 0xcd, 0x11, 0x25, 0xc2, 0x60, 0x18, 0x75, 0x88, 0x95, 0x1d, 0xc5,
+	0x51, 0x83, 0x7f, 0x88, 0x5d, 0x97, 0x98, 0x1d, 0xe2, 0xf0, 0x7a, 0xd8, 0x2f, 0xda, 0xee, 0x5e,
+	0x32, 0x8a, 0xfb, 0x07, 0x5e, 0xed, 0x49, 0x2d, 0x26, 0xe2, 0xbe, 0xa6, 0x9f, 0x1b, 0x84, 0x78,
+	0x7e, 0x0d, 0x62, 0x53, 0x34, 0x6a, 0x1c, 0x7b, 0x5b, 0x77, 0x3d, 0x47, 0x6f, 0xf5, 0x99, 0x13,
+	0xe3, 0xc8, 0xe8, 0x21, 0x4c, 0x69, 0xb8, 0xe5, 0xfa, 0x49, 0xf7, 0x45, 0x07, 0x61, 0xb8, 0x19,
+	0x31, 0xc9, 0x7f, 0xf1, 0xb3, 0x93, 0x13, 0x62, 0xb6, 0x2d, 0xe7, 0xc8, 0xc3, 0x1f, 0xb1, 0x24,
+	0xc6, 0x09, 0xa8, 0x3f, 0xba, 0x8c, 0xee, 0x25, 0x54, 0x92, 0x6b, 0x0d, 0x05, 0xf5, 0xab, 0x30,
+	0x7b, 0xca, 0x3a, 0x46, 0x86, 0xdb, 0x51, 0xdc, 0x00, 0x3e, 0x43, 0xcf, 0xff, 0x4e, 0x82, 0x15,
+	0x26, 0x8b, 0x0f, 0x2c, 0x93, 0x24, 0x8c, 0xd9, 0x32, 0x4c, 0xbf, 0xb6, 0xcc, 0xb0, 0xe0, 0xcd,
+	0x3f, 0xa2, 0xfc, 0xce, 0x8d, 0xe0, 0xf7, 0xe4, 0x28, 0x7e, 0x4f, 0xc5, 0xf9, 0x7d, 0x49, 0xb5,
+	0x7e, 0x09, 0x95, 0x24, 0xe9, 0x11, 0x46, 0xcd, 0xb0, 0x1a, 0xd1, 0xc8, 0x6c, 0x37, 0x40, 0x64,
+	0xa3, 0xa8, 0x3e, 0x42, 0x06, 0xa3, 0xfe, 0x56, 0x82, 0xeb, 0x34, 0xe4, 0xc0, 0x67, 0x7a, 0x0c,
+	0x2d, 0x5e, 0x86, 0x36, 0x2c, 0x8d, 0x85, 0x72, 0x11, 0x75, 0x2c, 0x06, 0x6d, 0x1f, 0x4e, 0x27,
+	0x2f, 0x99, 0xf0, 0x9e, 0xc2, 0x46, 0x06, 0xd5, 0x91, 0x24, 0x2e, 0xce, 0x2a, 0x61, 0xad, 0x39,
+	0x35, 0xc2, 0x39, 0xec, 0xfa, 0x2b, 0x29, 0x08, 0x98, 0x29, 0x56, 0xc3, 0x3a, 0x25, 0x0e, 0xee,
+	0x90, 0x8f, 0x74, 0xd7, 0x5e, 0x92, 0x43, 0x7d, 0x58, 0x17, 0x11, 0x1a, 0xb2, 0xe7, 0xcb, 0x90,
+	0xd7, 0xfc, 0xd6, 0x73, 0x3c, 0x4c, 0x6c, 0x80, 0x10, 0x29, 0x83, 0x41, 0xbf, 0x9f, 0x0b, 0x4a,
+	0x11, 0x2a, 0x39, 0x25, 0x66, 0x9f, 0x7c, 0xe2, 0x63, 0x7a, 0x1d, 0x56, 0x12, 0x0c, 0x09, 0x65,
+	0xf0, 0x45, 0x98, 0x75, 0x78, 0xe3, 0xa8, 0x4c, 0x38, 0x82, 0x1a, 0x80, 0x67, 0x30, 0xff, 0x9f,
+	0x24, 0x58, 0xa6, 0xdb, 0x42, 0xb7, 0x55, 0xec, 0x91, 0x8f, 0xab, 0x5b, 0xff, 0x11, 0xaf, 0xbf,
+	0x0d, 0x57, 0x11, 0x29, 0x66, 0xc5, 0xfc, 0xb9, 0x70, 0x38, 0x1f, 0x2d, 0x70, 0xde, 0xb5, 0x98,
+	0xf3, 0x1e, 0x89, 0x31, 0xca, 0x53, 0xff, 0x33, 0x3f, 0xa9, 0x3b, 0xc4, 0x83, 0x1e, 0x31, 0xbd,
+	0xe3, 0x81, 0x4d, 0xf6, 0xf5, 0xb3, 0x8f, 0x29, 0x7f, 0xff, 0x32, 0x88, 0x01, 0xe3, 0x6b, 0x89,
+	0x68, 0x65, 0x8c, 0xcb, 0x8a, 0x38, 0xe0, 0x89, 0x21, 0xfb, 0xcc, 0x7e, 0x3b, 0xc6, 0xec, 0x71,
+	0x10, 0x47, 0xf1, 0x9c, 0xc0, 0xda, 0x43, 0xec, 0x69, 0xdd, 0xd8, 0xd1, 0xed, 0x90, 0xe7, 0x8f,
+	0x20, 0xef, 0xf0, 0x8f, 0xc0, 0xc2, 0xdf, 0x8d, 0x9c, 0x87, 0x9c, 0x73, 0x1f, 0x41, 0x0d, 0x71,
+	0x95, 0x6f, 0xc1, 0xba, 0x68, 0x9a, 0x90, 0x1d, 0x7b, 0x50, 0x70, 0xfc, 0xaf, 0x60, 0xa2, 0xcf,
+	0x8d, 0x31, 0x51, 0x80, 0xaf, 0x0e, 0xb1, 0x33, 0x76, 0xed, 0x9f, 0xcd, 0x41, 0xfe, 0x69, 0x9f,
+	0x38, 0x83, 0x77, 0xad, 0x16, 0x2d, 0x07, 0xbe, 0xb0, 0xa2, 0xe5, 0xc0, 0x17, 0x16, 0x2d, 0x07,
+	0x2e, 0xc3, 0xf4, 0xd7, 0x29, 0x48, 0x80, 0xc9, 0x3e, 0xd0, 0x26, 0x4c, 0xbb, 0x5e, 0xa0, 0x3b,
+	0xf3, 0xf7, 0x2b, 0x11, 0xb2, 0x82, 0x01, 0x69, 0xb4, 0x44, 0x54, 0x0e, 0x86, 0x64, 0xc8, 0xdb,
+	0x8e, 0xd5, 0x71, 0x88, 0xeb, 0x06, 0xa5, 0xfc, 0xe0, 0x9b, 0x26, 0xe5, 0x6e, 0xbf, 0xd5, 0xd3,
+	0xfd, 0x8a, 0x23, 0x2f, 0x0d, 0x02, 0x6f, 0x62, 0x75, 0xc5, 0x78, 0x45, 0x72, 0x66, 0x54, 0x45,
+	0x72, 0x36, 0x5e, 0x91, 0x0c, 0x97, 0x9d, 0x8f, 0x2c, 0x1b, 0xed, 0x41, 0x09, 0x1b, 0x46, 0x33,
+	0xbc, 0x12, 0x51, 0x59, 0xac, 0x4a, 0x11, 0x2f, 0xe4, 0xf3, 0x56, 0x78, 0x31, 0x67, 0x77, 0x42,
+	0x2d, 0xe2, 0x61, 0x07, 0x52, 0xa1, 0x4c, 0x87, 0x8a, 0x1e, 0x34, 0x57, 0x10, 0x1b, 0xed, 0x76,
+	0x6a, 0x34, 0xe1, 0x31, 0xf5, 0xee, 0x84, 0x3a, 0x8f, 0x63, 0x7d, 0xe8, 0x19, 0x2c, 0xb6, 0xa8,
+	0x5a, 0x34, 0x87, 0x07, 0x73, 0x6e, 0x65, 0x89, 0x0d, 0xfa, 0x99, 0xc8, 0xa0, 0xa3, 0x54, 0x67,
+	0x77, 0x42, 0x5d, 0x60, 0x63, 0x0c, 0x3b, 0xd1, 0x01, 0xcc, 0xfb, 0x67, 0x05, 0x5d, 0x7e, 0x20,
+	0x54, 0x59, 0x8e, 0x9d, 0x84, 0xfb, 0x84, 0x66, 0x9c, 0x34, 0xed, 0x4e, 0xa8, 0x25, 0x3b, 0xda,
+	0x85, 0x7a, 0xb0, 0x6a, 0xb1, 0x43, 0x92, 0x66, 0x7b, 0x78, 0x4a, 0xd2, 0xec, 0xb1, 0xd3, 0x8e,
+	0xca, 0x15, 0x36, 0x74, 0x2d, 0x3e, 0xf4, 0xb9, 0x67, 0x2a, 0xbb, 0x13, 0xea, 0x8a, 0x25, 0x06,
+	0x42, 0x4f, 0x61, 0x81, 0x0a, 0x2c, 0x5a, 0x14, 0xbc, 0x2a, 0x62, 0x74, 0x56, 0x45, 0x91, 0x32,
+	0x5a, 0x8b, 0xf5, 0xa1, 0xdf, 0xe0, 0x87, 0x9b, 0xcd, 0xb0, 0xb6, 0xb5, 0x12, 0x3b, 0xb2, 0x8e,
+	0xec, 0xb1, 0x74, 0x4d, 0x6c, 0x77, 0x42, 0x9d, 0xf3, 0x22, 0x3d, 0xe8, 0x18, 0x16, 0x98, 0xa8,
+	0x9a, 0xc3, 0xfa, 0x48, 0x85, 0x0d, 0xf7, 0xd9, 0xc8, 0x70, 0xa3, 0xab, 0x2b, 0x8c, 0xc4, 0x58,
+	0x1f, 0xfa, 0x0a, 0xe4, 0x83, 0xc4, 0xba, 0xb2, 0x1a, 0x33, 0x8a, 0x74, 0xb8, 0x8c, 0x24, 0x7e,
+	0x77, 0x42, 0x0d, 0xb1, 0xd0, 0x31, 0x94, 0xed, 0x20, 0x47, 0x0c, 0x94, 0x49, 0x4e, 0x29, 0xd3,
+	0xa8, 0x64, 0x96, 0x2a, 0x93, 0x1d, 0xef, 0x44, 0xbb, 0x30, 0xc7, 0xd3, 0xa0, 0x26, 0xdd, 0xdf,
+	0x6e, 0x65, 0x4d, 0xb4, 0x83, 0x84, 0x19, 0x17, 0xdd, 0x41, 0xa7, 0xc3, 0x0e, 0xd4, 0x84, 0x2b,
+	0x1e, 0x3e, 0xd3, 0x9b, 0x34, 0x17, 0x8a, 0x69, 0xfc, 0x7a, 0x55, 0x12, 0x58, 0xd6, 0x11, 0x71,
+	0xf7, 0xee, 0x84, 0x8a, 0xbc, 0x54, 0x3f, 0xdd, 0x4e, 0x54, 0x71, 0xd8, 0xf8, 0x61, 0xdc, 0x79,
+	0x4d, 0xc4, 0x81, 0xcc, 0x90, 0x95, 0x72, 0x40, 0x8b, 0x77, 0xa2, 0x1d, 0x28, 0xd2, 0x61, 0x83,
+	0x28, 0xea, 0x7a, 0x4a, 0x38, 0x19, 0xa1, 0xd7, 0xee, 0x84, 0x0a, 0x5a, 0xd8, 0x8e, 0xbe, 0x0c,
+	0x05, 0x4f, 0xb7, 0x9b, 0x0e, 0xf6, 0x88, 0x5b, 0xd9, 0xa8, 0x4a, 0x91, 0x82, 0x5a, 0xb0, 0xe4,
+	0x54, 0x34, 0x42, 0xe5, 0xeb, 0xf9, 0xad, 0xd4, 0x02, 0xd9, 0xdc, 0xb3, 0x35, 0xbd, 0x81, 0x4d,
+	0x4f, 0xf9, 0xce, 0x2a, 0x55, 0xd1, 0xc6, 0xc8, 0xf2, 0xba, 0x54, 0xeb, 0xec, 0x58, 0xdf, 0xc3,
+	0x3c, 0xcc, 0x38, 0xc4, 0xed, 0x1b, 0x9e, 0xf2, 0x53, 0x80, 0x95, 0x23, 0x66, 0x89, 0x03, 0xb3,
+	0x3e, 0x74, 0x83, 0xbb, 0x49, 0x33, 0x2a, 0x89, 0x78, 0x20, 0xba, 0x9e, 0x99, 0xb4, 0xa2, 0x4f,
+	0x05, 0x56, 0x34, 0x27, 0x32, 0x4e, 0x19, 0xf7, 0x95, 0x04, 0x46, 0xf4, 0x58, 0x64, 0x44, 0x27,
+	0x53, 0x7c, 0x19, 0xe1, 0xe6, 0x45, 0x36, 0x74, 0x3f, 0x65, 0x43, 0xa7, 0x44, 0x26, 0x43, 0x7c,
+	0xf9, 0x20, 0x6d, 0x42, 0x8d, 0x51, 0x26, 0x74, 0x3a, 0x56, 0x39, 0x3a, 0xcf, 0x84, 0x0e, 0xe7,
+	0xc8, 0xb4, 0xa0, 0x87, 0x69, 0x0b, 0x3a, 0x23, 0x62, 0x72, 0xc6, 0x41, 0x95, 0xc0, 0x80, 0xbe,
+	0x9b, 0x34, 0xa0, 0xb3, 0x22, 0x33, 0x20, 0x3c, 0x67, 0x49, 0xd9, 0xcf, 0xa3, 0xb4, 0xfd, 0xcc,
+	0xb3, 0xd1, 0xee, 0x9c, 0x6b, 0x3f, 0xa3, 0x04, 0xc6, 0xba, 0x68, 0xaa, 0x19, 0x9a, 0xcf, 0x02,
+	0x1b, 0xed, 0x46, 0xb6, 0xf9, 0x1c, 0x0e, 0x33, 0xb4, 0x9e, 0x47, 0x02, 0xeb, 0x09, 0xe2, 0xdd,
+	0x25, 0xae, 0x6b, 0x8a, 0x8c, 0xe7, 0xe3, 0x84, 0xf1, 0x2c, 0x8a, 0xf6, 0x8d, 0xa8, 0x34, 0x97,
+	0xb4, 0x9d, 0x5f, 0xcb, 0xb2, 0x9d, 0x73, 0x29, 0xcf, 0x33, 0xba, 0xd2, 0x92, 0x61, 0x3a, 0x8f,
+	0x45, 0xa6, 0xb3, 0x94, 0xe1, 0x75, 0x85, 0x65, 0x09, 0x91, 0xe5, 0xdc, 0x8e, 0x5b, 0xce, 0xf9,
+	0x94, 0x5c, 0xc4, 0x59, 0x7c, 0xc2, 0x70, 0xbe, 0x13, 0x35, 0x9c, 0x0b, 0xb1, 0x9b, 0x5e, 0x29,
+	0xc3, 0x19, 0x91, 0x6c, 0x68, 0x37, 0x9f, 0x0a, 0xec, 0x66, 0x59, 0x18, 0x10, 0x89, 0x33, 0x2f,
+	0x81, 0xd9, 0x9c, 0xf5, 0x43, 0x65, 0xe5, 0x7d, 0xa8, 0x24, 0x8d, 0x66, 0x18, 0xd4, 0xbf, 0x01,
+	0x93, 0x2f, 0xac, 0x96, 0x6f, 0x2b, 0x97, 0x04, 0x71, 0xb3, 0x4a, 0xfb, 0x33, 0x02, 0xf6, 0x37,
+	0x59, 0x96, 0x9d, 0x36, 0xc5, 0xe2, 0xd8, 0x5d, 0x39, 0x86, 0x2b, 0x31, 0xf0, 0x9f, 0x0f, 0x11,
+	0xf7, 0x60, 0xa5, 0x81, 0x4d, 0x8d, 0x18, 0x63, 0xd3, 0xf1, 0x3e, 0x54, 0x92, 0x18, 0x3f, 0x1f,
+	0x52, 0xde, 0x86, 0xb9, 0x9d, 0x33, 0xdb, 0x72, 0xbc, 0x86, 0x65, 0xf4, 0x7b, 0x26, 0x42, 0x30,
+	0x65, 0xe2, 0xf0, 0x4e, 0x12, 0xfb, 0x4d, 0xdb, 0xa8, 0x80, 0x7d, 0x44, 0xf6, 0x5b, 0xf9, 0x6b,
+	0x29, 0x40, 0x3c, 0xd2, 0xba, 0xa4, 0x87, 0x87, 0x59, 0x8e, 0x14, 0xcd, 0x72, 0x2a, 0xb4, 0x4a,
+	0xc2, 0xd6, 0xe6, 0x63, 0x07, 0x9f, 0x34, 0x67, 0x21, 0x0c, 0x3f, 0x7a, 0x11, 0x02, 0x78, 0x53,
+	0x70, 0x17, 0xc2, 0xb1, 0x5e, 0xf9, 0xd7, 0xea, 0xa8, 0x93, 0x98, 0x52, 0xf3, 0x8e, 0xf5, 0x8a,
+	0xdf, 0xaa, 0xab, 0xc3, 0xac, 0xc6, 0x08, 0xa6, 0x57, 0xfd, 0x68, 0x5a, 0xb7, 0x12, 0x59, 0x77,
+	0x74, 0x41, 0x6a, 0x00, 0xa7, 0xfc, 0x04, 0x60, 0x99, 0xf7, 0x30, 0xbe, 0x7c, 0x4c, 0xbc, 0xf0,
+	0xa7, 0xfe, 0xf2, 0x53, 0x7f, 0xf9, 0xa9, 0xbf, 0xfc, 0xd4, 0x5f, 0x0a, 0xfd, 0x25, 0xaa, 0xc1,
+	0xcc, 0x73, 0xcb, 0xe9, 0x61, 0x8f, 0x15, 0x60, 0xe6, 0x05, 0x56, 0xf0, 0x11, 0xeb, 0x56, 0x7d,
+	0x30, 0x6a, 0x54, 0x9f, 0xeb, 0x06, 0x69, 0x32, 0x1b, 0x8f, 0x98, 0xcd, 0xcd, 0xd3, 0x86, 0x03,
+	0xdc, 0x23, 0xa1, 0xf7, 0x7d, 0x77, 0x2a, 0x3f, 0x59, 0x9e, 0x52, 0xbe, 0x27, 0xc1, 0x95, 0x98,
+	0xc1, 0x0c, 0x3d, 0x4e, 0x0d, 0x66, 0x5c, 0x66, 0xf5, 0x7d, 0x53, 0x99, 0x9e, 0x96, 0x3b, 0x05,
+	0xd5, 0x07, 0xa3, 0x1e, 0xc4, 0xc6, 0x5e, 0x37, 0xf0, 0x20, 0xf4, 0x37, 0x6d, 0x6b, 0x63, 0x0f,
+	0x33, 0xcb, 0x3f, 0xa7, 0xb2, 0xdf, 0x43, 0x1f, 0x35, 0x15, 0xf5, 0x51, 0x7f, 0x21, 0x41, 0xe5,
+	0xfd, 0x78, 0x1e, 0xf1, 0xff, 0xf1, 0xd4, 0xe6, 0xa7, 0x12, 0xac, 0xa6, 0xc8, 0xfc, 0xa8, 0x5e,
+	0x10, 0xd5, 0x61, 0xd6, 0x73, 0x74, 0xfa, 0x0a, 0xd0, 0x7f, 0x35, 0x12, 0x95, 0x41, 0x40, 0x05,
+	0xed, 0x56, 0x03, 0x38, 0x71, 0xa5, 0xf6, 0xee, 0x11, 0x94, 0x62, 0xf5, 0x46, 0x54, 0x84, 0xd9,
+	0xc3, 0x9d, 0x83, 0xed, 0xbd, 0x83, 0xc7, 0xe5, 0x09, 0xfa, 0xa1, 0x3e, 0x3b, 0x38, 0xa0, 0x1f,
+	0x12, 0x2a, 0x41, 0xe1, 0xe8, 0x59, 0xa3, 0xb1, 0xb3, 0xb3, 0xbd, 0xb3, 0x5d, 0xce, 0x21, 0x80,
+	0x99, 0x47, 0x5b, 0x7b, 0x4f, 0x76, 0xb6, 0xcb, 0x93, 0xb4, 0xab, 0xb1, 0x75, 0xd0, 0xd8, 0x79,
+	0x42, 0x3f, 0xa7, 0xee, 0xde, 0x82, 0xb9, 0xa8, 0xfa, 0xa1, 0x59, 0x98, 0x6c, 0x1c, 0x9d, 0xf0,
+	0xf1, 0x0e, 0xb7, 0xd4, 0xa7, 0xcf, 0x76, 0x8e, 0xcb, 0xd2, 0xdd, 0xf7, 0x61, 0x2e, 0x4a, 0x29,
+	0xed, 0x3c, 0x3a, 0xde, 0x52, 0x8f, 0x77, 0xb6, 0xcb, 0x13, 0x08, 0xc1, 0xfc, 0xb1, 0xba, 0x77,
+	0x78, 0xd4, 0xdc, 0xdb, 0x3f, 0x7c, 0x8f, 0xb5, 0x49, 0x68, 0x11, 0x4a, 0x8d, 0xad, 0xc6, 0xee,
+	0x4e, 0xb3, 0xf1, 0x64, 0x67, 0x4b, 0x65, 0x44, 0x2c, 0xc1, 0x02, 0x6f, 0x52, 0x77, 0x1e, 0xa9,
+	0x3b, 0x47, 0xbb, 0x94, 0x9a, 0xfb, 0xbf, 0xb7, 0x01, 0x73, 0x07, 0x5f, 0xa5, 0x57, 0x6a, 0xf9,
+	0x5b, 0x4b, 0xf4, 0x5b, 0xc1, 0x5b, 0x1d, 0x9f, 0xab, 0x4c, 0x3a, 0x9c, 0x93, 0x27, 0x75, 0x34,
+	0x86, 0xa7, 0x97, 0xc7, 0x29, 0x6d, 0x2a, 0x2b, 0xdf, 0xf9, 0xb7, 0xff, 0xfc, 0x71, 0x6e, 0x51,
+	0x99, 0xab, 0x9d, 0xd6, 0x6b, 0x1a, 0x6e, 0x31, 0x61, 0x3f, 0x90, 0xee, 0x22, 0x1b, 0xe6, 0x86,
+	0x0f, 0xf7, 0x4e, 0xea, 0xe8, 0x7a, 0x64, 0x34, 0xc1, 0x03, 0x42, 0x79, 0x23, 0xa3, 0x3f, 0x9c,
+	0x69, 0x83, 0xcd, 0xb4, 0x8a, 0x56, 0xa2, 0x33, 0xd5, 0xd8, 0xf3, 0x3f, 0x76, 0xe4, 0x80, 0xfe,
+	0x44, 0x0a, 0x6f, 0xe8, 0x25, 0xca, 0xdb, 0x27, 0x75, 0x74, 0x81, 0x62, 0xbb, 0x7c, 0x91, 0x7a,
+	0xb9, 0x72, 0x8b, 0x91, 0x75, 0x5d, 0x59, 0x8d, 0x91, 0xd5, 0x1a, 0xf0, 0x20, 0x84, 0xee, 0x35,
+	0xca, 0x8d, 0x9f, 0xf8, 0xe7, 0xd6, 0x59, 0x6f, 0xba, 0x4e, 0xea, 0xe8, 0xde, 0x88, 0x59, 0x85,
+	0x4f, 0xe2, 0xe4, 0xfa, 0xd8, 0x18, 0x21, 0xb5, 0x9f, 0x61, 0xd4, 0xde, 0x50, 0xd6, 0x29, 0xb5,
+	0x3c, 0x68, 0x13, 0x13, 0xfc, 0x03, 0xfe, 0x80, 0x27, 0x16, 0xb5, 0xc5, 0x55, 0x68, 0xbc, 0xf8,
+	0x4e, 0x1e, 0xb3, 0xa4, 0xad, 0xc8, 0x8c, 0xb2, 0x65, 0x65, 0x21, 0x41, 0x19, 0x25, 0xe6, 0x77,
+	0xc2, 0x2b, 0xee, 0xb1, 0xf7, 0x3e, 0x27, 0x75, 0x94, 0x2e, 0xc6, 0x89, 0x5f, 0x52, 0xc9, 0x77,
+	0xce, 0x03, 0x0c, 0x09, 0x59, 0x65, 0x84, 0x2c, 0x29, 0xf3, 0xbe, 0x40, 0x39, 0x2d, 0x8c, 0x8e,
+	0xdf, 0x96, 0x00, 0x25, 0x5f, 0xae, 0x9c, 0xd4, 0xd1, 0xad, 0x91, 0x0f, 0x5b, 0x02, 0x0a, 0xde,
+	0x18, 0xeb, 0xf9, 0x8b, 0x72, 0x9d, 0x4d, 0x5f, 0x51, 0x96, 0x62, 0xfa, 0xa4, 0x9b, 0xd8, 0x21,
+	0x98, 0xd2, 0xf0, 0x1d, 0x09, 0x50, 0x32, 0xfa, 0x4d, 0xd0, 0x90, 0x19, 0x1c, 0xcb, 0xe3, 0x95,
+	0xed, 0x83, 0xad, 0xa6, 0x2c, 0xc7, 0x68, 0xf0, 0xa3, 0x71, 0x4a, 0xc4, 0x9f, 0xf3, 0xab, 0x4c,
+	0x19, 0x81, 0xf2, 0x49, 0x1d, 0x5d, 0x30, 0xa2, 0x96, 0x2f, 0x5a, 0xf4, 0x57, 0xaa, 0x8c, 0x44,
+	0x59, 0xb9, 0x12, 0x23, 0xd1, 0x6a, 0xf3, 0xb8, 0x9e, 0xd2, 0x68, 0xc1, 0x7c, 0xf4, 0x91, 0xc1,
+	0x49, 0x1d, 0x55, 0x53, 0x3a, 0x90, 0x78, 0x31, 0x21, 0xdf, 0xc8, 0x84, 0x08, 0x27, 0xae, 0xb0,
+	0x89, 0x91, 0x52, 0xf2, 0x27, 0xe6, 0xaf, 0x10, 0xe8, 0x84, 0xdf, 0x97, 0x60, 0x29, 0x75, 0x4d,
+	0x3f, 0xb1, 0x51, 0xb2, 0x9f, 0x24, 0xc8, 0xb7, 0x47, 0x83, 0x85, 0x04, 0xdc, 0x60, 0x04, 0xac,
+	0x29, 0x57, 0x63, 0x2b, 0x0f, 0x03, 0x7a, 0x4a, 0xc9, 0x0b, 0x28, 0x86, 0xf7, 0xd8, 0xe9, 0xd3,
+	0x9b, 0xc8, 0xc8, 0xe9, 0x2b, 0xfd, 0xf2, 0x75, 0x71, 0x77, 0x38, 0xe1, 0x3a, 0x9b, 0xf0, 0xaa,
+	0xb2, 0x18, 0x9b, 0xd0, 0xd0, 0x5d, 0x8f, 0xce, 0xf5, 0x5d, 0x09, 0x96, 0x52, 0xf9, 0x4c, 0xda,
+	0x3c, 0x64, 0xe4, 0x3b, 0xf2, 0x98, 0x07, 0x31, 0xca, 0x35, 0x46, 0xc4, 0x8a, 0x82, 0x7c, 0x22,
+	0x22, 0x09, 0x16, 0xa5, 0xe2, 0xdb, 0x12, 0x2c, 0x26, 0xb2, 0x20, 0xfa, 0x66, 0x78, 0x8c, 0x1c,
+	0x49, 0x1e, 0xeb, 0xe4, 0x26, 0x43, 0xdf, 0x82, 0x9c, 0x8c, 0x92, 0xf0, 0x47, 0x12, 0x5c, 0x15,
+	0xa5, 0x4e, 0x27, 0x75, 0x34, 0x76, 0x76, 0x25, 0x8f, 0x7f, 0xee, 0xa3, 0xdc, 0x66, 0x14, 0x55,
+	0x95, 0xb5, 0xb8, 0x3f, 0xa4, 0xe0, 0x31, 0x65, 0x78, 0x0d, 0x0b, 0xb1, 0x1c, 0x8c, 0x5e, 0x91,
+	0x3a, 0x37, 0x3f, 0x93, 0xc7, 0x38, 0x31, 0xca, 0xe0, 0x49, 0x90, 0xd6, 0xd1, 0xb9, 0x7f, 0xc8,
+	0x6f, 0x78, 0x24, 0xf2, 0xb6, 0x93, 0x3a, 0x1a, 0x33, 0xb1, 0x93, 0xc7, 0x3d, 0x6e, 0x52, 0x14,
+	0x46, 0xcb, 0xba, 0xb2, 0x92, 0x90, 0x8f, 0x0f, 0xcf, 0x38, 0xf1, 0x4d, 0x28, 0xc7, 0x73, 0xbe,
+	0x74, 0x20, 0x24, 0x4a, 0x08, 0xe5, 0x71, 0x4e, 0xa8, 0x32, 0x98, 0xd1, 0x1a, 0xf0, 0x54, 0x92,
+	0x4e, 0xff, 0x0d, 0x28, 0xc7, 0x2f, 0xca, 0x25, 0xa6, 0xcf, 0xb8, 0x00, 0x28, 0xdf, 0x1c, 0x01,
+	0x73, 0x8e, 0xdb, 0x68, 0x0d, 0x68, 0x5a, 0x49, 0x27, 0xff, 0x03, 0x7e, 0x77, 0x33, 0x9d, 0x9e,
+	0x9e, 0xd4, 0xd1, 0xf8, 0x29, 0xac, 0x7c, 0x81, 0x93, 0xb5, 0x38, 0x49, 0x34, 0xf1, 0xa5, 0xb4,
+	0xb8, 0xb5, 0xd0, 0xab, 0xff, 0x80, 0x2b, 0x47, 0x22, 0xab, 0x4d, 0x2b, 0x47, 0x56, 0xda, 0x2b,
+	0x8f, 0x7b, 0x12, 0x17, 0x37, 0x99, 0x43, 0x4a, 0x82, 0x6c, 0x9b, 0x12, 0x33, 0x80, 0x85, 0x58,
+	0x46, 0x9c, 0xd8, 0x25, 0xe2, 0x6c, 0x59, 0x1e, 0xe3, 0xe8, 0x2e, 0xc3, 0x99, 0xfa, 0x89, 0x39,
+	0x8f, 0x94, 0x4b, 0x91, 0x44, 0x9a, 0xfe, 0x2f, 0x8d, 0x73, 0x52, 0x6c, 0xf9, 0xdc, 0xc3, 0xbe,
+	0x0c, 0x9b, 0xed, 0xf9, 0x9c, 0xff, 0x1e, 0xb7, 0xd9, 0xf1, 0xa4, 0x3b, 0x6d, 0xb3, 0x33, 0x92,
+	0x72, 0x79, 0xcc, 0x33, 0xc2, 0x4c, 0x9b, 0xc9, 0xc0, 0x03, 0x42, 0x96, 0xd3, 0xa7, 0x69, 0x09,
+	0x15, 0x18, 0x71, 0xdc, 0x26, 0x8f, 0x7b, 0xb7, 0x21, 0xe5, 0x3f, 0xfc, 0xfd, 0x41, 0x51, 0xb8,
+	0x0c, 0xca, 0xf1, 0xe2, 0x7b, 0x62, 0x6f, 0x66, 0x1c, 0x67, 0xca, 0x37, 0x47, 0xc0, 0x84, 0x73,
+	0x2f, 0xb1, 0xb9, 0x4b, 0x4a, 0x9e, 0xce, 0xfd, 0xc2, 0x6a, 0xb1, 0xa5, 0xf7, 0x98, 0xd4, 0x23,
+	0xd3, 0x25, 0xa4, 0x9e, 0x9e, 0xab, 0x9a, 0x05, 0x90, 0x0c, 0x5d, 0xd1, 0x62, 0x30, 0x51, 0xed,
+	0x1b, 0xbc, 0xb2, 0xfe, 0x4d, 0xea, 0x20, 0xcb, 0xf1, 0x72, 0x7a, 0xd2, 0xfa, 0x88, 0xab, 0xf3,
+	0xf2, 0xcd, 0x11, 0x30, 0xe1, 0xc4, 0x37, 0xd9, 0xc4, 0xd7, 0x94, 0x4a, 0x6a, 0xe2, 0x9a, 0xc6,
+	0x70, 0xe8, 0x8a, 0x0d, 0x28, 0x45, 0x6a, 0x2b, 0x89, 0x15, 0x8b, 0xca, 0xd4, 0x72, 0x35, 0x0b,
+	0x20, 0x9c, 0xf8, 0x2a, 0x9b, 0xb8, 0xac, 0x14, 0xe9, 0xc4, 0xbc, 0x92, 0x4e, 0xb9, 0x7b, 0x4f,
+	0x42, 0xbf, 0x09, 0x8b, 0x89, 0xca, 0x44, 0x22, 0x20, 0xc8, 0x2a, 0xaf, 0xc8, 0xb7, 0x46, 0x01,
+	0x25, 0xf3, 0x15, 0x14, 0x57, 0xa8, 0x57, 0x14, 0xfe, 0x9e, 0xf4, 0xf0, 0xdb, 0xb9, 0x3f, 0xdc,
+	0xfa, 0x0f, 0x09, 0xf5, 0x61, 0xfe, 0xe0, 0xab, 0xd5, 0x06, 0x6e, 0x55, 0xfd, 0x7f, 0x81, 0xa4,
+	0x7c, 0x0d, 0xae, 0xfa, 0x2d, 0x7e, 0xa2, 0x5e, 0xb5, 0x1d, 0x8b, 0x96, 0x3f, 0x90, 0xd2, 0xf5,
+	0x3c, 0xdb, 0x7d, 0x50, 0xab, 0x75, 0x74, 0xaf, 0xdb, 0x6f, 0x6d, 0x6a, 0x56, 0xaf, 0xd6, 0x33,
+	0xad, 0x53, 0x5d, 0xd3, 0xad, 0x9a, 0x39, 0xa0, 0xc5, 0x7a, 0xb9, 0xda, 0xd3, 0xb5, 0x2e, 0x26,
+	0xc6, 0x26, 0x36, 0x3b, 0xc4, 0xb0, 0x36, 0xfd, 0xee, 0xaf, 0x74, 0x7a, 0x58, 0x37, 0x28, 0xc6,
+	0xfd, 0xc9, 0xfa, 0xe6, 0xbd, 0xbb, 0x92, 0x74, 0xbf, 0x8c, 0x6d, 0xdb, 0xd0, 0xf9, 0x95, 0xe7,
+	0xda, 0x0b, 0xd7, 0x32, 0x1f, 0xa4, 0x5a, 0xd4, 0x2f, 0xc1, 0xe4, 0x5b, 0xf7, 0xde, 0x42, 0x6f,
+	0xc1, 0x5d, 0x95, 0x78, 0x7d, 0xc7, 0x24, 0xed, 0xea, 0xab, 0x2e, 0x31, 0xab, 0x5e, 0x97, 0x54,
+	0x1d, 0xe2, 0x5a, 0x7d, 0x47, 0x23, 0xd5, 0xb6, 0x45, 0xdc, 0xaa, 0x69, 0x79, 0x55, 0x72, 0xa6,
+	0xbb, 0xde, 0x26, 0x9a, 0x81, 0xa9, 0x3f, 0xcd, 0x49, 0xb3, 0x1f, 0x6c, 0x04, 0xb4, 0x31, 0x42,
+	0x13, 0xff, 0x7b, 0xc9, 0xb1, 0xb5, 0xd6, 0x0c, 0xfb, 0xfa, 0x95, 0xff, 0x1d, 0x00, 0xec, 0x1a,
+	0x88, 0xaf, 0xfe, 0x49, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NYCabServiceClient is the client API for NYCabService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NYCabServiceClient interface {
+	GetAllCabTripCountPerDayV1(ctx context.Context, in *GetAllCabTripsRequestV1, opts ...grpc.CallOption) (*GetAllCabTripsResponseV1, error)
+	ClearCacheV1(ctx context.Context, in *ClearCacheRequestV1, opts ...grpc.CallOption) (*ClearCacheResponseV1, error)
+	GetTripCountsForCabIDsV1(ctx context.Context, in *GetTripCountsForCabIDsRequestV1, opts ...grpc.CallOption) (*GetTripCountsForCabIDsResponseV1, error)
+	GetTripCountsForHackLicensesV1(ctx context.Context, in *GetTripCountsForHackLicensesRequestV1, opts ...grpc.CallOption) (*GetTripCountsForHackLicensesResponseV1, error)
+	GetAllDriverTripCountPerDayV1(ctx context.Context, in *GetAllDriverTripsRequestV1, opts ...grpc.CallOption) (*GetAllDriverTripsResponseV1, error)
+	GetCabDriverMappingV1(ctx context.Context, in *GetCabDriverMappingRequestV1, opts ...grpc.CallOption) (*GetCabDriverMappingResponseV1, error)
+	CountTripsInAreaV1(ctx context.Context, in *CountTripsInAreaRequestV1, opts ...grpc.CallOption) (*CountTripsInAreaResponseV1, error)
+	GetPickupHeatmapV1(ctx context.Context, in *GetPickupHeatmapRequestV1, opts ...grpc.CallOption) (*GetPickupHeatmapResponseV1, error)
+	GetOriginDestinationMatrixV1(ctx context.Context, in *GetOriginDestinationMatrixRequestV1, opts ...grpc.CallOption) (*GetOriginDestinationMatrixResponseV1, error)
+	GetCabShiftsV1(ctx context.Context, in *GetCabShiftsRequestV1, opts ...grpc.CallOption) (*GetCabShiftsResponseV1, error)
+	FindTripAnomaliesV1(ctx context.Context, in *FindTripAnomaliesRequestV1, opts ...grpc.CallOption) (*FindTripAnomaliesResponseV1, error)
+	ListTripsV1(ctx context.Context, in *ListTripsRequestV1, opts ...grpc.CallOption) (*ListTripsResponseV1, error)
+	GetCabUtilizationV1(ctx context.Context, in *GetCabUtilizationRequestV1, opts ...grpc.CallOption) (*GetCabUtilizationResponseV1, error)
+	GetTripPatternsV1(ctx context.Context, in *GetTripPatternsRequestV1, opts ...grpc.CallOption) (*GetTripPatternsResponseV1, error)
+	DetectCountAnomaliesV1(ctx context.Context, in *DetectCountAnomaliesRequestV1, opts ...grpc.CallOption) (*DetectCountAnomaliesResponseV1, error)
+	ForecastTripsV1(ctx context.Context, in *ForecastTripsRequestV1, opts ...grpc.CallOption) (*ForecastTripsResponseV1, error)
+	GetPassengerCountsV1(ctx context.Context, in *GetPassengerCountsRequestV1, opts ...grpc.CallOption) (*GetPassengerCountsResponseV1, error)
+	GetVendorStatsV1(ctx context.Context, in *GetVendorStatsRequestV1, opts ...grpc.CallOption) (*GetVendorStatsResponseV1, error)
+	CountZoneTripsV1(ctx context.Context, in *CountZoneTripsRequestV1, opts ...grpc.CallOption) (*CountZoneTripsResponseV1, error)
+	GetTaxiZoneTripCountsV1(ctx context.Context, in *GetTaxiZoneTripCountsRequestV1, opts ...grpc.CallOption) (*GetTaxiZoneTripCountsResponseV1, error)
+	GetCabZoneCoverageV1(ctx context.Context, in *GetCabZoneCoverageRequestV1, opts ...grpc.CallOption) (*GetCabZoneCoverageResponseV1, error)
+	GetCabRevenueV1(ctx context.Context, in *GetCabRevenueRequestV1, opts ...grpc.CallOption) (*GetCabRevenueResponseV1, error)
+	GetTipRatesV1(ctx context.Context, in *GetTipRatesRequestV1, opts ...grpc.CallOption) (*GetTipRatesResponseV1, error)
+	GetPaymentTypeMixV1(ctx context.Context, in *GetPaymentTypeMixRequestV1, opts ...grpc.CallOption) (*GetPaymentTypeMixResponseV1, error)
+	BatchGetTripCountsV1(ctx context.Context, in *BatchGetTripCountsRequestV1, opts ...grpc.CallOption) (*BatchGetTripCountsResponseV1, error)
+	SubmitQueryJobV1(ctx context.Context, in *SubmitQueryJobRequestV1, opts ...grpc.CallOption) (*SubmitQueryJobResponseV1, error)
+	GetQueryJobV1(ctx context.Context, in *GetQueryJobRequestV1, opts ...grpc.CallOption) (*GetQueryJobResponseV1, error)
+	CancelQueryJobV1(ctx context.Context, in *CancelQueryJobRequestV1, opts ...grpc.CallOption) (*CancelQueryJobResponseV1, error)
+	ExportQueryV1(ctx context.Context, in *ExportQueryRequestV1, opts ...grpc.CallOption) (NYCabService_ExportQueryV1Client, error)
+	WatchTripCountsV1(ctx context.Context, in *WatchTripCountsRequestV1, opts ...grpc.CallOption) (NYCabService_WatchTripCountsV1Client, error)
+}
+
+type nYCabServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNYCabServiceClient(cc *grpc.ClientConn) NYCabServiceClient {
+	return &nYCabServiceClient{cc}
+}
+
+func (c *nYCabServiceClient) GetAllCabTripCountPerDayV1(ctx context.Context, in *GetAllCabTripsRequestV1, opts ...grpc.CallOption) (*GetAllCabTripsResponseV1, error) {
+	out := new(GetAllCabTripsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetAllCabTripCountPerDayV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) ClearCacheV1(ctx context.Context, in *ClearCacheRequestV1, opts ...grpc.CallOption) (*ClearCacheResponseV1, error) {
+	out := new(ClearCacheResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/ClearCacheV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetTripCountsForCabIDsV1(ctx context.Context, in *GetTripCountsForCabIDsRequestV1, opts ...grpc.CallOption) (*GetTripCountsForCabIDsResponseV1, error) {
+	out := new(GetTripCountsForCabIDsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetTripCountsForCabIDsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetTripCountsForHackLicensesV1(ctx context.Context, in *GetTripCountsForHackLicensesRequestV1, opts ...grpc.CallOption) (*GetTripCountsForHackLicensesResponseV1, error) {
+	out := new(GetTripCountsForHackLicensesResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetTripCountsForHackLicensesV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetAllDriverTripCountPerDayV1(ctx context.Context, in *GetAllDriverTripsRequestV1, opts ...grpc.CallOption) (*GetAllDriverTripsResponseV1, error) {
+	out := new(GetAllDriverTripsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetAllDriverTripCountPerDayV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetCabDriverMappingV1(ctx context.Context, in *GetCabDriverMappingRequestV1, opts ...grpc.CallOption) (*GetCabDriverMappingResponseV1, error) {
+	out := new(GetCabDriverMappingResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetCabDriverMappingV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) CountTripsInAreaV1(ctx context.Context, in *CountTripsInAreaRequestV1, opts ...grpc.CallOption) (*CountTripsInAreaResponseV1, error) {
+	out := new(CountTripsInAreaResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/CountTripsInAreaV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetPickupHeatmapV1(ctx context.Context, in *GetPickupHeatmapRequestV1, opts ...grpc.CallOption) (*GetPickupHeatmapResponseV1, error) {
+	out := new(GetPickupHeatmapResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetPickupHeatmapV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetOriginDestinationMatrixV1(ctx context.Context, in *GetOriginDestinationMatrixRequestV1, opts ...grpc.CallOption) (*GetOriginDestinationMatrixResponseV1, error) {
+	out := new(GetOriginDestinationMatrixResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetOriginDestinationMatrixV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetCabShiftsV1(ctx context.Context, in *GetCabShiftsRequestV1, opts ...grpc.CallOption) (*GetCabShiftsResponseV1, error) {
+	out := new(GetCabShiftsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetCabShiftsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) FindTripAnomaliesV1(ctx context.Context, in *FindTripAnomaliesRequestV1, opts ...grpc.CallOption) (*FindTripAnomaliesResponseV1, error) {
+	out := new(FindTripAnomaliesResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/FindTripAnomaliesV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) ListTripsV1(ctx context.Context, in *ListTripsRequestV1, opts ...grpc.CallOption) (*ListTripsResponseV1, error) {
+	out := new(ListTripsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/ListTripsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetCabUtilizationV1(ctx context.Context, in *GetCabUtilizationRequestV1, opts ...grpc.CallOption) (*GetCabUtilizationResponseV1, error) {
+	out := new(GetCabUtilizationResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetCabUtilizationV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetTripPatternsV1(ctx context.Context, in *GetTripPatternsRequestV1, opts ...grpc.CallOption) (*GetTripPatternsResponseV1, error) {
+	out := new(GetTripPatternsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetTripPatternsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) DetectCountAnomaliesV1(ctx context.Context, in *DetectCountAnomaliesRequestV1, opts ...grpc.CallOption) (*DetectCountAnomaliesResponseV1, error) {
+	out := new(DetectCountAnomaliesResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/DetectCountAnomaliesV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) ForecastTripsV1(ctx context.Context, in *ForecastTripsRequestV1, opts ...grpc.CallOption) (*ForecastTripsResponseV1, error) {
+	out := new(ForecastTripsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/ForecastTripsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetPassengerCountsV1(ctx context.Context, in *GetPassengerCountsRequestV1, opts ...grpc.CallOption) (*GetPassengerCountsResponseV1, error) {
+	out := new(GetPassengerCountsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetPassengerCountsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetVendorStatsV1(ctx context.Context, in *GetVendorStatsRequestV1, opts ...grpc.CallOption) (*GetVendorStatsResponseV1, error) {
+	out := new(GetVendorStatsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetVendorStatsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) CountZoneTripsV1(ctx context.Context, in *CountZoneTripsRequestV1, opts ...grpc.CallOption) (*CountZoneTripsResponseV1, error) {
+	out := new(CountZoneTripsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/CountZoneTripsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetTaxiZoneTripCountsV1(ctx context.Context, in *GetTaxiZoneTripCountsRequestV1, opts ...grpc.CallOption) (*GetTaxiZoneTripCountsResponseV1, error) {
+	out := new(GetTaxiZoneTripCountsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetTaxiZoneTripCountsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetCabZoneCoverageV1(ctx context.Context, in *GetCabZoneCoverageRequestV1, opts ...grpc.CallOption) (*GetCabZoneCoverageResponseV1, error) {
+	out := new(GetCabZoneCoverageResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetCabZoneCoverageV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetCabRevenueV1(ctx context.Context, in *GetCabRevenueRequestV1, opts ...grpc.CallOption) (*GetCabRevenueResponseV1, error) {
+	out := new(GetCabRevenueResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetCabRevenueV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetTipRatesV1(ctx context.Context, in *GetTipRatesRequestV1, opts ...grpc.CallOption) (*GetTipRatesResponseV1, error) {
+	out := new(GetTipRatesResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetTipRatesV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetPaymentTypeMixV1(ctx context.Context, in *GetPaymentTypeMixRequestV1, opts ...grpc.CallOption) (*GetPaymentTypeMixResponseV1, error) {
+	out := new(GetPaymentTypeMixResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetPaymentTypeMixV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) BatchGetTripCountsV1(ctx context.Context, in *BatchGetTripCountsRequestV1, opts ...grpc.CallOption) (*BatchGetTripCountsResponseV1, error) {
+	out := new(BatchGetTripCountsResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/BatchGetTripCountsV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) SubmitQueryJobV1(ctx context.Context, in *SubmitQueryJobRequestV1, opts ...grpc.CallOption) (*SubmitQueryJobResponseV1, error) {
+	out := new(SubmitQueryJobResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/SubmitQueryJobV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) GetQueryJobV1(ctx context.Context, in *GetQueryJobRequestV1, opts ...grpc.CallOption) (*GetQueryJobResponseV1, error) {
+	out := new(GetQueryJobResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/GetQueryJobV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) CancelQueryJobV1(ctx context.Context, in *CancelQueryJobRequestV1, opts ...grpc.CallOption) (*CancelQueryJobResponseV1, error) {
+	out := new(CancelQueryJobResponseV1)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabService/CancelQueryJobV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceClient) ExportQueryV1(ctx context.Context, in *ExportQueryRequestV1, opts ...grpc.CallOption) (NYCabService_ExportQueryV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_NYCabService_serviceDesc.Streams[0], "/nycab.rpc.NYCabService/ExportQueryV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nYCabServiceExportQueryV1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NYCabService_ExportQueryV1Client interface {
+	Recv() (*ExportQueryResponseV1, error)
+	grpc.ClientStream
+}
+
+type nYCabServiceExportQueryV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *nYCabServiceExportQueryV1Client) Recv() (*ExportQueryResponseV1, error) {
+	m := new(ExportQueryResponseV1)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *nYCabServiceClient) WatchTripCountsV1(ctx context.Context, in *WatchTripCountsRequestV1, opts ...grpc.CallOption) (NYCabService_WatchTripCountsV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_NYCabService_serviceDesc.Streams[1], "/nycab.rpc.NYCabService/WatchTripCountsV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &nYCabServiceWatchTripCountsV1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NYCabService_WatchTripCountsV1Client interface {
+	Recv() (*WatchTripCountsResponseV1, error)
+	grpc.ClientStream
+}
+
+type nYCabServiceWatchTripCountsV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *nYCabServiceWatchTripCountsV1Client) Recv() (*WatchTripCountsResponseV1, error) {
+	m := new(WatchTripCountsResponseV1)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NYCabServiceServer is the server API for NYCabService service.
+type NYCabServiceServer interface {
+	GetAllCabTripCountPerDayV1(context.Context, *GetAllCabTripsRequestV1) (*GetAllCabTripsResponseV1, error)
+	ClearCacheV1(context.Context, *ClearCacheRequestV1) (*ClearCacheResponseV1, error)
+	GetTripCountsForCabIDsV1(context.Context, *GetTripCountsForCabIDsRequestV1) (*GetTripCountsForCabIDsResponseV1, error)
+	GetTripCountsForHackLicensesV1(context.Context, *GetTripCountsForHackLicensesRequestV1) (*GetTripCountsForHackLicensesResponseV1, error)
+	GetAllDriverTripCountPerDayV1(context.Context, *GetAllDriverTripsRequestV1) (*GetAllDriverTripsResponseV1, error)
+	GetCabDriverMappingV1(context.Context, *GetCabDriverMappingRequestV1) (*GetCabDriverMappingResponseV1, error)
+	CountTripsInAreaV1(context.Context, *CountTripsInAreaRequestV1) (*CountTripsInAreaResponseV1, error)
+	GetPickupHeatmapV1(context.Context, *GetPickupHeatmapRequestV1) (*GetPickupHeatmapResponseV1, error)
+	GetOriginDestinationMatrixV1(context.Context, *GetOriginDestinationMatrixRequestV1) (*GetOriginDestinationMatrixResponseV1, error)
+	GetCabShiftsV1(context.Context, *GetCabShiftsRequestV1) (*GetCabShiftsResponseV1, error)
+	FindTripAnomaliesV1(context.Context, *FindTripAnomaliesRequestV1) (*FindTripAnomaliesResponseV1, error)
+	ListTripsV1(context.Context, *ListTripsRequestV1) (*ListTripsResponseV1, error)
+	GetCabUtilizationV1(context.Context, *GetCabUtilizationRequestV1) (*GetCabUtilizationResponseV1, error)
+	GetTripPatternsV1(context.Context, *GetTripPatternsRequestV1) (*GetTripPatternsResponseV1, error)
+	DetectCountAnomaliesV1(context.Context, *DetectCountAnomaliesRequestV1) (*DetectCountAnomaliesResponseV1, error)
+	ForecastTripsV1(context.Context, *ForecastTripsRequestV1) (*ForecastTripsResponseV1, error)
+	GetPassengerCountsV1(context.Context, *GetPassengerCountsRequestV1) (*GetPassengerCountsResponseV1, error)
+	GetVendorStatsV1(context.Context, *GetVendorStatsRequestV1) (*GetVendorStatsResponseV1, error)
+	CountZoneTripsV1(context.Context, *CountZoneTripsRequestV1) (*CountZoneTripsResponseV1, error)
+	GetTaxiZoneTripCountsV1(context.Context, *GetTaxiZoneTripCountsRequestV1) (*GetTaxiZoneTripCountsResponseV1, error)
+	GetCabZoneCoverageV1(context.Context, *GetCabZoneCoverageRequestV1) (*GetCabZoneCoverageResponseV1, error)
+	GetCabRevenueV1(context.Context, *GetCabRevenueRequestV1) (*GetCabRevenueResponseV1, error)
+	GetTipRatesV1(context.Context, *GetTipRatesRequestV1) (*GetTipRatesResponseV1, error)
+	GetPaymentTypeMixV1(context.Context, *GetPaymentTypeMixRequestV1) (*GetPaymentTypeMixResponseV1, error)
+	BatchGetTripCountsV1(context.Context, *BatchGetTripCountsRequestV1) (*BatchGetTripCountsResponseV1, error)
+	SubmitQueryJobV1(context.Context, *SubmitQueryJobRequestV1) (*SubmitQueryJobResponseV1, error)
+	GetQueryJobV1(context.Context, *GetQueryJobRequestV1) (*GetQueryJobResponseV1, error)
+	CancelQueryJobV1(context.Context, *CancelQueryJobRequestV1) (*CancelQueryJobResponseV1, error)
+	ExportQueryV1(*ExportQueryRequestV1, NYCabService_ExportQueryV1Server) error
+	WatchTripCountsV1(*WatchTripCountsRequestV1, NYCabService_WatchTripCountsV1Server) error
+}
+
+// UnimplementedNYCabServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedNYCabServiceServer struct {
+}
+
+func (*UnimplementedNYCabServiceServer) GetAllCabTripCountPerDayV1(ctx context.Context, req *GetAllCabTripsRequestV1) (*GetAllCabTripsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCabTripCountPerDayV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) ClearCacheV1(ctx context.Context, req *ClearCacheRequestV1) (*ClearCacheResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCacheV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetTripCountsForCabIDsV1(ctx context.Context, req *GetTripCountsForCabIDsRequestV1) (*GetTripCountsForCabIDsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripCountsForCabIDsV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetTripCountsForHackLicensesV1(ctx context.Context, req *GetTripCountsForHackLicensesRequestV1) (*GetTripCountsForHackLicensesResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripCountsForHackLicensesV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetAllDriverTripCountPerDayV1(ctx context.Context, req *GetAllDriverTripsRequestV1) (*GetAllDriverTripsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllDriverTripCountPerDayV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetCabDriverMappingV1(ctx context.Context, req *GetCabDriverMappingRequestV1) (*GetCabDriverMappingResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCabDriverMappingV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) CountTripsInAreaV1(ctx context.Context, req *CountTripsInAreaRequestV1) (*CountTripsInAreaResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountTripsInAreaV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetPickupHeatmapV1(ctx context.Context, req *GetPickupHeatmapRequestV1) (*GetPickupHeatmapResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickupHeatmapV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetOriginDestinationMatrixV1(ctx context.Context, req *GetOriginDestinationMatrixRequestV1) (*GetOriginDestinationMatrixResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOriginDestinationMatrixV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetCabShiftsV1(ctx context.Context, req *GetCabShiftsRequestV1) (*GetCabShiftsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCabShiftsV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) FindTripAnomaliesV1(ctx context.Context, req *FindTripAnomaliesRequestV1) (*FindTripAnomaliesResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTripAnomaliesV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) ListTripsV1(ctx context.Context, req *ListTripsRequestV1) (*ListTripsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTripsV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetCabUtilizationV1(ctx context.Context, req *GetCabUtilizationRequestV1) (*GetCabUtilizationResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCabUtilizationV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetTripPatternsV1(ctx context.Context, req *GetTripPatternsRequestV1) (*GetTripPatternsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripPatternsV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) DetectCountAnomaliesV1(ctx context.Context, req *DetectCountAnomaliesRequestV1) (*DetectCountAnomaliesResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectCountAnomaliesV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) ForecastTripsV1(ctx context.Context, req *ForecastTripsRequestV1) (*ForecastTripsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForecastTripsV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetPassengerCountsV1(ctx context.Context, req *GetPassengerCountsRequestV1) (*GetPassengerCountsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPassengerCountsV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetVendorStatsV1(ctx context.Context, req *GetVendorStatsRequestV1) (*GetVendorStatsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVendorStatsV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) CountZoneTripsV1(ctx context.Context, req *CountZoneTripsRequestV1) (*CountZoneTripsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountZoneTripsV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetTaxiZoneTripCountsV1(ctx context.Context, req *GetTaxiZoneTripCountsRequestV1) (*GetTaxiZoneTripCountsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaxiZoneTripCountsV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetCabZoneCoverageV1(ctx context.Context, req *GetCabZoneCoverageRequestV1) (*GetCabZoneCoverageResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCabZoneCoverageV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetCabRevenueV1(ctx context.Context, req *GetCabRevenueRequestV1) (*GetCabRevenueResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCabRevenueV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetTipRatesV1(ctx context.Context, req *GetTipRatesRequestV1) (*GetTipRatesResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTipRatesV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetPaymentTypeMixV1(ctx context.Context, req *GetPaymentTypeMixRequestV1) (*GetPaymentTypeMixResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentTypeMixV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) BatchGetTripCountsV1(ctx context.Context, req *BatchGetTripCountsRequestV1) (*BatchGetTripCountsResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetTripCountsV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) SubmitQueryJobV1(ctx context.Context, req *SubmitQueryJobRequestV1) (*SubmitQueryJobResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQueryJobV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) GetQueryJobV1(ctx context.Context, req *GetQueryJobRequestV1) (*GetQueryJobResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryJobV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) CancelQueryJobV1(ctx context.Context, req *CancelQueryJobRequestV1) (*CancelQueryJobResponseV1, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueryJobV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) ExportQueryV1(req *ExportQueryRequestV1, srv NYCabService_ExportQueryV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method ExportQueryV1 not implemented")
+}
+func (*UnimplementedNYCabServiceServer) WatchTripCountsV1(req *WatchTripCountsRequestV1, srv NYCabService_WatchTripCountsV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTripCountsV1 not implemented")
+}
+
+func RegisterNYCabServiceServer(s *grpc.Server, srv NYCabServiceServer) {
+	s.RegisterService(&_NYCabService_serviceDesc, srv)
+}
+
+func _NYCabService_GetAllCabTripCountPerDayV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCabTripsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetAllCabTripCountPerDayV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetAllCabTripCountPerDayV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetAllCabTripCountPerDayV1(ctx, req.(*GetAllCabTripsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_ClearCacheV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCacheRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).ClearCacheV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/ClearCacheV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).ClearCacheV1(ctx, req.(*ClearCacheRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetTripCountsForCabIDsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripCountsForCabIDsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
 		return srv.(NYCabServiceServer).GetTripCountsForCabIDsV1(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nycab.rpc.NYCabService/GetTripCountsForCabIDsV1",
+		FullMethod: "/nycab.rpc.NYCabService/GetTripCountsForCabIDsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetTripCountsForCabIDsV1(ctx, req.(*GetTripCountsForCabIDsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetTripCountsForHackLicensesV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripCountsForHackLicensesRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetTripCountsForHackLicensesV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetTripCountsForHackLicensesV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetTripCountsForHackLicensesV1(ctx, req.(*GetTripCountsForHackLicensesRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetAllDriverTripCountPerDayV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllDriverTripsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetAllDriverTripCountPerDayV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetAllDriverTripCountPerDayV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetAllDriverTripCountPerDayV1(ctx, req.(*GetAllDriverTripsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetCabDriverMappingV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCabDriverMappingRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetCabDriverMappingV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetCabDriverMappingV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetCabDriverMappingV1(ctx, req.(*GetCabDriverMappingRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_CountTripsInAreaV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountTripsInAreaRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).CountTripsInAreaV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/CountTripsInAreaV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).CountTripsInAreaV1(ctx, req.(*CountTripsInAreaRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetPickupHeatmapV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPickupHeatmapRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetPickupHeatmapV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetPickupHeatmapV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetPickupHeatmapV1(ctx, req.(*GetPickupHeatmapRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetOriginDestinationMatrixV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOriginDestinationMatrixRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetOriginDestinationMatrixV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetOriginDestinationMatrixV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetOriginDestinationMatrixV1(ctx, req.(*GetOriginDestinationMatrixRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetCabShiftsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCabShiftsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetCabShiftsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetCabShiftsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetCabShiftsV1(ctx, req.(*GetCabShiftsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_FindTripAnomaliesV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindTripAnomaliesRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).FindTripAnomaliesV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/FindTripAnomaliesV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).FindTripAnomaliesV1(ctx, req.(*FindTripAnomaliesRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_ListTripsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTripsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).ListTripsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/ListTripsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).ListTripsV1(ctx, req.(*ListTripsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetCabUtilizationV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCabUtilizationRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetCabUtilizationV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetCabUtilizationV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetCabUtilizationV1(ctx, req.(*GetCabUtilizationRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetTripPatternsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripPatternsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetTripPatternsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetTripPatternsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetTripPatternsV1(ctx, req.(*GetTripPatternsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_DetectCountAnomaliesV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectCountAnomaliesRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).DetectCountAnomaliesV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/DetectCountAnomaliesV1",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NYCabServiceServer).GetTripCountsForCabIDsV1(ctx, req.(*GetTripCountsForCabIDsRequestV1))
+		return srv.(NYCabServiceServer).DetectCountAnomaliesV1(ctx, req.(*DetectCountAnomaliesRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_ForecastTripsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastTripsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).ForecastTripsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/ForecastTripsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).ForecastTripsV1(ctx, req.(*ForecastTripsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetPassengerCountsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPassengerCountsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetPassengerCountsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetPassengerCountsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetPassengerCountsV1(ctx, req.(*GetPassengerCountsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetVendorStatsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVendorStatsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetVendorStatsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetVendorStatsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetVendorStatsV1(ctx, req.(*GetVendorStatsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_CountZoneTripsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountZoneTripsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).CountZoneTripsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/CountZoneTripsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).CountZoneTripsV1(ctx, req.(*CountZoneTripsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetTaxiZoneTripCountsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxiZoneTripCountsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetTaxiZoneTripCountsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetTaxiZoneTripCountsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetTaxiZoneTripCountsV1(ctx, req.(*GetTaxiZoneTripCountsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetCabZoneCoverageV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCabZoneCoverageRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetCabZoneCoverageV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetCabZoneCoverageV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetCabZoneCoverageV1(ctx, req.(*GetCabZoneCoverageRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetCabRevenueV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCabRevenueRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetCabRevenueV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetCabRevenueV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetCabRevenueV1(ctx, req.(*GetCabRevenueRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetTipRatesV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTipRatesRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetTipRatesV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetTipRatesV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetTipRatesV1(ctx, req.(*GetTipRatesRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetPaymentTypeMixV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentTypeMixRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetPaymentTypeMixV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetPaymentTypeMixV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetPaymentTypeMixV1(ctx, req.(*GetPaymentTypeMixRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_BatchGetTripCountsV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetTripCountsRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).BatchGetTripCountsV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/BatchGetTripCountsV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).BatchGetTripCountsV1(ctx, req.(*BatchGetTripCountsRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_SubmitQueryJobV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitQueryJobRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).SubmitQueryJobV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/SubmitQueryJobV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).SubmitQueryJobV1(ctx, req.(*SubmitQueryJobRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_GetQueryJobV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryJobRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).GetQueryJobV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/GetQueryJobV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).GetQueryJobV1(ctx, req.(*GetQueryJobRequestV1))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabService_CancelQueryJobV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelQueryJobRequestV1)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceServer).CancelQueryJobV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabService/CancelQueryJobV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceServer).CancelQueryJobV1(ctx, req.(*CancelQueryJobRequestV1))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NYCabService_ExportQueryV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportQueryRequestV1)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NYCabServiceServer).ExportQueryV1(m, &nYCabServiceExportQueryV1Server{stream})
+}
+
+type NYCabService_ExportQueryV1Server interface {
+	Send(*ExportQueryResponseV1) error
+	grpc.ServerStream
+}
+
+type nYCabServiceExportQueryV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *nYCabServiceExportQueryV1Server) Send(m *ExportQueryResponseV1) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NYCabService_WatchTripCountsV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTripCountsRequestV1)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NYCabServiceServer).WatchTripCountsV1(m, &nYCabServiceWatchTripCountsV1Server{stream})
+}
+
+type NYCabService_WatchTripCountsV1Server interface {
+	Send(*WatchTripCountsResponseV1) error
+	grpc.ServerStream
+}
+
+type nYCabServiceWatchTripCountsV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *nYCabServiceWatchTripCountsV1Server) Send(m *WatchTripCountsResponseV1) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _NYCabService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nycab.rpc.NYCabService",
 	HandlerType: (*NYCabServiceServer)(nil),
@@ -474,7 +5994,118 @@ var _NYCabService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTripCountsForCabIDsV1",
 			Handler:    _NYCabService_GetTripCountsForCabIDsV1_Handler,
 		},
+		{
+			MethodName: "GetTripCountsForHackLicensesV1",
+			Handler:    _NYCabService_GetTripCountsForHackLicensesV1_Handler,
+		},
+		{
+			MethodName: "GetAllDriverTripCountPerDayV1",
+			Handler:    _NYCabService_GetAllDriverTripCountPerDayV1_Handler,
+		},
+		{
+			MethodName: "GetCabDriverMappingV1",
+			Handler:    _NYCabService_GetCabDriverMappingV1_Handler,
+		},
+		{
+			MethodName: "CountTripsInAreaV1",
+			Handler:    _NYCabService_CountTripsInAreaV1_Handler,
+		},
+		{
+			MethodName: "GetPickupHeatmapV1",
+			Handler:    _NYCabService_GetPickupHeatmapV1_Handler,
+		},
+		{
+			MethodName: "GetOriginDestinationMatrixV1",
+			Handler:    _NYCabService_GetOriginDestinationMatrixV1_Handler,
+		},
+		{
+			MethodName: "GetCabShiftsV1",
+			Handler:    _NYCabService_GetCabShiftsV1_Handler,
+		},
+		{
+			MethodName: "FindTripAnomaliesV1",
+			Handler:    _NYCabService_FindTripAnomaliesV1_Handler,
+		},
+		{
+			MethodName: "ListTripsV1",
+			Handler:    _NYCabService_ListTripsV1_Handler,
+		},
+		{
+			MethodName: "GetCabUtilizationV1",
+			Handler:    _NYCabService_GetCabUtilizationV1_Handler,
+		},
+		{
+			MethodName: "GetTripPatternsV1",
+			Handler:    _NYCabService_GetTripPatternsV1_Handler,
+		},
+		{
+			MethodName: "DetectCountAnomaliesV1",
+			Handler:    _NYCabService_DetectCountAnomaliesV1_Handler,
+		},
+		{
+			MethodName: "ForecastTripsV1",
+			Handler:    _NYCabService_ForecastTripsV1_Handler,
+		},
+		{
+			MethodName: "GetPassengerCountsV1",
+			Handler:    _NYCabService_GetPassengerCountsV1_Handler,
+		},
+		{
+			MethodName: "GetVendorStatsV1",
+			Handler:    _NYCabService_GetVendorStatsV1_Handler,
+		},
+		{
+			MethodName: "CountZoneTripsV1",
+			Handler:    _NYCabService_CountZoneTripsV1_Handler,
+		},
+		{
+			MethodName: "GetTaxiZoneTripCountsV1",
+			Handler:    _NYCabService_GetTaxiZoneTripCountsV1_Handler,
+		},
+		{
+			MethodName: "GetCabZoneCoverageV1",
+			Handler:    _NYCabService_GetCabZoneCoverageV1_Handler,
+		},
+		{
+			MethodName: "GetCabRevenueV1",
+			Handler:    _NYCabService_GetCabRevenueV1_Handler,
+		},
+		{
+			MethodName: "GetTipRatesV1",
+			Handler:    _NYCabService_GetTipRatesV1_Handler,
+		},
+		{
+			MethodName: "GetPaymentTypeMixV1",
+			Handler:    _NYCabService_GetPaymentTypeMixV1_Handler,
+		},
+		{
+			MethodName: "BatchGetTripCountsV1",
+			Handler:    _NYCabService_BatchGetTripCountsV1_Handler,
+		},
+		{
+			MethodName: "SubmitQueryJobV1",
+			Handler:    _NYCabService_SubmitQueryJobV1_Handler,
+		},
+		{
+			MethodName: "GetQueryJobV1",
+			Handler:    _NYCabService_GetQueryJobV1_Handler,
+		},
+		{
+			MethodName: "CancelQueryJobV1",
+			Handler:    _NYCabService_CancelQueryJobV1_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportQueryV1",
+			Handler:       _NYCabService_ExportQueryV1_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchTripCountsV1",
+			Handler:       _NYCabService_WatchTripCountsV1_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
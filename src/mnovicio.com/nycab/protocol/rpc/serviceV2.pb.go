@@ -0,0 +1,4090 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: serviceV2.proto
+
+package rpc
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+	objects "mnovicio.com/nycab/protocol/objects"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type GetAllCabTripsRequestV2 struct {
+	IgnoreCache          bool                  `protobuf:"varint,1,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,2,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,3,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetAllCabTripsRequestV2) Reset()         { *m = GetAllCabTripsRequestV2{} }
+func (m *GetAllCabTripsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetAllCabTripsRequestV2) ProtoMessage()    {}
+func (*GetAllCabTripsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{0}
+}
+
+func (m *GetAllCabTripsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAllCabTripsRequestV2.Unmarshal(m, b)
+}
+func (m *GetAllCabTripsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAllCabTripsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetAllCabTripsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllCabTripsRequestV2.Merge(m, src)
+}
+func (m *GetAllCabTripsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetAllCabTripsRequestV2.Size(m)
+}
+func (m *GetAllCabTripsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllCabTripsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllCabTripsRequestV2 proto.InternalMessageInfo
+
+func (m *GetAllCabTripsRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetAllCabTripsRequestV2) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetAllCabTripsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetAllCabTripsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetAllCabTripsResponseV2 struct {
+	CabTripCounts        []*objects.CabTripCount `protobuf:"bytes,1,rep,name=cab_trip_counts,json=cabTripCounts,proto3" json:"cab_trip_counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetAllCabTripsResponseV2) Reset()         { *m = GetAllCabTripsResponseV2{} }
+func (m *GetAllCabTripsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetAllCabTripsResponseV2) ProtoMessage()    {}
+func (*GetAllCabTripsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{1}
+}
+
+func (m *GetAllCabTripsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAllCabTripsResponseV2.Unmarshal(m, b)
+}
+func (m *GetAllCabTripsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAllCabTripsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetAllCabTripsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllCabTripsResponseV2.Merge(m, src)
+}
+func (m *GetAllCabTripsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetAllCabTripsResponseV2.Size(m)
+}
+func (m *GetAllCabTripsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllCabTripsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllCabTripsResponseV2 proto.InternalMessageInfo
+
+func (m *GetAllCabTripsResponseV2) GetCabTripCounts() []*objects.CabTripCount {
+	if m != nil {
+		return m.CabTripCounts
+	}
+	return nil
+}
+
+type ClearCacheRequestV2 struct {
+	ClearCache           bool            `protobuf:"varint,1,opt,name=clear_cache,json=clearCache,proto3" json:"clear_cache,omitempty"`
+	Dataset              objects.Dataset `protobuf:"varint,2,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ClearCacheRequestV2) Reset()         { *m = ClearCacheRequestV2{} }
+func (m *ClearCacheRequestV2) String() string { return proto.CompactTextString(m) }
+func (*ClearCacheRequestV2) ProtoMessage()    {}
+func (*ClearCacheRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{2}
+}
+
+func (m *ClearCacheRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearCacheRequestV2.Unmarshal(m, b)
+}
+func (m *ClearCacheRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearCacheRequestV2.Marshal(b, m, deterministic)
+}
+func (m *ClearCacheRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearCacheRequestV2.Merge(m, src)
+}
+func (m *ClearCacheRequestV2) XXX_Size() int {
+	return xxx_messageInfo_ClearCacheRequestV2.Size(m)
+}
+func (m *ClearCacheRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearCacheRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearCacheRequestV2 proto.InternalMessageInfo
+
+func (m *ClearCacheRequestV2) GetClearCache() bool {
+	if m != nil {
+		return m.ClearCache
+	}
+	return false
+}
+
+func (m *ClearCacheRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+type ClearCacheResponseV2 struct {
+	CacheCleared         bool     `protobuf:"varint,1,opt,name=cache_cleared,json=cacheCleared,proto3" json:"cache_cleared,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearCacheResponseV2) Reset()         { *m = ClearCacheResponseV2{} }
+func (m *ClearCacheResponseV2) String() string { return proto.CompactTextString(m) }
+func (*ClearCacheResponseV2) ProtoMessage()    {}
+func (*ClearCacheResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{3}
+}
+
+func (m *ClearCacheResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearCacheResponseV2.Unmarshal(m, b)
+}
+func (m *ClearCacheResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearCacheResponseV2.Marshal(b, m, deterministic)
+}
+func (m *ClearCacheResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearCacheResponseV2.Merge(m, src)
+}
+func (m *ClearCacheResponseV2) XXX_Size() int {
+	return xxx_messageInfo_ClearCacheResponseV2.Size(m)
+}
+func (m *ClearCacheResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearCacheResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearCacheResponseV2 proto.InternalMessageInfo
+
+func (m *ClearCacheResponseV2) GetCacheCleared() bool {
+	if m != nil {
+		return m.CacheCleared
+	}
+	return false
+}
+
+type GetTripCountsForCabIDsRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,2,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	PickupDate           string                `protobuf:"bytes,3,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,4,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetTripCountsForCabIDsRequestV2) Reset()         { *m = GetTripCountsForCabIDsRequestV2{} }
+func (m *GetTripCountsForCabIDsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetTripCountsForCabIDsRequestV2) ProtoMessage()    {}
+func (*GetTripCountsForCabIDsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{4}
+}
+
+func (m *GetTripCountsForCabIDsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTripCountsForCabIDsRequestV2.Unmarshal(m, b)
+}
+func (m *GetTripCountsForCabIDsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTripCountsForCabIDsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetTripCountsForCabIDsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTripCountsForCabIDsRequestV2.Merge(m, src)
+}
+func (m *GetTripCountsForCabIDsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetTripCountsForCabIDsRequestV2.Size(m)
+}
+func (m *GetTripCountsForCabIDsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTripCountsForCabIDsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTripCountsForCabIDsRequestV2 proto.InternalMessageInfo
+
+func (m *GetTripCountsForCabIDsRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetTripCountsForCabIDsRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetTripCountsForCabIDsRequestV2) GetPickupDate() string {
+	if m != nil {
+		return m.PickupDate
+	}
+	return ""
+}
+
+func (m *GetTripCountsForCabIDsRequestV2) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetTripCountsForCabIDsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetTripCountsForCabIDsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetTripCountsForCabIDsResponseV2 struct {
+	CabTripCounts        []*objects.CabTripCount `protobuf:"bytes,1,rep,name=cab_trip_counts,json=cabTripCounts,proto3" json:"cab_trip_counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetTripCountsForCabIDsResponseV2) Reset()         { *m = GetTripCountsForCabIDsResponseV2{} }
+func (m *GetTripCountsForCabIDsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetTripCountsForCabIDsResponseV2) ProtoMessage()    {}
+func (*GetTripCountsForCabIDsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{5}
+}
+
+func (m *GetTripCountsForCabIDsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTripCountsForCabIDsResponseV2.Unmarshal(m, b)
+}
+func (m *GetTripCountsForCabIDsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTripCountsForCabIDsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetTripCountsForCabIDsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTripCountsForCabIDsResponseV2.Merge(m, src)
+}
+func (m *GetTripCountsForCabIDsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetTripCountsForCabIDsResponseV2.Size(m)
+}
+func (m *GetTripCountsForCabIDsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTripCountsForCabIDsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTripCountsForCabIDsResponseV2 proto.InternalMessageInfo
+
+func (m *GetTripCountsForCabIDsResponseV2) GetCabTripCounts() []*objects.CabTripCount {
+	if m != nil {
+		return m.CabTripCounts
+	}
+	return nil
+}
+
+type GetTripCountsForHackLicensesRequestV2 struct {
+	HackLicenses         []string              `protobuf:"bytes,1,rep,name=hack_licenses,json=hackLicenses,proto3" json:"hack_licenses,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,2,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	PickupDate           string                `protobuf:"bytes,3,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,4,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetTripCountsForHackLicensesRequestV2) Reset()         { *m = GetTripCountsForHackLicensesRequestV2{} }
+func (m *GetTripCountsForHackLicensesRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetTripCountsForHackLicensesRequestV2) ProtoMessage()    {}
+func (*GetTripCountsForHackLicensesRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{6}
+}
+
+func (m *GetTripCountsForHackLicensesRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTripCountsForHackLicensesRequestV2.Unmarshal(m, b)
+}
+func (m *GetTripCountsForHackLicensesRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTripCountsForHackLicensesRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetTripCountsForHackLicensesRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTripCountsForHackLicensesRequestV2.Merge(m, src)
+}
+func (m *GetTripCountsForHackLicensesRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetTripCountsForHackLicensesRequestV2.Size(m)
+}
+func (m *GetTripCountsForHackLicensesRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTripCountsForHackLicensesRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTripCountsForHackLicensesRequestV2 proto.InternalMessageInfo
+
+func (m *GetTripCountsForHackLicensesRequestV2) GetHackLicenses() []string {
+	if m != nil {
+		return m.HackLicenses
+	}
+	return nil
+}
+
+func (m *GetTripCountsForHackLicensesRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetTripCountsForHackLicensesRequestV2) GetPickupDate() string {
+	if m != nil {
+		return m.PickupDate
+	}
+	return ""
+}
+
+func (m *GetTripCountsForHackLicensesRequestV2) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetTripCountsForHackLicensesRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetTripCountsForHackLicensesRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetTripCountsForHackLicensesResponseV2 struct {
+	DriverTripCounts     []*objects.DriverTripCount `protobuf:"bytes,1,rep,name=driver_trip_counts,json=driverTripCounts,proto3" json:"driver_trip_counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetTripCountsForHackLicensesResponseV2) Reset() {
+	*m = GetTripCountsForHackLicensesResponseV2{}
+}
+func (m *GetTripCountsForHackLicensesResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetTripCountsForHackLicensesResponseV2) ProtoMessage()    {}
+func (*GetTripCountsForHackLicensesResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{7}
+}
+
+func (m *GetTripCountsForHackLicensesResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTripCountsForHackLicensesResponseV2.Unmarshal(m, b)
+}
+func (m *GetTripCountsForHackLicensesResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTripCountsForHackLicensesResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetTripCountsForHackLicensesResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTripCountsForHackLicensesResponseV2.Merge(m, src)
+}
+func (m *GetTripCountsForHackLicensesResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetTripCountsForHackLicensesResponseV2.Size(m)
+}
+func (m *GetTripCountsForHackLicensesResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTripCountsForHackLicensesResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTripCountsForHackLicensesResponseV2 proto.InternalMessageInfo
+
+func (m *GetTripCountsForHackLicensesResponseV2) GetDriverTripCounts() []*objects.DriverTripCount {
+	if m != nil {
+		return m.DriverTripCounts
+	}
+	return nil
+}
+
+type GetAllDriverTripsRequestV2 struct {
+	IgnoreCache          bool                  `protobuf:"varint,1,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,2,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,3,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetAllDriverTripsRequestV2) Reset()         { *m = GetAllDriverTripsRequestV2{} }
+func (m *GetAllDriverTripsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetAllDriverTripsRequestV2) ProtoMessage()    {}
+func (*GetAllDriverTripsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{8}
+}
+
+func (m *GetAllDriverTripsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAllDriverTripsRequestV2.Unmarshal(m, b)
+}
+func (m *GetAllDriverTripsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAllDriverTripsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetAllDriverTripsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllDriverTripsRequestV2.Merge(m, src)
+}
+func (m *GetAllDriverTripsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetAllDriverTripsRequestV2.Size(m)
+}
+func (m *GetAllDriverTripsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllDriverTripsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllDriverTripsRequestV2 proto.InternalMessageInfo
+
+func (m *GetAllDriverTripsRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetAllDriverTripsRequestV2) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetAllDriverTripsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetAllDriverTripsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetAllDriverTripsResponseV2 struct {
+	DriverTripCounts     []*objects.DriverTripCount `protobuf:"bytes,1,rep,name=driver_trip_counts,json=driverTripCounts,proto3" json:"driver_trip_counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetAllDriverTripsResponseV2) Reset()         { *m = GetAllDriverTripsResponseV2{} }
+func (m *GetAllDriverTripsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetAllDriverTripsResponseV2) ProtoMessage()    {}
+func (*GetAllDriverTripsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{9}
+}
+
+func (m *GetAllDriverTripsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAllDriverTripsResponseV2.Unmarshal(m, b)
+}
+func (m *GetAllDriverTripsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAllDriverTripsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetAllDriverTripsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAllDriverTripsResponseV2.Merge(m, src)
+}
+func (m *GetAllDriverTripsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetAllDriverTripsResponseV2.Size(m)
+}
+func (m *GetAllDriverTripsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAllDriverTripsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAllDriverTripsResponseV2 proto.InternalMessageInfo
+
+func (m *GetAllDriverTripsResponseV2) GetDriverTripCounts() []*objects.DriverTripCount {
+	if m != nil {
+		return m.DriverTripCounts
+	}
+	return nil
+}
+
+type GetCabDriverMappingRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	HackLicenses         []string              `protobuf:"bytes,2,rep,name=hack_licenses,json=hackLicenses,proto3" json:"hack_licenses,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,3,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	PickupDate           string                `protobuf:"bytes,4,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCabDriverMappingRequestV2) Reset()         { *m = GetCabDriverMappingRequestV2{} }
+func (m *GetCabDriverMappingRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetCabDriverMappingRequestV2) ProtoMessage()    {}
+func (*GetCabDriverMappingRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{10}
+}
+
+func (m *GetCabDriverMappingRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabDriverMappingRequestV2.Unmarshal(m, b)
+}
+func (m *GetCabDriverMappingRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabDriverMappingRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetCabDriverMappingRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabDriverMappingRequestV2.Merge(m, src)
+}
+func (m *GetCabDriverMappingRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetCabDriverMappingRequestV2.Size(m)
+}
+func (m *GetCabDriverMappingRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabDriverMappingRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabDriverMappingRequestV2 proto.InternalMessageInfo
+
+func (m *GetCabDriverMappingRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetCabDriverMappingRequestV2) GetHackLicenses() []string {
+	if m != nil {
+		return m.HackLicenses
+	}
+	return nil
+}
+
+func (m *GetCabDriverMappingRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetCabDriverMappingRequestV2) GetPickupDate() string {
+	if m != nil {
+		return m.PickupDate
+	}
+	return ""
+}
+
+func (m *GetCabDriverMappingRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetCabDriverMappingRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetCabDriverMappingResponseV2 struct {
+	CabDriverMapping     *objects.CabDriverMapping `protobuf:"bytes,1,opt,name=cab_driver_mapping,json=cabDriverMapping,proto3" json:"cab_driver_mapping,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetCabDriverMappingResponseV2) Reset()         { *m = GetCabDriverMappingResponseV2{} }
+func (m *GetCabDriverMappingResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetCabDriverMappingResponseV2) ProtoMessage()    {}
+func (*GetCabDriverMappingResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{11}
+}
+
+func (m *GetCabDriverMappingResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabDriverMappingResponseV2.Unmarshal(m, b)
+}
+func (m *GetCabDriverMappingResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabDriverMappingResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetCabDriverMappingResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabDriverMappingResponseV2.Merge(m, src)
+}
+func (m *GetCabDriverMappingResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetCabDriverMappingResponseV2.Size(m)
+}
+func (m *GetCabDriverMappingResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabDriverMappingResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabDriverMappingResponseV2 proto.InternalMessageInfo
+
+func (m *GetCabDriverMappingResponseV2) GetCabDriverMapping() *objects.CabDriverMapping {
+	if m != nil {
+		return m.CabDriverMapping
+	}
+	return nil
+}
+
+type CountTripsInAreaRequestV2 struct {
+	BoundingBox          *objects.BoundingBox  `protobuf:"bytes,1,opt,name=bounding_box,json=boundingBox,proto3" json:"bounding_box,omitempty"`
+	Polygon              []*objects.GeoPoint   `protobuf:"bytes,2,rep,name=polygon,proto3" json:"polygon,omitempty"`
+	StartTime            string                `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string                `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IncludeCabIds        bool                  `protobuf:"varint,5,opt,name=include_cab_ids,json=includeCabIds,proto3" json:"include_cab_ids,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CountTripsInAreaRequestV2) Reset()         { *m = CountTripsInAreaRequestV2{} }
+func (m *CountTripsInAreaRequestV2) String() string { return proto.CompactTextString(m) }
+func (*CountTripsInAreaRequestV2) ProtoMessage()    {}
+func (*CountTripsInAreaRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{12}
+}
+
+func (m *CountTripsInAreaRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountTripsInAreaRequestV2.Unmarshal(m, b)
+}
+func (m *CountTripsInAreaRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountTripsInAreaRequestV2.Marshal(b, m, deterministic)
+}
+func (m *CountTripsInAreaRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountTripsInAreaRequestV2.Merge(m, src)
+}
+func (m *CountTripsInAreaRequestV2) XXX_Size() int {
+	return xxx_messageInfo_CountTripsInAreaRequestV2.Size(m)
+}
+func (m *CountTripsInAreaRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountTripsInAreaRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountTripsInAreaRequestV2 proto.InternalMessageInfo
+
+func (m *CountTripsInAreaRequestV2) GetBoundingBox() *objects.BoundingBox {
+	if m != nil {
+		return m.BoundingBox
+	}
+	return nil
+}
+
+func (m *CountTripsInAreaRequestV2) GetPolygon() []*objects.GeoPoint {
+	if m != nil {
+		return m.Polygon
+	}
+	return nil
+}
+
+func (m *CountTripsInAreaRequestV2) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *CountTripsInAreaRequestV2) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *CountTripsInAreaRequestV2) GetIncludeCabIds() bool {
+	if m != nil {
+		return m.IncludeCabIds
+	}
+	return false
+}
+
+func (m *CountTripsInAreaRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *CountTripsInAreaRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type CountTripsInAreaResponseV2 struct {
+	TripCount            uint32            `protobuf:"varint,1,opt,name=trip_count,json=tripCount,proto3" json:"trip_count,omitempty"`
+	TripsPerCab          map[string]uint32 `protobuf:"bytes,2,rep,name=trips_per_cab,json=tripsPerCab,proto3" json:"trips_per_cab,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CountTripsInAreaResponseV2) Reset()         { *m = CountTripsInAreaResponseV2{} }
+func (m *CountTripsInAreaResponseV2) String() string { return proto.CompactTextString(m) }
+func (*CountTripsInAreaResponseV2) ProtoMessage()    {}
+func (*CountTripsInAreaResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{13}
+}
+
+func (m *CountTripsInAreaResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountTripsInAreaResponseV2.Unmarshal(m, b)
+}
+func (m *CountTripsInAreaResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountTripsInAreaResponseV2.Marshal(b, m, deterministic)
+}
+func (m *CountTripsInAreaResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountTripsInAreaResponseV2.Merge(m, src)
+}
+func (m *CountTripsInAreaResponseV2) XXX_Size() int {
+	return xxx_messageInfo_CountTripsInAreaResponseV2.Size(m)
+}
+func (m *CountTripsInAreaResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountTripsInAreaResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountTripsInAreaResponseV2 proto.InternalMessageInfo
+
+func (m *CountTripsInAreaResponseV2) GetTripCount() uint32 {
+	if m != nil {
+		return m.TripCount
+	}
+	return 0
+}
+
+func (m *CountTripsInAreaResponseV2) GetTripsPerCab() map[string]uint32 {
+	if m != nil {
+		return m.TripsPerCab
+	}
+	return nil
+}
+
+type GetPickupHeatmapRequestV2 struct {
+	Precision            uint32                `protobuf:"varint,1,opt,name=precision,proto3" json:"precision,omitempty"`
+	StartTime            string                `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string                `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetPickupHeatmapRequestV2) Reset()         { *m = GetPickupHeatmapRequestV2{} }
+func (m *GetPickupHeatmapRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetPickupHeatmapRequestV2) ProtoMessage()    {}
+func (*GetPickupHeatmapRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{14}
+}
+
+func (m *GetPickupHeatmapRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPickupHeatmapRequestV2.Unmarshal(m, b)
+}
+func (m *GetPickupHeatmapRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPickupHeatmapRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetPickupHeatmapRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPickupHeatmapRequestV2.Merge(m, src)
+}
+func (m *GetPickupHeatmapRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetPickupHeatmapRequestV2.Size(m)
+}
+func (m *GetPickupHeatmapRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPickupHeatmapRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPickupHeatmapRequestV2 proto.InternalMessageInfo
+
+func (m *GetPickupHeatmapRequestV2) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *GetPickupHeatmapRequestV2) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GetPickupHeatmapRequestV2) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *GetPickupHeatmapRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetPickupHeatmapRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetPickupHeatmapRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetPickupHeatmapResponseV2 struct {
+	Cells                []*objects.HeatmapCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetPickupHeatmapResponseV2) Reset()         { *m = GetPickupHeatmapResponseV2{} }
+func (m *GetPickupHeatmapResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetPickupHeatmapResponseV2) ProtoMessage()    {}
+func (*GetPickupHeatmapResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{15}
+}
+
+func (m *GetPickupHeatmapResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPickupHeatmapResponseV2.Unmarshal(m, b)
+}
+func (m *GetPickupHeatmapResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPickupHeatmapResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetPickupHeatmapResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPickupHeatmapResponseV2.Merge(m, src)
+}
+func (m *GetPickupHeatmapResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetPickupHeatmapResponseV2.Size(m)
+}
+func (m *GetPickupHeatmapResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPickupHeatmapResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPickupHeatmapResponseV2 proto.InternalMessageInfo
+
+func (m *GetPickupHeatmapResponseV2) GetCells() []*objects.HeatmapCell {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+type GetOriginDestinationMatrixRequestV2 struct {
+	StartTime            string                `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string                `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	GeohashPrecision     uint32                `protobuf:"varint,3,opt,name=geohash_precision,json=geohashPrecision,proto3" json:"geohash_precision,omitempty"`
+	GridSize             float64               `protobuf:"fixed64,4,opt,name=grid_size,json=gridSize,proto3" json:"grid_size,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,5,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetOriginDestinationMatrixRequestV2) Reset()         { *m = GetOriginDestinationMatrixRequestV2{} }
+func (m *GetOriginDestinationMatrixRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetOriginDestinationMatrixRequestV2) ProtoMessage()    {}
+func (*GetOriginDestinationMatrixRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{16}
+}
+
+func (m *GetOriginDestinationMatrixRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOriginDestinationMatrixRequestV2.Unmarshal(m, b)
+}
+func (m *GetOriginDestinationMatrixRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOriginDestinationMatrixRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetOriginDestinationMatrixRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOriginDestinationMatrixRequestV2.Merge(m, src)
+}
+func (m *GetOriginDestinationMatrixRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetOriginDestinationMatrixRequestV2.Size(m)
+}
+func (m *GetOriginDestinationMatrixRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOriginDestinationMatrixRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOriginDestinationMatrixRequestV2 proto.InternalMessageInfo
+
+func (m *GetOriginDestinationMatrixRequestV2) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GetOriginDestinationMatrixRequestV2) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *GetOriginDestinationMatrixRequestV2) GetGeohashPrecision() uint32 {
+	if m != nil {
+		return m.GeohashPrecision
+	}
+	return 0
+}
+
+func (m *GetOriginDestinationMatrixRequestV2) GetGridSize() float64 {
+	if m != nil {
+		return m.GridSize
+	}
+	return 0
+}
+
+func (m *GetOriginDestinationMatrixRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetOriginDestinationMatrixRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetOriginDestinationMatrixRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetOriginDestinationMatrixResponseV2 struct {
+	Entries              []*objects.ODMatrixEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetOriginDestinationMatrixResponseV2) Reset()         { *m = GetOriginDestinationMatrixResponseV2{} }
+func (m *GetOriginDestinationMatrixResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetOriginDestinationMatrixResponseV2) ProtoMessage()    {}
+func (*GetOriginDestinationMatrixResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{17}
+}
+
+func (m *GetOriginDestinationMatrixResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOriginDestinationMatrixResponseV2.Unmarshal(m, b)
+}
+func (m *GetOriginDestinationMatrixResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOriginDestinationMatrixResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetOriginDestinationMatrixResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOriginDestinationMatrixResponseV2.Merge(m, src)
+}
+func (m *GetOriginDestinationMatrixResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetOriginDestinationMatrixResponseV2.Size(m)
+}
+func (m *GetOriginDestinationMatrixResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOriginDestinationMatrixResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOriginDestinationMatrixResponseV2 proto.InternalMessageInfo
+
+func (m *GetOriginDestinationMatrixResponseV2) GetEntries() []*objects.ODMatrixEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type GetCabShiftsRequestV2 struct {
+	CabId                string                `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	PickupDate           string                `protobuf:"bytes,2,opt,name=pickup_date,json=pickupDate,proto3" json:"pickup_date,omitempty"`
+	MaxIdleMinutes       uint32                `protobuf:"varint,3,opt,name=max_idle_minutes,json=maxIdleMinutes,proto3" json:"max_idle_minutes,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,4,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCabShiftsRequestV2) Reset()         { *m = GetCabShiftsRequestV2{} }
+func (m *GetCabShiftsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetCabShiftsRequestV2) ProtoMessage()    {}
+func (*GetCabShiftsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{18}
+}
+
+func (m *GetCabShiftsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabShiftsRequestV2.Unmarshal(m, b)
+}
+func (m *GetCabShiftsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabShiftsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetCabShiftsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabShiftsRequestV2.Merge(m, src)
+}
+func (m *GetCabShiftsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetCabShiftsRequestV2.Size(m)
+}
+func (m *GetCabShiftsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabShiftsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabShiftsRequestV2 proto.InternalMessageInfo
+
+func (m *GetCabShiftsRequestV2) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *GetCabShiftsRequestV2) GetPickupDate() string {
+	if m != nil {
+		return m.PickupDate
+	}
+	return ""
+}
+
+func (m *GetCabShiftsRequestV2) GetMaxIdleMinutes() uint32 {
+	if m != nil {
+		return m.MaxIdleMinutes
+	}
+	return 0
+}
+
+func (m *GetCabShiftsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetCabShiftsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetCabShiftsResponseV2 struct {
+	Shifts               []*objects.Shift `protobuf:"bytes,1,rep,name=shifts,proto3" json:"shifts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetCabShiftsResponseV2) Reset()         { *m = GetCabShiftsResponseV2{} }
+func (m *GetCabShiftsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetCabShiftsResponseV2) ProtoMessage()    {}
+func (*GetCabShiftsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{19}
+}
+
+func (m *GetCabShiftsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabShiftsResponseV2.Unmarshal(m, b)
+}
+func (m *GetCabShiftsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabShiftsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetCabShiftsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabShiftsResponseV2.Merge(m, src)
+}
+func (m *GetCabShiftsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetCabShiftsResponseV2.Size(m)
+}
+func (m *GetCabShiftsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabShiftsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabShiftsResponseV2 proto.InternalMessageInfo
+
+func (m *GetCabShiftsResponseV2) GetShifts() []*objects.Shift {
+	if m != nil {
+		return m.Shifts
+	}
+	return nil
+}
+
+type FindTripAnomaliesRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartTime            string                `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              string                `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	MaxSpeedMph          float64               `protobuf:"fixed64,4,opt,name=max_speed_mph,json=maxSpeedMph,proto3" json:"max_speed_mph,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *FindTripAnomaliesRequestV2) Reset()         { *m = FindTripAnomaliesRequestV2{} }
+func (m *FindTripAnomaliesRequestV2) String() string { return proto.CompactTextString(m) }
+func (*FindTripAnomaliesRequestV2) ProtoMessage()    {}
+func (*FindTripAnomaliesRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{20}
+}
+
+func (m *FindTripAnomaliesRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindTripAnomaliesRequestV2.Unmarshal(m, b)
+}
+func (m *FindTripAnomaliesRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindTripAnomaliesRequestV2.Marshal(b, m, deterministic)
+}
+func (m *FindTripAnomaliesRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindTripAnomaliesRequestV2.Merge(m, src)
+}
+func (m *FindTripAnomaliesRequestV2) XXX_Size() int {
+	return xxx_messageInfo_FindTripAnomaliesRequestV2.Size(m)
+}
+func (m *FindTripAnomaliesRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindTripAnomaliesRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindTripAnomaliesRequestV2 proto.InternalMessageInfo
+
+func (m *FindTripAnomaliesRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *FindTripAnomaliesRequestV2) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *FindTripAnomaliesRequestV2) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *FindTripAnomaliesRequestV2) GetMaxSpeedMph() float64 {
+	if m != nil {
+		return m.MaxSpeedMph
+	}
+	return 0
+}
+
+func (m *FindTripAnomaliesRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *FindTripAnomaliesRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type FindTripAnomaliesResponseV2 struct {
+	Anomalies            []*objects.TripAnomaly `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *FindTripAnomaliesResponseV2) Reset()         { *m = FindTripAnomaliesResponseV2{} }
+func (m *FindTripAnomaliesResponseV2) String() string { return proto.CompactTextString(m) }
+func (*FindTripAnomaliesResponseV2) ProtoMessage()    {}
+func (*FindTripAnomaliesResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{21}
+}
+
+func (m *FindTripAnomaliesResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindTripAnomaliesResponseV2.Unmarshal(m, b)
+}
+func (m *FindTripAnomaliesResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindTripAnomaliesResponseV2.Marshal(b, m, deterministic)
+}
+func (m *FindTripAnomaliesResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindTripAnomaliesResponseV2.Merge(m, src)
+}
+func (m *FindTripAnomaliesResponseV2) XXX_Size() int {
+	return xxx_messageInfo_FindTripAnomaliesResponseV2.Size(m)
+}
+func (m *FindTripAnomaliesResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindTripAnomaliesResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindTripAnomaliesResponseV2 proto.InternalMessageInfo
+
+func (m *FindTripAnomaliesResponseV2) GetAnomalies() []*objects.TripAnomaly {
+	if m != nil {
+		return m.Anomalies
+	}
+	return nil
+}
+
+type ListTripsRequestV2 struct {
+	CabId     string `protobuf:"bytes,1,opt,name=cab_id,json=cabId,proto3" json:"cab_id,omitempty"`
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PageSize  uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// optional, trip fields to return, all fields if empty. cab_id is always returned
+	// supported: hack_license, pickup_time, dropoff_time, pickup_location, dropoff_location, trip_distance, passenger_count
+	Fields               []string              `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,7,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListTripsRequestV2) Reset()         { *m = ListTripsRequestV2{} }
+func (m *ListTripsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*ListTripsRequestV2) ProtoMessage()    {}
+func (*ListTripsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{22}
+}
+
+func (m *ListTripsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTripsRequestV2.Unmarshal(m, b)
+}
+func (m *ListTripsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTripsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *ListTripsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTripsRequestV2.Merge(m, src)
+}
+func (m *ListTripsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_ListTripsRequestV2.Size(m)
+}
+func (m *ListTripsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTripsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTripsRequestV2 proto.InternalMessageInfo
+
+func (m *ListTripsRequestV2) GetCabId() string {
+	if m != nil {
+		return m.CabId
+	}
+	return ""
+}
+
+func (m *ListTripsRequestV2) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *ListTripsRequestV2) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *ListTripsRequestV2) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListTripsRequestV2) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListTripsRequestV2) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ListTripsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *ListTripsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type ListTripsResponseV2 struct {
+	Trips                []*objects.TripRecord `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
+	NextPageToken        string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListTripsResponseV2) Reset()         { *m = ListTripsResponseV2{} }
+func (m *ListTripsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*ListTripsResponseV2) ProtoMessage()    {}
+func (*ListTripsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{23}
+}
+
+func (m *ListTripsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTripsResponseV2.Unmarshal(m, b)
+}
+func (m *ListTripsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTripsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *ListTripsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTripsResponseV2.Merge(m, src)
+}
+func (m *ListTripsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_ListTripsResponseV2.Size(m)
+}
+func (m *ListTripsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTripsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTripsResponseV2 proto.InternalMessageInfo
+
+func (m *ListTripsResponseV2) GetTrips() []*objects.TripRecord {
+	if m != nil {
+		return m.Trips
+	}
+	return nil
+}
+
+func (m *ListTripsResponseV2) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type GetCabUtilizationRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,5,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCabUtilizationRequestV2) Reset()         { *m = GetCabUtilizationRequestV2{} }
+func (m *GetCabUtilizationRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetCabUtilizationRequestV2) ProtoMessage()    {}
+func (*GetCabUtilizationRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{24}
+}
+
+func (m *GetCabUtilizationRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabUtilizationRequestV2.Unmarshal(m, b)
+}
+func (m *GetCabUtilizationRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabUtilizationRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetCabUtilizationRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabUtilizationRequestV2.Merge(m, src)
+}
+func (m *GetCabUtilizationRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetCabUtilizationRequestV2.Size(m)
+}
+func (m *GetCabUtilizationRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabUtilizationRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabUtilizationRequestV2 proto.InternalMessageInfo
+
+func (m *GetCabUtilizationRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetCabUtilizationRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetCabUtilizationRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetCabUtilizationRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetCabUtilizationRequestV2) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetCabUtilizationRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetCabUtilizationRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetCabUtilizationResponseV2 struct {
+	Utilization          []*objects.CabUtilization `protobuf:"bytes,1,rep,name=utilization,proto3" json:"utilization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetCabUtilizationResponseV2) Reset()         { *m = GetCabUtilizationResponseV2{} }
+func (m *GetCabUtilizationResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetCabUtilizationResponseV2) ProtoMessage()    {}
+func (*GetCabUtilizationResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{25}
+}
+
+func (m *GetCabUtilizationResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabUtilizationResponseV2.Unmarshal(m, b)
+}
+func (m *GetCabUtilizationResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabUtilizationResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetCabUtilizationResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabUtilizationResponseV2.Merge(m, src)
+}
+func (m *GetCabUtilizationResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetCabUtilizationResponseV2.Size(m)
+}
+func (m *GetCabUtilizationResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabUtilizationResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabUtilizationResponseV2 proto.InternalMessageInfo
+
+func (m *GetCabUtilizationResponseV2) GetUtilization() []*objects.CabUtilization {
+	if m != nil {
+		return m.Utilization
+	}
+	return nil
+}
+
+type GetTripPatternsRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,5,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetTripPatternsRequestV2) Reset()         { *m = GetTripPatternsRequestV2{} }
+func (m *GetTripPatternsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetTripPatternsRequestV2) ProtoMessage()    {}
+func (*GetTripPatternsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{26}
+}
+
+func (m *GetTripPatternsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTripPatternsRequestV2.Unmarshal(m, b)
+}
+func (m *GetTripPatternsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTripPatternsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetTripPatternsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTripPatternsRequestV2.Merge(m, src)
+}
+func (m *GetTripPatternsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetTripPatternsRequestV2.Size(m)
+}
+func (m *GetTripPatternsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTripPatternsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTripPatternsRequestV2 proto.InternalMessageInfo
+
+func (m *GetTripPatternsRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetTripPatternsRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetTripPatternsRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetTripPatternsRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetTripPatternsRequestV2) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetTripPatternsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetTripPatternsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetTripPatternsResponseV2 struct {
+	Patterns             []*objects.TripPatterns `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetTripPatternsResponseV2) Reset()         { *m = GetTripPatternsResponseV2{} }
+func (m *GetTripPatternsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetTripPatternsResponseV2) ProtoMessage()    {}
+func (*GetTripPatternsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{27}
+}
+
+func (m *GetTripPatternsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTripPatternsResponseV2.Unmarshal(m, b)
+}
+func (m *GetTripPatternsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTripPatternsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetTripPatternsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTripPatternsResponseV2.Merge(m, src)
+}
+func (m *GetTripPatternsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetTripPatternsResponseV2.Size(m)
+}
+func (m *GetTripPatternsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTripPatternsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTripPatternsResponseV2 proto.InternalMessageInfo
+
+func (m *GetTripPatternsResponseV2) GetPatterns() []*objects.TripPatterns {
+	if m != nil {
+		return m.Patterns
+	}
+	return nil
+}
+
+type DetectCountAnomaliesRequestV2 struct {
+	CabIds               []string               `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	WindowDays           uint32                 `protobuf:"varint,4,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	Method               objects.BaselineMethod `protobuf:"varint,5,opt,name=method,proto3,enum=nycab.data.objects.BaselineMethod" json:"method,omitempty"`
+	Threshold            float64                `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	IgnoreCache          bool                   `protobuf:"varint,7,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter  `protobuf:"varint,8,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset        `protobuf:"varint,9,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask  `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *DetectCountAnomaliesRequestV2) Reset()         { *m = DetectCountAnomaliesRequestV2{} }
+func (m *DetectCountAnomaliesRequestV2) String() string { return proto.CompactTextString(m) }
+func (*DetectCountAnomaliesRequestV2) ProtoMessage()    {}
+func (*DetectCountAnomaliesRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{28}
+}
+
+func (m *DetectCountAnomaliesRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectCountAnomaliesRequestV2.Unmarshal(m, b)
+}
+func (m *DetectCountAnomaliesRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectCountAnomaliesRequestV2.Marshal(b, m, deterministic)
+}
+func (m *DetectCountAnomaliesRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectCountAnomaliesRequestV2.Merge(m, src)
+}
+func (m *DetectCountAnomaliesRequestV2) XXX_Size() int {
+	return xxx_messageInfo_DetectCountAnomaliesRequestV2.Size(m)
+}
+func (m *DetectCountAnomaliesRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectCountAnomaliesRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectCountAnomaliesRequestV2 proto.InternalMessageInfo
+
+func (m *DetectCountAnomaliesRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *DetectCountAnomaliesRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *DetectCountAnomaliesRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *DetectCountAnomaliesRequestV2) GetWindowDays() uint32 {
+	if m != nil {
+		return m.WindowDays
+	}
+	return 0
+}
+
+func (m *DetectCountAnomaliesRequestV2) GetMethod() objects.BaselineMethod {
+	if m != nil {
+		return m.Method
+	}
+	return objects.BaselineMethod_ROLLING_MEAN
+}
+
+func (m *DetectCountAnomaliesRequestV2) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *DetectCountAnomaliesRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *DetectCountAnomaliesRequestV2) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *DetectCountAnomaliesRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *DetectCountAnomaliesRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type DetectCountAnomaliesResponseV2 struct {
+	Anomalies            []*objects.CountAnomaly `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DetectCountAnomaliesResponseV2) Reset()         { *m = DetectCountAnomaliesResponseV2{} }
+func (m *DetectCountAnomaliesResponseV2) String() string { return proto.CompactTextString(m) }
+func (*DetectCountAnomaliesResponseV2) ProtoMessage()    {}
+func (*DetectCountAnomaliesResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{29}
+}
+
+func (m *DetectCountAnomaliesResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectCountAnomaliesResponseV2.Unmarshal(m, b)
+}
+func (m *DetectCountAnomaliesResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectCountAnomaliesResponseV2.Marshal(b, m, deterministic)
+}
+func (m *DetectCountAnomaliesResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectCountAnomaliesResponseV2.Merge(m, src)
+}
+func (m *DetectCountAnomaliesResponseV2) XXX_Size() int {
+	return xxx_messageInfo_DetectCountAnomaliesResponseV2.Size(m)
+}
+func (m *DetectCountAnomaliesResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectCountAnomaliesResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectCountAnomaliesResponseV2 proto.InternalMessageInfo
+
+func (m *DetectCountAnomaliesResponseV2) GetAnomalies() []*objects.CountAnomaly {
+	if m != nil {
+		return m.Anomalies
+	}
+	return nil
+}
+
+type ForecastTripsRequestV2 struct {
+	CabIds               []string               `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	HistoryStartDate     string                 `protobuf:"bytes,2,opt,name=history_start_date,json=historyStartDate,proto3" json:"history_start_date,omitempty"`
+	HistoryEndDate       string                 `protobuf:"bytes,3,opt,name=history_end_date,json=historyEndDate,proto3" json:"history_end_date,omitempty"`
+	HorizonDays          uint32                 `protobuf:"varint,4,opt,name=horizon_days,json=horizonDays,proto3" json:"horizon_days,omitempty"`
+	Method               objects.ForecastMethod `protobuf:"varint,5,opt,name=method,proto3,enum=nycab.data.objects.ForecastMethod" json:"method,omitempty"`
+	PredictionLevel      float64                `protobuf:"fixed64,6,opt,name=prediction_level,json=predictionLevel,proto3" json:"prediction_level,omitempty"`
+	IgnoreCache          bool                   `protobuf:"varint,7,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset        `protobuf:"varint,8,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask  `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ForecastTripsRequestV2) Reset()         { *m = ForecastTripsRequestV2{} }
+func (m *ForecastTripsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*ForecastTripsRequestV2) ProtoMessage()    {}
+func (*ForecastTripsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{30}
+}
+
+func (m *ForecastTripsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForecastTripsRequestV2.Unmarshal(m, b)
+}
+func (m *ForecastTripsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForecastTripsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *ForecastTripsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForecastTripsRequestV2.Merge(m, src)
+}
+func (m *ForecastTripsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_ForecastTripsRequestV2.Size(m)
+}
+func (m *ForecastTripsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForecastTripsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForecastTripsRequestV2 proto.InternalMessageInfo
+
+func (m *ForecastTripsRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *ForecastTripsRequestV2) GetHistoryStartDate() string {
+	if m != nil {
+		return m.HistoryStartDate
+	}
+	return ""
+}
+
+func (m *ForecastTripsRequestV2) GetHistoryEndDate() string {
+	if m != nil {
+		return m.HistoryEndDate
+	}
+	return ""
+}
+
+func (m *ForecastTripsRequestV2) GetHorizonDays() uint32 {
+	if m != nil {
+		return m.HorizonDays
+	}
+	return 0
+}
+
+func (m *ForecastTripsRequestV2) GetMethod() objects.ForecastMethod {
+	if m != nil {
+		return m.Method
+	}
+	return objects.ForecastMethod_SEASONAL_NAIVE
+}
+
+func (m *ForecastTripsRequestV2) GetPredictionLevel() float64 {
+	if m != nil {
+		return m.PredictionLevel
+	}
+	return 0
+}
+
+func (m *ForecastTripsRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *ForecastTripsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *ForecastTripsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type ForecastTripsResponseV2 struct {
+	Forecasts            []*objects.CabTripForecast `protobuf:"bytes,1,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ForecastTripsResponseV2) Reset()         { *m = ForecastTripsResponseV2{} }
+func (m *ForecastTripsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*ForecastTripsResponseV2) ProtoMessage()    {}
+func (*ForecastTripsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{31}
+}
+
+func (m *ForecastTripsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForecastTripsResponseV2.Unmarshal(m, b)
+}
+func (m *ForecastTripsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForecastTripsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *ForecastTripsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForecastTripsResponseV2.Merge(m, src)
+}
+func (m *ForecastTripsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_ForecastTripsResponseV2.Size(m)
+}
+func (m *ForecastTripsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForecastTripsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForecastTripsResponseV2 proto.InternalMessageInfo
+
+func (m *ForecastTripsResponseV2) GetForecasts() []*objects.CabTripForecast {
+	if m != nil {
+		return m.Forecasts
+	}
+	return nil
+}
+
+type GetPassengerCountsRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetPassengerCountsRequestV2) Reset()         { *m = GetPassengerCountsRequestV2{} }
+func (m *GetPassengerCountsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetPassengerCountsRequestV2) ProtoMessage()    {}
+func (*GetPassengerCountsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{32}
+}
+
+func (m *GetPassengerCountsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPassengerCountsRequestV2.Unmarshal(m, b)
+}
+func (m *GetPassengerCountsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPassengerCountsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetPassengerCountsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPassengerCountsRequestV2.Merge(m, src)
+}
+func (m *GetPassengerCountsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetPassengerCountsRequestV2.Size(m)
+}
+func (m *GetPassengerCountsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPassengerCountsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPassengerCountsRequestV2 proto.InternalMessageInfo
+
+func (m *GetPassengerCountsRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetPassengerCountsRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetPassengerCountsRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetPassengerCountsRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetPassengerCountsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetPassengerCountsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetPassengerCountsResponseV2 struct {
+	Fleet                *objects.PassengerCountDistribution   `protobuf:"bytes,1,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	Cabs                 []*objects.PassengerCountDistribution `protobuf:"bytes,2,rep,name=cabs,proto3" json:"cabs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *GetPassengerCountsResponseV2) Reset()         { *m = GetPassengerCountsResponseV2{} }
+func (m *GetPassengerCountsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetPassengerCountsResponseV2) ProtoMessage()    {}
+func (*GetPassengerCountsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{33}
+}
+
+func (m *GetPassengerCountsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPassengerCountsResponseV2.Unmarshal(m, b)
+}
+func (m *GetPassengerCountsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPassengerCountsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetPassengerCountsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPassengerCountsResponseV2.Merge(m, src)
+}
+func (m *GetPassengerCountsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetPassengerCountsResponseV2.Size(m)
+}
+func (m *GetPassengerCountsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPassengerCountsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPassengerCountsResponseV2 proto.InternalMessageInfo
+
+func (m *GetPassengerCountsResponseV2) GetFleet() *objects.PassengerCountDistribution {
+	if m != nil {
+		return m.Fleet
+	}
+	return nil
+}
+
+func (m *GetPassengerCountsResponseV2) GetCabs() []*objects.PassengerCountDistribution {
+	if m != nil {
+		return m.Cabs
+	}
+	return nil
+}
+
+type GetVendorStatsRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	MaxSpeedMph          float64               `protobuf:"fixed64,4,opt,name=max_speed_mph,json=maxSpeedMph,proto3" json:"max_speed_mph,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,5,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetVendorStatsRequestV2) Reset()         { *m = GetVendorStatsRequestV2{} }
+func (m *GetVendorStatsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetVendorStatsRequestV2) ProtoMessage()    {}
+func (*GetVendorStatsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{34}
+}
+
+func (m *GetVendorStatsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVendorStatsRequestV2.Unmarshal(m, b)
+}
+func (m *GetVendorStatsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVendorStatsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetVendorStatsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVendorStatsRequestV2.Merge(m, src)
+}
+func (m *GetVendorStatsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetVendorStatsRequestV2.Size(m)
+}
+func (m *GetVendorStatsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVendorStatsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVendorStatsRequestV2 proto.InternalMessageInfo
+
+func (m *GetVendorStatsRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetVendorStatsRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetVendorStatsRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetVendorStatsRequestV2) GetMaxSpeedMph() float64 {
+	if m != nil {
+		return m.MaxSpeedMph
+	}
+	return 0
+}
+
+func (m *GetVendorStatsRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetVendorStatsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetVendorStatsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetVendorStatsResponseV2 struct {
+	Vendors              []*objects.VendorStats `protobuf:"bytes,1,rep,name=vendors,proto3" json:"vendors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetVendorStatsResponseV2) Reset()         { *m = GetVendorStatsResponseV2{} }
+func (m *GetVendorStatsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetVendorStatsResponseV2) ProtoMessage()    {}
+func (*GetVendorStatsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{35}
+}
+
+func (m *GetVendorStatsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetVendorStatsResponseV2.Unmarshal(m, b)
+}
+func (m *GetVendorStatsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetVendorStatsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetVendorStatsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVendorStatsResponseV2.Merge(m, src)
+}
+func (m *GetVendorStatsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetVendorStatsResponseV2.Size(m)
+}
+func (m *GetVendorStatsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVendorStatsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetVendorStatsResponseV2 proto.InternalMessageInfo
+
+func (m *GetVendorStatsResponseV2) GetVendors() []*objects.VendorStats {
+	if m != nil {
+		return m.Vendors
+	}
+	return nil
+}
+
+type CountZoneTripsRequestV2 struct {
+	Zones                []string              `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	CabIds               []string              `protobuf:"bytes,2,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CountZoneTripsRequestV2) Reset()         { *m = CountZoneTripsRequestV2{} }
+func (m *CountZoneTripsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*CountZoneTripsRequestV2) ProtoMessage()    {}
+func (*CountZoneTripsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{36}
+}
+
+func (m *CountZoneTripsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountZoneTripsRequestV2.Unmarshal(m, b)
+}
+func (m *CountZoneTripsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountZoneTripsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *CountZoneTripsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountZoneTripsRequestV2.Merge(m, src)
+}
+func (m *CountZoneTripsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_CountZoneTripsRequestV2.Size(m)
+}
+func (m *CountZoneTripsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountZoneTripsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountZoneTripsRequestV2 proto.InternalMessageInfo
+
+func (m *CountZoneTripsRequestV2) GetZones() []string {
+	if m != nil {
+		return m.Zones
+	}
+	return nil
+}
+
+func (m *CountZoneTripsRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *CountZoneTripsRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *CountZoneTripsRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *CountZoneTripsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *CountZoneTripsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type CountZoneTripsResponseV2 struct {
+	Counts               []*objects.ZoneTripCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CountZoneTripsResponseV2) Reset()         { *m = CountZoneTripsResponseV2{} }
+func (m *CountZoneTripsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*CountZoneTripsResponseV2) ProtoMessage()    {}
+func (*CountZoneTripsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{37}
+}
+
+func (m *CountZoneTripsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CountZoneTripsResponseV2.Unmarshal(m, b)
+}
+func (m *CountZoneTripsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CountZoneTripsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *CountZoneTripsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CountZoneTripsResponseV2.Merge(m, src)
+}
+func (m *CountZoneTripsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_CountZoneTripsResponseV2.Size(m)
+}
+func (m *CountZoneTripsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_CountZoneTripsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CountZoneTripsResponseV2 proto.InternalMessageInfo
+
+func (m *CountZoneTripsResponseV2) GetCounts() []*objects.ZoneTripCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+type GetTaxiZoneTripCountsRequestV2 struct {
+	LocationIds          []string              `protobuf:"bytes,1,rep,name=location_ids,json=locationIds,proto3" json:"location_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,4,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetTaxiZoneTripCountsRequestV2) Reset()         { *m = GetTaxiZoneTripCountsRequestV2{} }
+func (m *GetTaxiZoneTripCountsRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetTaxiZoneTripCountsRequestV2) ProtoMessage()    {}
+func (*GetTaxiZoneTripCountsRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{38}
+}
+
+func (m *GetTaxiZoneTripCountsRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTaxiZoneTripCountsRequestV2.Unmarshal(m, b)
+}
+func (m *GetTaxiZoneTripCountsRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTaxiZoneTripCountsRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetTaxiZoneTripCountsRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaxiZoneTripCountsRequestV2.Merge(m, src)
+}
+func (m *GetTaxiZoneTripCountsRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetTaxiZoneTripCountsRequestV2.Size(m)
+}
+func (m *GetTaxiZoneTripCountsRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaxiZoneTripCountsRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaxiZoneTripCountsRequestV2 proto.InternalMessageInfo
+
+func (m *GetTaxiZoneTripCountsRequestV2) GetLocationIds() []string {
+	if m != nil {
+		return m.LocationIds
+	}
+	return nil
+}
+
+func (m *GetTaxiZoneTripCountsRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetTaxiZoneTripCountsRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetTaxiZoneTripCountsRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetTaxiZoneTripCountsRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetTaxiZoneTripCountsResponseV2 struct {
+	Counts               []*objects.TaxiZoneTripCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *GetTaxiZoneTripCountsResponseV2) Reset()         { *m = GetTaxiZoneTripCountsResponseV2{} }
+func (m *GetTaxiZoneTripCountsResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetTaxiZoneTripCountsResponseV2) ProtoMessage()    {}
+func (*GetTaxiZoneTripCountsResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{39}
+}
+
+func (m *GetTaxiZoneTripCountsResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTaxiZoneTripCountsResponseV2.Unmarshal(m, b)
+}
+func (m *GetTaxiZoneTripCountsResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTaxiZoneTripCountsResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetTaxiZoneTripCountsResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaxiZoneTripCountsResponseV2.Merge(m, src)
+}
+func (m *GetTaxiZoneTripCountsResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetTaxiZoneTripCountsResponseV2.Size(m)
+}
+func (m *GetTaxiZoneTripCountsResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaxiZoneTripCountsResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaxiZoneTripCountsResponseV2 proto.InternalMessageInfo
+
+func (m *GetTaxiZoneTripCountsResponseV2) GetCounts() []*objects.TaxiZoneTripCount {
+	if m != nil {
+		return m.Counts
+	}
+	return nil
+}
+
+type GetCabZoneCoverageRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,4,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCabZoneCoverageRequestV2) Reset()         { *m = GetCabZoneCoverageRequestV2{} }
+func (m *GetCabZoneCoverageRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetCabZoneCoverageRequestV2) ProtoMessage()    {}
+func (*GetCabZoneCoverageRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{40}
+}
+
+func (m *GetCabZoneCoverageRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabZoneCoverageRequestV2.Unmarshal(m, b)
+}
+func (m *GetCabZoneCoverageRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabZoneCoverageRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetCabZoneCoverageRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabZoneCoverageRequestV2.Merge(m, src)
+}
+func (m *GetCabZoneCoverageRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetCabZoneCoverageRequestV2.Size(m)
+}
+func (m *GetCabZoneCoverageRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabZoneCoverageRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabZoneCoverageRequestV2 proto.InternalMessageInfo
+
+func (m *GetCabZoneCoverageRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetCabZoneCoverageRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetCabZoneCoverageRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetCabZoneCoverageRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetCabZoneCoverageRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetCabZoneCoverageResponseV2 struct {
+	Coverage             []*objects.CabZoneCoverage `protobuf:"bytes,1,rep,name=coverage,proto3" json:"coverage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetCabZoneCoverageResponseV2) Reset()         { *m = GetCabZoneCoverageResponseV2{} }
+func (m *GetCabZoneCoverageResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetCabZoneCoverageResponseV2) ProtoMessage()    {}
+func (*GetCabZoneCoverageResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{41}
+}
+
+func (m *GetCabZoneCoverageResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabZoneCoverageResponseV2.Unmarshal(m, b)
+}
+func (m *GetCabZoneCoverageResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabZoneCoverageResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetCabZoneCoverageResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabZoneCoverageResponseV2.Merge(m, src)
+}
+func (m *GetCabZoneCoverageResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetCabZoneCoverageResponseV2.Size(m)
+}
+func (m *GetCabZoneCoverageResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabZoneCoverageResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabZoneCoverageResponseV2 proto.InternalMessageInfo
+
+func (m *GetCabZoneCoverageResponseV2) GetCoverage() []*objects.CabZoneCoverage {
+	if m != nil {
+		return m.Coverage
+	}
+	return nil
+}
+
+type GetCabRevenueRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	HolidayFilter        objects.HolidayFilter `protobuf:"varint,5,opt,name=holiday_filter,json=holidayFilter,proto3,enum=nycab.data.objects.HolidayFilter" json:"holiday_filter,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,6,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCabRevenueRequestV2) Reset()         { *m = GetCabRevenueRequestV2{} }
+func (m *GetCabRevenueRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetCabRevenueRequestV2) ProtoMessage()    {}
+func (*GetCabRevenueRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{42}
+}
+
+func (m *GetCabRevenueRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabRevenueRequestV2.Unmarshal(m, b)
+}
+func (m *GetCabRevenueRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabRevenueRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetCabRevenueRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabRevenueRequestV2.Merge(m, src)
+}
+func (m *GetCabRevenueRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetCabRevenueRequestV2.Size(m)
+}
+func (m *GetCabRevenueRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabRevenueRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabRevenueRequestV2 proto.InternalMessageInfo
+
+func (m *GetCabRevenueRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetCabRevenueRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetCabRevenueRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetCabRevenueRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetCabRevenueRequestV2) GetHolidayFilter() objects.HolidayFilter {
+	if m != nil {
+		return m.HolidayFilter
+	}
+	return objects.HolidayFilter_INCLUDE_HOLIDAYS
+}
+
+func (m *GetCabRevenueRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetCabRevenueRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetCabRevenueResponseV2 struct {
+	Revenue              []*objects.CabRevenue `protobuf:"bytes,1,rep,name=revenue,proto3" json:"revenue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCabRevenueResponseV2) Reset()         { *m = GetCabRevenueResponseV2{} }
+func (m *GetCabRevenueResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetCabRevenueResponseV2) ProtoMessage()    {}
+func (*GetCabRevenueResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{43}
+}
+
+func (m *GetCabRevenueResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCabRevenueResponseV2.Unmarshal(m, b)
+}
+func (m *GetCabRevenueResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCabRevenueResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetCabRevenueResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCabRevenueResponseV2.Merge(m, src)
+}
+func (m *GetCabRevenueResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetCabRevenueResponseV2.Size(m)
+}
+func (m *GetCabRevenueResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCabRevenueResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCabRevenueResponseV2 proto.InternalMessageInfo
+
+func (m *GetCabRevenueResponseV2) GetRevenue() []*objects.CabRevenue {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+type GetTipRatesRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetTipRatesRequestV2) Reset()         { *m = GetTipRatesRequestV2{} }
+func (m *GetTipRatesRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetTipRatesRequestV2) ProtoMessage()    {}
+func (*GetTipRatesRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{44}
+}
+
+func (m *GetTipRatesRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTipRatesRequestV2.Unmarshal(m, b)
+}
+func (m *GetTipRatesRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTipRatesRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetTipRatesRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTipRatesRequestV2.Merge(m, src)
+}
+func (m *GetTipRatesRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetTipRatesRequestV2.Size(m)
+}
+func (m *GetTipRatesRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTipRatesRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTipRatesRequestV2 proto.InternalMessageInfo
+
+func (m *GetTipRatesRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetTipRatesRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetTipRatesRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetTipRatesRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetTipRatesRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetTipRatesRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetTipRatesResponseV2 struct {
+	Fleet                *objects.TipRate   `protobuf:"bytes,1,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	Cabs                 []*objects.TipRate `protobuf:"bytes,2,rep,name=cabs,proto3" json:"cabs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetTipRatesResponseV2) Reset()         { *m = GetTipRatesResponseV2{} }
+func (m *GetTipRatesResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetTipRatesResponseV2) ProtoMessage()    {}
+func (*GetTipRatesResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{45}
+}
+
+func (m *GetTipRatesResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTipRatesResponseV2.Unmarshal(m, b)
+}
+func (m *GetTipRatesResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTipRatesResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetTipRatesResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTipRatesResponseV2.Merge(m, src)
+}
+func (m *GetTipRatesResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetTipRatesResponseV2.Size(m)
+}
+func (m *GetTipRatesResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTipRatesResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTipRatesResponseV2 proto.InternalMessageInfo
+
+func (m *GetTipRatesResponseV2) GetFleet() *objects.TipRate {
+	if m != nil {
+		return m.Fleet
+	}
+	return nil
+}
+
+func (m *GetTipRatesResponseV2) GetCabs() []*objects.TipRate {
+	if m != nil {
+		return m.Cabs
+	}
+	return nil
+}
+
+type GetPaymentTypeMixRequestV2 struct {
+	CabIds               []string              `protobuf:"bytes,1,rep,name=cab_ids,json=cabIds,proto3" json:"cab_ids,omitempty"`
+	StartDate            string                `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	IgnoreCache          bool                  `protobuf:"varint,4,opt,name=ignore_cache,json=ignoreCache,proto3" json:"ignore_cache,omitempty"`
+	Dataset              objects.Dataset       `protobuf:"varint,5,opt,name=dataset,proto3,enum=nycab.data.objects.Dataset" json:"dataset,omitempty"`
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,100,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetPaymentTypeMixRequestV2) Reset()         { *m = GetPaymentTypeMixRequestV2{} }
+func (m *GetPaymentTypeMixRequestV2) String() string { return proto.CompactTextString(m) }
+func (*GetPaymentTypeMixRequestV2) ProtoMessage()    {}
+func (*GetPaymentTypeMixRequestV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{46}
+}
+
+func (m *GetPaymentTypeMixRequestV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaymentTypeMixRequestV2.Unmarshal(m, b)
+}
+func (m *GetPaymentTypeMixRequestV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaymentTypeMixRequestV2.Marshal(b, m, deterministic)
+}
+func (m *GetPaymentTypeMixRequestV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaymentTypeMixRequestV2.Merge(m, src)
+}
+func (m *GetPaymentTypeMixRequestV2) XXX_Size() int {
+	return xxx_messageInfo_GetPaymentTypeMixRequestV2.Size(m)
+}
+func (m *GetPaymentTypeMixRequestV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaymentTypeMixRequestV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPaymentTypeMixRequestV2 proto.InternalMessageInfo
+
+func (m *GetPaymentTypeMixRequestV2) GetCabIds() []string {
+	if m != nil {
+		return m.CabIds
+	}
+	return nil
+}
+
+func (m *GetPaymentTypeMixRequestV2) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetPaymentTypeMixRequestV2) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *GetPaymentTypeMixRequestV2) GetIgnoreCache() bool {
+	if m != nil {
+		return m.IgnoreCache
+	}
+	return false
+}
+
+func (m *GetPaymentTypeMixRequestV2) GetDataset() objects.Dataset {
+	if m != nil {
+		return m.Dataset
+	}
+	return objects.Dataset_YELLOW
+}
+
+func (m *GetPaymentTypeMixRequestV2) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type GetPaymentTypeMixResponseV2 struct {
+	Fleet                *objects.PaymentTypeMix   `protobuf:"bytes,1,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	Cabs                 []*objects.PaymentTypeMix `protobuf:"bytes,2,rep,name=cabs,proto3" json:"cabs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetPaymentTypeMixResponseV2) Reset()         { *m = GetPaymentTypeMixResponseV2{} }
+func (m *GetPaymentTypeMixResponseV2) String() string { return proto.CompactTextString(m) }
+func (*GetPaymentTypeMixResponseV2) ProtoMessage()    {}
+func (*GetPaymentTypeMixResponseV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf3288ada2454d8e, []int{47}
+}
+
+func (m *GetPaymentTypeMixResponseV2) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaymentTypeMixResponseV2.Unmarshal(m, b)
+}
+func (m *GetPaymentTypeMixResponseV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaymentTypeMixResponseV2.Marshal(b, m, deterministic)
+}
+func (m *GetPaymentTypeMixResponseV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaymentTypeMixResponseV2.Merge(m, src)
+}
+func (m *GetPaymentTypeMixResponseV2) XXX_Size() int {
+	return xxx_messageInfo_GetPaymentTypeMixResponseV2.Size(m)
+}
+func (m *GetPaymentTypeMixResponseV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaymentTypeMixResponseV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPaymentTypeMixResponseV2 proto.InternalMessageInfo
+
+func (m *GetPaymentTypeMixResponseV2) GetFleet() *objects.PaymentTypeMix {
+	if m != nil {
+		return m.Fleet
+	}
+	return nil
+}
+
+func (m *GetPaymentTypeMixResponseV2) GetCabs() []*objects.PaymentTypeMix {
+	if m != nil {
+		return m.Cabs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetAllCabTripsRequestV2)(nil), "nycab.rpc.GetAllCabTripsRequestV2")
+	proto.RegisterType((*GetAllCabTripsResponseV2)(nil), "nycab.rpc.GetAllCabTripsResponseV2")
+	proto.RegisterType((*ClearCacheRequestV2)(nil), "nycab.rpc.ClearCacheRequestV2")
+	proto.RegisterType((*ClearCacheResponseV2)(nil), "nycab.rpc.ClearCacheResponseV2")
+	proto.RegisterType((*GetTripCountsForCabIDsRequestV2)(nil), "nycab.rpc.GetTripCountsForCabIDsRequestV2")
+	proto.RegisterType((*GetTripCountsForCabIDsResponseV2)(nil), "nycab.rpc.GetTripCountsForCabIDsResponseV2")
+	proto.RegisterType((*GetTripCountsForHackLicensesRequestV2)(nil), "nycab.rpc.GetTripCountsForHackLicensesRequestV2")
+	proto.RegisterType((*GetTripCountsForHackLicensesResponseV2)(nil), "nycab.rpc.GetTripCountsForHackLicensesResponseV2")
+	proto.RegisterType((*GetAllDriverTripsRequestV2)(nil), "nycab.rpc.GetAllDriverTripsRequestV2")
+	proto.RegisterType((*GetAllDriverTripsResponseV2)(nil), "nycab.rpc.GetAllDriverTripsResponseV2")
+	proto.RegisterType((*GetCabDriverMappingRequestV2)(nil), "nycab.rpc.GetCabDriverMappingRequestV2")
+	proto.RegisterType((*GetCabDriverMappingResponseV2)(nil), "nycab.rpc.GetCabDriverMappingResponseV2")
+	proto.RegisterType((*CountTripsInAreaRequestV2)(nil), "nycab.rpc.CountTripsInAreaRequestV2")
+	proto.RegisterType((*CountTripsInAreaResponseV2)(nil), "nycab.rpc.CountTripsInAreaResponseV2")
+	proto.RegisterMapType((map[string]uint32)(nil), "nycab.rpc.CountTripsInAreaResponseV2.TripsPerCabEntry")
+	proto.RegisterType((*GetPickupHeatmapRequestV2)(nil), "nycab.rpc.GetPickupHeatmapRequestV2")
+	proto.RegisterType((*GetPickupHeatmapResponseV2)(nil), "nycab.rpc.GetPickupHeatmapResponseV2")
+	proto.RegisterType((*GetOriginDestinationMatrixRequestV2)(nil), "nycab.rpc.GetOriginDestinationMatrixRequestV2")
+	proto.RegisterType((*GetOriginDestinationMatrixResponseV2)(nil), "nycab.rpc.GetOriginDestinationMatrixResponseV2")
+	proto.RegisterType((*GetCabShiftsRequestV2)(nil), "nycab.rpc.GetCabShiftsRequestV2")
+	proto.RegisterType((*GetCabShiftsResponseV2)(nil), "nycab.rpc.GetCabShiftsResponseV2")
+	proto.RegisterType((*FindTripAnomaliesRequestV2)(nil), "nycab.rpc.FindTripAnomaliesRequestV2")
+	proto.RegisterType((*FindTripAnomaliesResponseV2)(nil), "nycab.rpc.FindTripAnomaliesResponseV2")
+	proto.RegisterType((*ListTripsRequestV2)(nil), "nycab.rpc.ListTripsRequestV2")
+	proto.RegisterType((*ListTripsResponseV2)(nil), "nycab.rpc.ListTripsResponseV2")
+	proto.RegisterType((*GetCabUtilizationRequestV2)(nil), "nycab.rpc.GetCabUtilizationRequestV2")
+	proto.RegisterType((*GetCabUtilizationResponseV2)(nil), "nycab.rpc.GetCabUtilizationResponseV2")
+	proto.RegisterType((*GetTripPatternsRequestV2)(nil), "nycab.rpc.GetTripPatternsRequestV2")
+	proto.RegisterType((*GetTripPatternsResponseV2)(nil), "nycab.rpc.GetTripPatternsResponseV2")
+	proto.RegisterType((*DetectCountAnomaliesRequestV2)(nil), "nycab.rpc.DetectCountAnomaliesRequestV2")
+	proto.RegisterType((*DetectCountAnomaliesResponseV2)(nil), "nycab.rpc.DetectCountAnomaliesResponseV2")
+	proto.RegisterType((*ForecastTripsRequestV2)(nil), "nycab.rpc.ForecastTripsRequestV2")
+	proto.RegisterType((*ForecastTripsResponseV2)(nil), "nycab.rpc.ForecastTripsResponseV2")
+	proto.RegisterType((*GetPassengerCountsRequestV2)(nil), "nycab.rpc.GetPassengerCountsRequestV2")
+	proto.RegisterType((*GetPassengerCountsResponseV2)(nil), "nycab.rpc.GetPassengerCountsResponseV2")
+	proto.RegisterType((*GetVendorStatsRequestV2)(nil), "nycab.rpc.GetVendorStatsRequestV2")
+	proto.RegisterType((*GetVendorStatsResponseV2)(nil), "nycab.rpc.GetVendorStatsResponseV2")
+	proto.RegisterType((*CountZoneTripsRequestV2)(nil), "nycab.rpc.CountZoneTripsRequestV2")
+	proto.RegisterType((*CountZoneTripsResponseV2)(nil), "nycab.rpc.CountZoneTripsResponseV2")
+	proto.RegisterType((*GetTaxiZoneTripCountsRequestV2)(nil), "nycab.rpc.GetTaxiZoneTripCountsRequestV2")
+	proto.RegisterType((*GetTaxiZoneTripCountsResponseV2)(nil), "nycab.rpc.GetTaxiZoneTripCountsResponseV2")
+	proto.RegisterType((*GetCabZoneCoverageRequestV2)(nil), "nycab.rpc.GetCabZoneCoverageRequestV2")
+	proto.RegisterType((*GetCabZoneCoverageResponseV2)(nil), "nycab.rpc.GetCabZoneCoverageResponseV2")
+	proto.RegisterType((*GetCabRevenueRequestV2)(nil), "nycab.rpc.GetCabRevenueRequestV2")
+	proto.RegisterType((*GetCabRevenueResponseV2)(nil), "nycab.rpc.GetCabRevenueResponseV2")
+	proto.RegisterType((*GetTipRatesRequestV2)(nil), "nycab.rpc.GetTipRatesRequestV2")
+	proto.RegisterType((*GetTipRatesResponseV2)(nil), "nycab.rpc.GetTipRatesResponseV2")
+	proto.RegisterType((*GetPaymentTypeMixRequestV2)(nil), "nycab.rpc.GetPaymentTypeMixRequestV2")
+	proto.RegisterType((*GetPaymentTypeMixResponseV2)(nil), "nycab.rpc.GetPaymentTypeMixResponseV2")
+}
+
+func init() { proto.RegisterFile("serviceV2.proto", fileDescriptor_bf3288ada2454d8e) }
+
+var fileDescriptor_bf3288ada2454d8e = []byte{
+	// 2992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcd, 0x8f, 0x1c, 0x47,
+	0xd9, 0x57, 0xcf, 0x7a, 0x3f, 0xe6, 0x19, 0xef, 0x87, 0xdb, 0xf6, 0x7e, 0xf4, 0x7e, 0xb5, 0x7b,
+	0xed, 0xf5, 0xc6, 0x49, 0x66, 0x9c, 0x79, 0x93, 0x28, 0x71, 0x5e, 0x02, 0xde, 0xd9, 0xf8, 0x03,
+	0xb2, 0x64, 0x69, 0x3b, 0x23, 0x25, 0x42, 0x4c, 0x6a, 0xba, 0x6b, 0x67, 0x2a, 0xdb, 0xd3, 0xdd,
+	0x74, 0xd7, 0xac, 0x77, 0x1c, 0x81, 0x20, 0x10, 0x40, 0xe1, 0x00, 0x0a, 0xe2, 0xc0, 0x91, 0x03,
+	0x52, 0x24, 0x24, 0x6e, 0x5c, 0x38, 0xf0, 0x07, 0xa0, 0x88, 0x0b, 0x07, 0x24, 0x8e, 0x88, 0x5b,
+	0x0e, 0x5c, 0x38, 0x20, 0x21, 0x01, 0xaa, 0xea, 0x8f, 0xe9, 0xaf, 0xe9, 0x19, 0xdb, 0xf2, 0x04,
+	0xc9, 0x39, 0xed, 0xf6, 0x53, 0x4f, 0x55, 0x3d, 0xf5, 0xfb, 0x3d, 0xf5, 0x54, 0xd5, 0x53, 0x35,
+	0x30, 0xef, 0x62, 0xe7, 0x98, 0x68, 0xb8, 0x5e, 0x2d, 0xdb, 0x8e, 0x45, 0x2d, 0xb1, 0x68, 0xf6,
+	0x34, 0xd4, 0x2c, 0x3b, 0xb6, 0x26, 0xad, 0xb5, 0x2c, 0xab, 0x65, 0xe0, 0x0a, 0xb2, 0x49, 0x05,
+	0x99, 0xa6, 0x45, 0x11, 0x25, 0x96, 0xe9, 0x7a, 0x8a, 0x92, 0xec, 0x97, 0xf2, 0xaf, 0x66, 0xf7,
+	0xb0, 0x72, 0x48, 0xb0, 0xa1, 0x37, 0x3a, 0xc8, 0x3d, 0xf2, 0x35, 0x9e, 0xe1, 0x7f, 0xb4, 0x67,
+	0x5b, 0xd8, 0x7c, 0xd6, 0xbd, 0x87, 0x5a, 0x2d, 0xec, 0x54, 0x2c, 0x9b, 0xb7, 0x91, 0xd1, 0xde,
+	0x45, 0xde, 0xb1, 0xd7, 0x9c, 0x66, 0x19, 0x15, 0xab, 0xf9, 0x2e, 0xd6, 0xa8, 0x1b, 0xfc, 0xf5,
+	0xb5, 0xb6, 0xf3, 0xb5, 0x82, 0x61, 0x28, 0xff, 0x14, 0x60, 0xe9, 0x26, 0xa6, 0xd7, 0x0d, 0xa3,
+	0x86, 0x9a, 0x77, 0x1d, 0x62, 0xbb, 0x2a, 0xfe, 0x66, 0x17, 0xbb, 0xb4, 0x5e, 0x15, 0x2f, 0xc0,
+	0x69, 0xd2, 0x32, 0x2d, 0x07, 0x37, 0x34, 0xa4, 0xb5, 0xf1, 0xb2, 0x20, 0x0b, 0x3b, 0x33, 0x6a,
+	0xc9, 0x93, 0xd5, 0x98, 0x48, 0xbc, 0x05, 0x73, 0x6d, 0xcb, 0x20, 0x3a, 0xea, 0x35, 0x0e, 0x89,
+	0x41, 0xb1, 0xb3, 0x5c, 0x90, 0x85, 0x9d, 0xb9, 0xea, 0x85, 0xb2, 0x07, 0x8f, 0x8e, 0x28, 0x2a,
+	0x07, 0x96, 0xdd, 0xf2, 0x34, 0x6f, 0x70, 0x45, 0x75, 0xb6, 0x1d, 0xfd, 0x14, 0x5f, 0x80, 0x69,
+	0xa6, 0xec, 0x62, 0xba, 0x3c, 0xc1, 0x9b, 0x58, 0xcd, 0x6a, 0x62, 0xcf, 0x53, 0x51, 0x03, 0x5d,
+	0xf1, 0x65, 0x80, 0x3e, 0x9e, 0xcb, 0xba, 0x2c, 0xec, 0x94, 0xaa, 0x52, 0xd9, 0x83, 0xbc, 0x1c,
+	0x40, 0x5e, 0xbe, 0xc1, 0x54, 0xf6, 0x91, 0x7b, 0xa4, 0x16, 0x0f, 0x83, 0x7f, 0x15, 0x1d, 0x96,
+	0x93, 0x23, 0x77, 0x6d, 0xcb, 0x74, 0x71, 0xbd, 0x2a, 0xde, 0x82, 0x79, 0x0d, 0x35, 0x1b, 0xd4,
+	0x21, 0x76, 0x43, 0xb3, 0xba, 0x26, 0x75, 0x97, 0x05, 0x79, 0x62, 0xa7, 0x54, 0x95, 0xb3, 0xac,
+	0xf2, 0x1b, 0xa8, 0x31, 0x45, 0x75, 0x56, 0x8b, 0x7c, 0xb9, 0x4a, 0x07, 0xce, 0xd6, 0x0c, 0x8c,
+	0x1c, 0x8e, 0x57, 0x1f, 0xdb, 0x4d, 0x28, 0x69, 0x4c, 0x1c, 0x83, 0x16, 0xb4, 0x50, 0x33, 0x8a,
+	0x47, 0x61, 0x74, 0x3c, 0x94, 0x57, 0xe0, 0x5c, 0xb4, 0xbb, 0x70, 0x40, 0x5b, 0x30, 0xcb, 0x7b,
+	0x6a, 0xf0, 0x2e, 0xb0, 0xee, 0xf7, 0x78, 0x9a, 0x0b, 0x6b, 0x9e, 0x4c, 0xf9, 0x5d, 0x01, 0x36,
+	0x6f, 0x62, 0xda, 0xb7, 0xfe, 0x86, 0xe5, 0xd4, 0x50, 0xf3, 0xf6, 0x5e, 0xc4, 0x29, 0x96, 0x60,
+	0x9a, 0x21, 0x43, 0x74, 0x0f, 0x91, 0xa2, 0x3a, 0xa5, 0xa1, 0xe6, 0x6d, 0xdd, 0x4d, 0x79, 0x4b,
+	0x21, 0xed, 0x2d, 0x9b, 0x50, 0xb2, 0x89, 0x76, 0xd4, 0xb5, 0x1b, 0x3a, 0xa2, 0x98, 0xf3, 0x5c,
+	0x54, 0xc1, 0x13, 0xed, 0x21, 0x9a, 0xe5, 0x4e, 0xa7, 0x1e, 0xdd, 0x9d, 0x26, 0xc7, 0xe3, 0x4e,
+	0x06, 0xc8, 0x83, 0xb0, 0x7b, 0x0c, 0x6e, 0xf5, 0x49, 0x01, 0x2e, 0x25, 0xbb, 0xbb, 0x85, 0xb4,
+	0xa3, 0xd7, 0x89, 0x86, 0x4d, 0x17, 0x47, 0x08, 0xdb, 0x82, 0xd9, 0x36, 0xd2, 0x8e, 0x1a, 0x86,
+	0x5f, 0xe2, 0xd3, 0x76, 0xba, 0x1d, 0xd1, 0x7e, 0xe2, 0xc9, 0x7b, 0x0f, 0xb6, 0xf3, 0xd1, 0x0c,
+	0x29, 0xfc, 0x1a, 0x88, 0xba, 0x43, 0x8e, 0xb1, 0x93, 0xc1, 0xe2, 0x56, 0xa6, 0x99, 0x5c, 0xbb,
+	0x4f, 0xe4, 0x82, 0x1e, 0x17, 0xb8, 0xca, 0xbf, 0x04, 0x90, 0xbc, 0x48, 0xd4, 0xd7, 0x7d, 0x72,
+	0xc2, 0xb0, 0x0d, 0xab, 0x19, 0x83, 0x7f, 0x9c, 0x78, 0x7f, 0x54, 0x80, 0xb5, 0x9b, 0x98, 0xd6,
+	0x50, 0xd3, 0xd3, 0xdd, 0x47, 0xb6, 0x4d, 0xcc, 0xd6, 0x08, 0x31, 0x2e, 0x35, 0x97, 0x0a, 0x23,
+	0xcc, 0xa5, 0x89, 0xa1, 0x73, 0xe9, 0x54, 0x6a, 0x2e, 0x8d, 0x7f, 0x06, 0xb8, 0xb0, 0x9e, 0x89,
+	0x49, 0x48, 0x84, 0x0a, 0x22, 0x03, 0xc5, 0x27, 0xa3, 0xe3, 0x95, 0x73, 0x67, 0x2c, 0x55, 0x2f,
+	0x0e, 0x08, 0x5f, 0xf1, 0xb6, 0x16, 0xb4, 0x84, 0x44, 0xf9, 0xb4, 0x00, 0x2b, 0x9c, 0x14, 0xce,
+	0xfa, 0x6d, 0xf3, 0xba, 0x83, 0x51, 0x9f, 0x86, 0x5d, 0x38, 0xdd, 0xb4, 0xba, 0xa6, 0x4e, 0xcc,
+	0x56, 0xa3, 0x69, 0x9d, 0xf8, 0x7d, 0x6d, 0x66, 0xf5, 0xb5, 0xeb, 0xeb, 0xed, 0x5a, 0x27, 0x6a,
+	0xa9, 0xd9, 0xff, 0x10, 0x5f, 0x84, 0x69, 0xdb, 0x32, 0x7a, 0x2d, 0xcb, 0xe4, 0x5c, 0x95, 0xaa,
+	0x6b, 0x59, 0xd5, 0x6f, 0x62, 0xeb, 0xc0, 0x22, 0x26, 0x55, 0x03, 0x65, 0x71, 0x1d, 0xc0, 0xa5,
+	0xc8, 0xa1, 0x0d, 0x4a, 0x3a, 0x41, 0xb0, 0x2b, 0x72, 0xc9, 0x5d, 0xd2, 0xc1, 0xe2, 0x0a, 0xcc,
+	0x60, 0x53, 0xf7, 0x0a, 0x3d, 0xf6, 0xa6, 0xb1, 0xa9, 0xf3, 0xa2, 0x6d, 0x98, 0x27, 0xa6, 0x66,
+	0x74, 0x75, 0xdc, 0x08, 0x9c, 0x68, 0x92, 0x7b, 0xc0, 0xac, 0x2f, 0xae, 0x79, 0xbe, 0x14, 0xa1,
+	0x78, 0x6a, 0x3c, 0x14, 0xff, 0x59, 0x00, 0x29, 0x8d, 0x76, 0x48, 0xf0, 0x3a, 0x40, 0x7f, 0x8a,
+	0x71, 0xb0, 0x67, 0xd5, 0x22, 0x0d, 0xa6, 0x8d, 0xf8, 0x36, 0xcc, 0xb2, 0x0f, 0xb7, 0x61, 0x63,
+	0xb6, 0x6b, 0x69, 0xfa, 0x78, 0xbe, 0x58, 0x0e, 0x37, 0xc2, 0xe5, 0xc1, 0x8d, 0x97, 0xb9, 0xf4,
+	0x00, 0xb3, 0x25, 0xf1, 0x35, 0x93, 0x3a, 0x3d, 0xb5, 0x44, 0xfb, 0x12, 0xe9, 0x55, 0x58, 0x48,
+	0x2a, 0x88, 0x0b, 0x30, 0x71, 0x84, 0x7b, 0xdc, 0x8e, 0xa2, 0xca, 0xfe, 0x15, 0xcf, 0xc1, 0xe4,
+	0x31, 0x32, 0xba, 0xde, 0xea, 0x34, 0xab, 0x7a, 0x1f, 0xd7, 0x0a, 0x2f, 0x09, 0xca, 0x8f, 0x0a,
+	0xb0, 0x72, 0x13, 0xd3, 0x03, 0x3e, 0x81, 0x6e, 0x61, 0x44, 0x3b, 0xc8, 0xee, 0xfb, 0xd1, 0x1a,
+	0x14, 0x6d, 0x07, 0x6b, 0xc4, 0x25, 0x96, 0x19, 0x8c, 0x2b, 0x14, 0x24, 0x98, 0x2e, 0xe4, 0x31,
+	0x3d, 0x11, 0x67, 0x3a, 0x39, 0xd1, 0x4f, 0xa5, 0x27, 0xfa, 0xf8, 0xe7, 0xf1, 0x1d, 0x90, 0xd2,
+	0x48, 0x84, 0x1c, 0xbf, 0x00, 0x93, 0x1a, 0x36, 0x8c, 0x20, 0x80, 0x66, 0xce, 0x25, 0xbf, 0x56,
+	0x0d, 0x1b, 0x86, 0xea, 0x69, 0x2b, 0xbf, 0x2f, 0xc0, 0xd6, 0x4d, 0x4c, 0xdf, 0x70, 0x48, 0x8b,
+	0x98, 0x7b, 0xd8, 0xa5, 0xc4, 0xe4, 0x67, 0x92, 0x7d, 0x44, 0x1d, 0x72, 0xd2, 0x47, 0x3a, 0x8e,
+	0xa5, 0x90, 0x87, 0x65, 0x21, 0x8e, 0xe5, 0xd3, 0x70, 0xa6, 0x85, 0xad, 0x36, 0x72, 0xdb, 0x8d,
+	0x3e, 0x57, 0x13, 0x9c, 0xab, 0x05, 0xbf, 0xe0, 0x20, 0xa4, 0x6c, 0x15, 0x8a, 0x2d, 0x87, 0xe8,
+	0x0d, 0x97, 0xdc, 0xf7, 0x50, 0x17, 0xd4, 0x19, 0x26, 0xb8, 0x43, 0xee, 0xa7, 0x59, 0x99, 0xcc,
+	0x65, 0x65, 0x4c, 0x53, 0x4f, 0x83, 0x8b, 0x79, 0xf8, 0x85, 0xfc, 0xbc, 0x02, 0xd3, 0xd8, 0xa4,
+	0x0e, 0xc1, 0x01, 0x43, 0x99, 0x2b, 0xf8, 0x1b, 0x7b, 0x5e, 0x45, 0x6f, 0x26, 0x05, 0x35, 0x94,
+	0x4f, 0x05, 0x38, 0xef, 0xc5, 0xf0, 0x3b, 0x6d, 0x72, 0x48, 0x23, 0x5b, 0x88, 0xf3, 0x30, 0xe5,
+	0xc5, 0x22, 0x9f, 0x93, 0x49, 0xbe, 0x9e, 0x25, 0x97, 0xa1, 0x42, 0x6a, 0x19, 0xda, 0x81, 0x85,
+	0x0e, 0x3a, 0x69, 0x10, 0xdd, 0xc0, 0x8d, 0x0e, 0x31, 0xbb, 0x14, 0xbb, 0x3e, 0x29, 0x73, 0x1d,
+	0x74, 0x72, 0x5b, 0x37, 0xf0, 0xbe, 0x27, 0x8d, 0x42, 0x7a, 0x6a, 0x3c, 0x90, 0x7e, 0x05, 0x16,
+	0xe3, 0x83, 0x0d, 0x41, 0x7c, 0x0e, 0xa6, 0x5c, 0x2e, 0xf3, 0x31, 0x5c, 0xc9, 0x32, 0x85, 0xd7,
+	0x52, 0x7d, 0x45, 0xe5, 0x83, 0x02, 0x48, 0x37, 0x88, 0xa9, 0xb3, 0x28, 0x74, 0xdd, 0xb4, 0x3a,
+	0xc8, 0x20, 0x78, 0x94, 0x43, 0xcf, 0xc3, 0x07, 0x0f, 0x05, 0x66, 0x19, 0xb4, 0xae, 0x8d, 0xb1,
+	0xde, 0xe8, 0xd8, 0x6d, 0xdf, 0x8f, 0x4b, 0x1d, 0x74, 0x72, 0x87, 0xc9, 0xf6, 0xed, 0xf6, 0x67,
+	0x10, 0x3d, 0xbe, 0x0e, 0xab, 0x19, 0x30, 0x84, 0xc8, 0x7e, 0x01, 0x8a, 0x28, 0x10, 0xe7, 0x85,
+	0x90, 0x7e, 0xfd, 0x9e, 0xda, 0xaf, 0xa1, 0xfc, 0xba, 0x00, 0xe2, 0xeb, 0xc4, 0xa5, 0x89, 0x0d,
+	0xee, 0x00, 0xef, 0x7c, 0x78, 0x6c, 0x57, 0xa1, 0x68, 0xa3, 0x16, 0xee, 0xc7, 0x87, 0x59, 0x75,
+	0x86, 0x09, 0x78, 0x7c, 0x58, 0x07, 0xe0, 0x85, 0xd4, 0x3a, 0xc2, 0x26, 0xc7, 0xb5, 0xa8, 0x72,
+	0xf5, 0xbb, 0x4c, 0x20, 0x2e, 0xc2, 0x14, 0x87, 0xc3, 0x5d, 0x9e, 0xf2, 0x98, 0xf6, 0xbe, 0xa2,
+	0x5c, 0x4c, 0x8f, 0x6b, 0x47, 0x76, 0x36, 0x02, 0x56, 0xc8, 0xc1, 0xf3, 0x30, 0xc9, 0x97, 0x4e,
+	0x1f, 0xff, 0x8d, 0x41, 0xf8, 0xab, 0x58, 0xb3, 0x1c, 0x5d, 0xf5, 0x94, 0xd9, 0xae, 0xc4, 0xc4,
+	0x27, 0xb4, 0x11, 0x19, 0xba, 0x87, 0xe8, 0x2c, 0x13, 0x1f, 0x04, 0xc3, 0x57, 0xfe, 0x58, 0xe0,
+	0xeb, 0x47, 0x0d, 0x35, 0xdf, 0xa4, 0xc4, 0x20, 0xf7, 0x79, 0x98, 0x7a, 0x90, 0x89, 0x10, 0x89,
+	0x24, 0x1e, 0x59, 0x3c, 0x90, 0xf8, 0x64, 0x45, 0x4e, 0x8e, 0x8c, 0x2c, 0x5e, 0x34, 0xc2, 0x2a,
+	0x9a, 0x3e, 0xde, 0x4c, 0x3e, 0xfa, 0xf1, 0x66, 0x6c, 0x91, 0x7f, 0x35, 0x03, 0xcf, 0x90, 0xcd,
+	0x3d, 0x28, 0x75, 0xfb, 0x05, 0x3e, 0xa7, 0xca, 0x80, 0xed, 0x74, 0xb4, 0x89, 0x68, 0x35, 0x96,
+	0x0d, 0x58, 0xf6, 0xcf, 0xaf, 0x07, 0x88, 0x52, 0xec, 0x98, 0xee, 0xe7, 0x9c, 0x3d, 0x3c, 0x67,
+	0x6f, 0xc1, 0x4a, 0x0a, 0xcd, 0x90, 0xb1, 0xff, 0x87, 0x19, 0xdb, 0x97, 0xe6, 0x25, 0x6f, 0x62,
+	0xb5, 0xc3, 0x1a, 0xca, 0x27, 0x13, 0xb0, 0xbe, 0x87, 0x29, 0xd6, 0x28, 0xdf, 0x2c, 0x3f, 0xd4,
+	0x5a, 0xf3, 0xc0, 0x74, 0x6d, 0x42, 0xe9, 0x1e, 0x31, 0x75, 0xeb, 0x5e, 0x43, 0x47, 0x3d, 0xd7,
+	0x8f, 0x88, 0xe0, 0x89, 0xf6, 0x50, 0xcf, 0x15, 0xaf, 0xc1, 0x54, 0x07, 0xd3, 0xb6, 0xa5, 0xfb,
+	0x24, 0x65, 0x3a, 0xe0, 0x2e, 0x72, 0xb1, 0x41, 0x4c, 0xbc, 0xcf, 0x35, 0x55, 0xbf, 0x06, 0xdb,
+	0x5d, 0xd3, 0xb6, 0x83, 0xdd, 0xb6, 0x65, 0xe8, 0x9c, 0x20, 0x41, 0xed, 0x0b, 0x52, 0x9e, 0x32,
+	0x3d, 0x8a, 0xa7, 0xcc, 0x3c, 0xba, 0xa7, 0x14, 0xc7, 0xe3, 0x29, 0xef, 0xc0, 0x46, 0x36, 0x9b,
+	0xa1, 0xbb, 0xbc, 0x9a, 0x5e, 0x32, 0xb3, 0x93, 0x7d, 0xfd, 0x06, 0x62, 0x6b, 0xe6, 0xc7, 0x13,
+	0xb0, 0x78, 0xc3, 0x72, 0xb0, 0x86, 0x52, 0xeb, 0xe6, 0x40, 0x4f, 0x79, 0x06, 0xc4, 0x36, 0x71,
+	0xa9, 0xe5, 0xf4, 0x1a, 0x29, 0x8f, 0x59, 0xf0, 0x4b, 0xee, 0x84, 0x8e, 0xb3, 0x03, 0x81, 0xac,
+	0x91, 0x70, 0xa0, 0x39, 0x5f, 0xfe, 0x5a, 0x7f, 0xda, 0xb7, 0x2d, 0x87, 0xdc, 0xb7, 0xcc, 0xa8,
+	0x23, 0x95, 0x7c, 0xd9, 0xe8, 0x9e, 0x14, 0x8c, 0x27, 0xe1, 0x49, 0x4f, 0xc1, 0x82, 0xed, 0x60,
+	0x9d, 0x68, 0x2c, 0xa6, 0x35, 0x0c, 0x7c, 0x8c, 0x0d, 0xdf, 0xa1, 0xe6, 0xfb, 0xf2, 0xd7, 0x99,
+	0x78, 0x14, 0xb7, 0x8a, 0x38, 0xc3, 0xcc, 0xb8, 0x36, 0x4f, 0x4b, 0x09, 0xa6, 0x42, 0x2f, 0xb8,
+	0x0e, 0xc5, 0x43, 0xbf, 0x28, 0x37, 0x79, 0xe5, 0xa7, 0x7c, 0x83, 0x66, 0xd4, 0x7e, 0x2d, 0xb6,
+	0x45, 0x65, 0x2b, 0xc9, 0x01, 0x72, 0x5d, 0x6c, 0xb6, 0xb0, 0xe3, 0x25, 0xb3, 0x3e, 0xeb, 0x30,
+	0x3f, 0xfe, 0x2d, 0xea, 0xc7, 0x02, 0xac, 0x65, 0xe1, 0x10, 0x59, 0x52, 0x27, 0x0f, 0x0d, 0x8c,
+	0xa9, 0x9f, 0x2f, 0x2a, 0x67, 0x19, 0x14, 0xaf, 0xbd, 0x47, 0x5c, 0xea, 0x90, 0x66, 0x97, 0x2f,
+	0xac, 0x5e, 0x65, 0x71, 0x17, 0x4e, 0x69, 0xa8, 0xe9, 0xfa, 0x59, 0x8e, 0x07, 0x6d, 0x84, 0xd7,
+	0x55, 0x7e, 0x55, 0xe0, 0x97, 0x6b, 0x75, 0x6c, 0xea, 0x96, 0x73, 0x87, 0xa2, 0xc7, 0x4c, 0xd7,
+	0x28, 0x47, 0x8a, 0xff, 0xc9, 0xd3, 0xf1, 0x9b, 0xb0, 0x9c, 0x84, 0x29, 0x64, 0xf3, 0x65, 0x98,
+	0x3e, 0xe6, 0x05, 0xb9, 0x07, 0x8e, 0x68, 0xdd, 0x40, 0x5f, 0xf9, 0x87, 0x00, 0x4b, 0x9c, 0x9a,
+	0xb7, 0x2d, 0x13, 0x27, 0x62, 0xe7, 0x39, 0x98, 0xbc, 0x6f, 0x99, 0xe1, 0x6d, 0x88, 0xf7, 0x11,
+	0x25, 0xa5, 0x90, 0x43, 0xca, 0x44, 0x1e, 0x29, 0xa7, 0xe2, 0xa4, 0x8c, 0x7f, 0x82, 0xbc, 0x09,
+	0xcb, 0xc9, 0x51, 0x47, 0xd0, 0x9c, 0x8a, 0x65, 0xd0, 0x33, 0xd7, 0xd8, 0xa0, 0x22, 0x6f, 0x45,
+	0xf5, 0x2b, 0x28, 0x7f, 0x17, 0x60, 0x83, 0xed, 0x8a, 0xd0, 0x09, 0x89, 0x29, 0xc4, 0x6f, 0x2a,
+	0x0c, 0x4b, 0xe3, 0x5b, 0xd2, 0x88, 0x63, 0x97, 0x02, 0xd9, 0xa3, 0x79, 0xf7, 0xf8, 0x33, 0x0c,
+	0xef, 0xc0, 0xe6, 0x80, 0x01, 0x47, 0x0e, 0xc4, 0x71, 0x3c, 0x2f, 0x65, 0x6e, 0x05, 0x93, 0x2d,
+	0x84, 0x98, 0xfe, 0x55, 0x08, 0x4e, 0x07, 0xac, 0xbc, 0x66, 0x1d, 0x63, 0x07, 0xb5, 0xf0, 0x63,
+	0x0d, 0x12, 0xe3, 0x87, 0xb1, 0x01, 0x6b, 0x59, 0x63, 0x0c, 0x31, 0xfc, 0x22, 0xcc, 0x68, 0xbe,
+	0x74, 0xc8, 0xd2, 0x18, 0x6b, 0x20, 0xac, 0xa4, 0xfc, 0xa1, 0x10, 0xa4, 0x82, 0x54, 0x7c, 0x8c,
+	0xcd, 0x2e, 0xfe, 0xfc, 0xec, 0xf3, 0x28, 0xf9, 0xe3, 0xa5, 0x04, 0x96, 0x21, 0x51, 0x2f, 0xc1,
+	0xb4, 0xe3, 0x09, 0xf3, 0x72, 0x0f, 0x91, 0xaa, 0x81, 0xba, 0xf2, 0x6f, 0x01, 0xce, 0xb1, 0xa9,
+	0x44, 0x6c, 0x15, 0x51, 0xfc, 0x04, 0x6e, 0x5a, 0xde, 0x83, 0xf3, 0xb1, 0xf1, 0x47, 0x72, 0x95,
+	0xb1, 0xcd, 0x4a, 0xa6, 0x21, 0x7e, 0xb5, 0x60, 0x67, 0x52, 0x89, 0xed, 0x4c, 0x72, 0x6b, 0x78,
+	0xdb, 0x90, 0xef, 0x7b, 0x39, 0x9d, 0x03, 0xd4, 0xeb, 0x60, 0x93, 0xde, 0xed, 0xd9, 0x78, 0x9f,
+	0x9c, 0x3c, 0x79, 0x1c, 0xfc, 0x44, 0x80, 0xd5, 0x0c, 0x18, 0x22, 0xee, 0x1d, 0xa3, 0x42, 0xc9,
+	0xde, 0xf2, 0xc5, 0x2a, 0xfb, 0x8c, 0xbc, 0x18, 0x63, 0x64, 0x94, 0x8a, 0x5c, 0xbf, 0xfa, 0xdb,
+	0x15, 0x98, 0xfb, 0xea, 0x5b, 0x2c, 0x85, 0x1d, 0x3c, 0x2e, 0x13, 0xbf, 0x1d, 0x3c, 0x05, 0x88,
+	0x3e, 0xfe, 0x38, 0xc0, 0xce, 0x1e, 0xea, 0xd5, 0xab, 0xa2, 0x12, 0xb9, 0x6c, 0x1b, 0xf0, 0x6a,
+	0x4b, 0xda, 0xca, 0xd1, 0x09, 0xc6, 0xaa, 0x2c, 0xbd, 0xff, 0xa7, 0xbf, 0xfd, 0xac, 0x70, 0x46,
+	0x39, 0x5d, 0x39, 0xae, 0x56, 0x34, 0xd4, 0xe4, 0x49, 0xc2, 0x6b, 0xc2, 0x15, 0xd1, 0x86, 0xd3,
+	0xfd, 0xf7, 0x43, 0xf5, 0xaa, 0xb8, 0x11, 0xbd, 0xde, 0x4b, 0xbf, 0x63, 0x92, 0x36, 0x07, 0x94,
+	0x87, 0x3d, 0x6d, 0xf2, 0x9e, 0x56, 0xc4, 0xa5, 0x68, 0x4f, 0x15, 0xfe, 0x0a, 0x89, 0xfb, 0x86,
+	0xf8, 0x0b, 0x21, 0xcc, 0x5d, 0x25, 0x1e, 0xce, 0xd4, 0xab, 0xe2, 0x95, 0xf8, 0x60, 0xf2, 0x5e,
+	0x26, 0x49, 0x4f, 0x8f, 0xa0, 0x1b, 0x9a, 0x75, 0x91, 0x9b, 0xb5, 0xa1, 0xac, 0xc4, 0xcc, 0x6a,
+	0xf6, 0xbc, 0xcb, 0x0f, 0xe6, 0xd3, 0x0c, 0x8d, 0xdf, 0xf8, 0x7b, 0x9e, 0x41, 0xef, 0x42, 0xea,
+	0x55, 0xf1, 0x6a, 0x4e, 0xaf, 0x99, 0x0f, 0x72, 0xa4, 0xe7, 0x46, 0xae, 0x11, 0x5a, 0x7b, 0x99,
+	0x5b, 0x7b, 0x41, 0x59, 0x63, 0xd6, 0x7a, 0x37, 0xf0, 0xd9, 0x06, 0x7f, 0x28, 0xc0, 0x7a, 0xf2,
+	0x35, 0x45, 0xdc, 0x85, 0x2e, 0xa5, 0xdc, 0x23, 0xeb, 0xd1, 0x89, 0xb4, 0x9d, 0xaf, 0x16, 0x5a,
+	0x26, 0x71, 0xcb, 0xce, 0x29, 0xf3, 0x09, 0xcb, 0x98, 0x31, 0x1f, 0x84, 0xf7, 0x51, 0xb1, 0x5b,
+	0xff, 0x7a, 0x55, 0xbc, 0x1c, 0x6f, 0x7d, 0xe0, 0x4b, 0x0c, 0x69, 0x67, 0x98, 0x62, 0x68, 0xc8,
+	0x0a, 0x37, 0xe4, 0xac, 0x32, 0xe7, 0x13, 0xea, 0xd9, 0xc2, 0xed, 0xf8, 0xae, 0x00, 0x62, 0xf2,
+	0x6a, 0xba, 0x5e, 0x15, 0x2f, 0xe6, 0xde, 0x5c, 0x07, 0x16, 0x5c, 0x1a, 0xe9, 0x7e, 0x5b, 0xd9,
+	0xe0, 0xdd, 0x2f, 0x2b, 0x67, 0x63, 0xfe, 0x44, 0x4c, 0xe4, 0x60, 0xc4, 0x6c, 0x78, 0x5f, 0x00,
+	0x31, 0x79, 0x2f, 0x9b, 0xb0, 0x61, 0xe0, 0x05, 0xb6, 0x74, 0x29, 0x57, 0x2b, 0x39, 0xd5, 0x94,
+	0x73, 0x31, 0x1b, 0xda, 0x9e, 0x1e, 0x33, 0xe2, 0x97, 0xde, 0xd1, 0x79, 0xc0, 0x35, 0x64, 0xbd,
+	0x2a, 0x96, 0xe3, 0x1d, 0x0d, 0xbb, 0xef, 0x95, 0x2a, 0x23, 0xea, 0x87, 0x26, 0xca, 0xdc, 0x44,
+	0x49, 0x39, 0x1f, 0x33, 0xd1, 0xd2, 0x3b, 0x5c, 0x91, 0xd9, 0x68, 0xc1, 0x5c, 0xf4, 0x5a, 0xaf,
+	0x5e, 0x15, 0xe5, 0x94, 0x0f, 0x24, 0xae, 0x37, 0xa5, 0x0b, 0x03, 0x35, 0xc2, 0x8e, 0x97, 0x79,
+	0xc7, 0xa2, 0x32, 0xeb, 0x77, 0xec, 0xdd, 0xfb, 0xb1, 0x0e, 0x7f, 0x28, 0xc0, 0xd9, 0xd4, 0x9d,
+	0x57, 0x62, 0xa2, 0x0c, 0xbe, 0x1a, 0x94, 0xb6, 0xf3, 0xd5, 0x42, 0x03, 0x2e, 0x70, 0x03, 0x56,
+	0x95, 0xc5, 0xd8, 0xc8, 0xc3, 0x3c, 0x1f, 0xb3, 0xe4, 0x5d, 0x28, 0x85, 0x17, 0x3e, 0xec, 0x32,
+	0x3d, 0xd2, 0x72, 0xfa, 0xd6, 0x4c, 0xda, 0xc8, 0x2e, 0x0e, 0x3b, 0x5c, 0xe3, 0x1d, 0x2e, 0x2a,
+	0x67, 0x62, 0x1d, 0x1a, 0xc4, 0xa5, 0xac, 0xaf, 0xef, 0x09, 0x70, 0x36, 0x75, 0x2f, 0x91, 0x0e,
+	0x0f, 0x03, 0xee, 0x81, 0xa4, 0xed, 0x7c, 0xb5, 0xd0, 0x88, 0x75, 0x6e, 0xc4, 0x92, 0x22, 0xfa,
+	0x46, 0x44, 0x2e, 0x2d, 0x98, 0x15, 0xdf, 0x11, 0xe0, 0x4c, 0x22, 0xd3, 0xce, 0x5e, 0x2c, 0xa6,
+	0x03, 0x64, 0xea, 0x56, 0x43, 0xba, 0x98, 0xa7, 0x34, 0xc4, 0xdf, 0x82, 0x6c, 0x3c, 0x33, 0xe1,
+	0xe7, 0x02, 0x2c, 0x66, 0xa5, 0x70, 0xeb, 0x55, 0x31, 0x1a, 0x7c, 0x72, 0x73, 0xf6, 0xd2, 0x53,
+	0x43, 0x35, 0x43, 0x8b, 0xb6, 0xb9, 0x45, 0xb2, 0xb2, 0x1a, 0x5f, 0x0f, 0x99, 0x7a, 0xcc, 0x19,
+	0xee, 0xc3, 0x7c, 0x2c, 0x99, 0xc8, 0x8e, 0xd7, 0x51, 0x57, 0xcb, 0x4c, 0x09, 0x4b, 0xca, 0x60,
+	0x95, 0x21, 0x98, 0x04, 0x89, 0x46, 0xd6, 0xf7, 0x8f, 0xbd, 0xed, 0x7a, 0x22, 0xc5, 0x56, 0xaf,
+	0x8a, 0x09, 0xda, 0x07, 0xe5, 0x22, 0xa5, 0xcb, 0x43, 0xf4, 0x42, 0x5b, 0x14, 0x6e, 0xcb, 0x9a,
+	0xb2, 0x94, 0xe0, 0xc7, 0xd7, 0xe7, 0x48, 0x7c, 0x0b, 0x16, 0xe2, 0xd9, 0xa1, 0xf4, 0x46, 0x28,
+	0x2b, 0xc3, 0x26, 0x6d, 0xe5, 0xe8, 0x0c, 0x01, 0xa3, 0xd9, 0xf3, 0x72, 0x48, 0xac, 0xfb, 0xf7,
+	0x60, 0x21, 0x9e, 0x4e, 0x49, 0x74, 0x3f, 0x20, 0xc3, 0x24, 0x6d, 0xe5, 0xe8, 0x0c, 0x59, 0x36,
+	0x9a, 0x3d, 0x96, 0x8e, 0x62, 0x9d, 0xff, 0xd4, 0x7b, 0x9e, 0x9f, 0xce, 0x41, 0xd4, 0xab, 0xe2,
+	0x53, 0x89, 0x19, 0x30, 0x38, 0x31, 0x23, 0x5d, 0x19, 0xae, 0x9a, 0x6d, 0x12, 0x45, 0x27, 0x2c,
+	0xb5, 0x8f, 0xdd, 0x4a, 0xb8, 0xaa, 0x7f, 0xe8, 0x39, 0x47, 0xe2, 0x38, 0x9e, 0x76, 0x8e, 0x41,
+	0x49, 0x0d, 0xe9, 0xf2, 0x10, 0xbd, 0xec, 0x90, 0xd9, 0xb7, 0x24, 0x38, 0xf7, 0x33, 0x63, 0x7a,
+	0x30, 0x1f, 0x3b, 0xad, 0x26, 0x66, 0x49, 0x76, 0x56, 0x40, 0x52, 0x06, 0xab, 0x0c, 0x59, 0x4c,
+	0xfd, 0x03, 0xad, 0xb7, 0x53, 0x9e, 0x8d, 0x1c, 0xe9, 0xd8, 0x93, 0xfe, 0x04, 0xc8, 0xc9, 0xc3,
+	0xae, 0x24, 0x0f, 0x52, 0x18, 0x12, 0xb3, 0xa9, 0x8f, 0xfc, 0x0f, 0xbc, 0x98, 0x1d, 0x3f, 0x4a,
+	0xa4, 0x63, 0xf6, 0x80, 0x73, 0x9e, 0xb4, 0x9d, 0xaf, 0x36, 0x34, 0x66, 0x72, 0x75, 0x66, 0xc8,
+	0xee, 0x7f, 0x0a, 0x1f, 0x5d, 0xff, 0x4b, 0x41, 0xec, 0xb2, 0xd3, 0x8b, 0x5c, 0x43, 0x4d, 0xd9,
+	0xff, 0x71, 0x8c, 0xf2, 0x0d, 0x58, 0xf4, 0x25, 0xfe, 0x89, 0x46, 0xb6, 0x1d, 0x8b, 0x1d, 0x80,
+	0x44, 0xa5, 0x4d, 0xa9, 0xed, 0x5e, 0xab, 0x54, 0x5a, 0x84, 0xb6, 0xbb, 0xcd, 0xb2, 0x66, 0x75,
+	0x2a, 0x1d, 0xd3, 0x3a, 0x26, 0x1a, 0xb1, 0x2a, 0x66, 0x8f, 0x3d, 0x28, 0x94, 0xe4, 0x0e, 0xd1,
+	0xda, 0x08, 0x1b, 0x65, 0x64, 0xb6, 0xb0, 0x61, 0x95, 0xfd, 0xe2, 0x2f, 0xb5, 0x3a, 0x88, 0x18,
+	0xac, 0x46, 0x75, 0xa2, 0x5a, 0xbe, 0x7a, 0x45, 0x10, 0xaa, 0x0b, 0xc8, 0xb6, 0x0d, 0xe2, 0x65,
+	0x15, 0x2b, 0xef, 0xba, 0x96, 0x79, 0x2d, 0x25, 0x51, 0x4d, 0x98, 0x78, 0xfe, 0xea, 0x55, 0xb1,
+	0x05, 0x58, 0xc5, 0xb4, 0xeb, 0x98, 0x58, 0x97, 0xef, 0xb5, 0xb1, 0x29, 0xd3, 0x36, 0x96, 0x1d,
+	0x0f, 0x14, 0x99, 0xb8, 0x32, 0x31, 0x8f, 0x91, 0x41, 0xf4, 0x67, 0x64, 0x1d, 0x53, 0x44, 0x0c,
+	0x57, 0x66, 0xd7, 0x96, 0x32, 0xf2, 0x7f, 0xb5, 0xc3, 0xc1, 0xda, 0x45, 0xba, 0x8f, 0xa2, 0x7c,
+	0x8f, 0xd0, 0x36, 0x6f, 0x81, 0x1f, 0x2b, 0xe5, 0x63, 0x62, 0x19, 0xfe, 0x8f, 0x73, 0xd4, 0x2f,
+	0xc3, 0xc4, 0xf3, 0xcf, 0x55, 0xc5, 0x1a, 0x5c, 0x4f, 0xf7, 0xc7, 0xf0, 0xc1, 0x0e, 0xeb, 0xce,
+	0xb4, 0xa8, 0xac, 0x59, 0xe6, 0x21, 0x69, 0x75, 0x1d, 0xac, 0xcb, 0xd4, 0x92, 0xdb, 0xc8, 0xd4,
+	0x0d, 0x1c, 0x35, 0xab, 0xfc, 0xf6, 0x66, 0x00, 0x0d, 0xc7, 0x29, 0xf1, 0x53, 0x1e, 0xc7, 0xd6,
+	0x9a, 0x53, 0xfc, 0xeb, 0xff, 0xfe, 0x3b, 0x00, 0x05, 0x32, 0x6e, 0x8d, 0x97, 0x34, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NYCabServiceV2Client is the client API for NYCabServiceV2 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NYCabServiceV2Client interface {
+	GetAllCabTripCountPerDayV2(ctx context.Context, in *GetAllCabTripsRequestV2, opts ...grpc.CallOption) (*GetAllCabTripsResponseV2, error)
+	ClearCacheV2(ctx context.Context, in *ClearCacheRequestV2, opts ...grpc.CallOption) (*ClearCacheResponseV2, error)
+	GetTripCountsForCabIDsV2(ctx context.Context, in *GetTripCountsForCabIDsRequestV2, opts ...grpc.CallOption) (*GetTripCountsForCabIDsResponseV2, error)
+	GetTripCountsForHackLicensesV2(ctx context.Context, in *GetTripCountsForHackLicensesRequestV2, opts ...grpc.CallOption) (*GetTripCountsForHackLicensesResponseV2, error)
+	GetAllDriverTripCountPerDayV2(ctx context.Context, in *GetAllDriverTripsRequestV2, opts ...grpc.CallOption) (*GetAllDriverTripsResponseV2, error)
+	GetCabDriverMappingV2(ctx context.Context, in *GetCabDriverMappingRequestV2, opts ...grpc.CallOption) (*GetCabDriverMappingResponseV2, error)
+	CountTripsInAreaV2(ctx context.Context, in *CountTripsInAreaRequestV2, opts ...grpc.CallOption) (*CountTripsInAreaResponseV2, error)
+	GetPickupHeatmapV2(ctx context.Context, in *GetPickupHeatmapRequestV2, opts ...grpc.CallOption) (*GetPickupHeatmapResponseV2, error)
+	GetOriginDestinationMatrixV2(ctx context.Context, in *GetOriginDestinationMatrixRequestV2, opts ...grpc.CallOption) (*GetOriginDestinationMatrixResponseV2, error)
+	GetCabShiftsV2(ctx context.Context, in *GetCabShiftsRequestV2, opts ...grpc.CallOption) (*GetCabShiftsResponseV2, error)
+	FindTripAnomaliesV2(ctx context.Context, in *FindTripAnomaliesRequestV2, opts ...grpc.CallOption) (*FindTripAnomaliesResponseV2, error)
+	ListTripsV2(ctx context.Context, in *ListTripsRequestV2, opts ...grpc.CallOption) (*ListTripsResponseV2, error)
+	GetCabUtilizationV2(ctx context.Context, in *GetCabUtilizationRequestV2, opts ...grpc.CallOption) (*GetCabUtilizationResponseV2, error)
+	GetTripPatternsV2(ctx context.Context, in *GetTripPatternsRequestV2, opts ...grpc.CallOption) (*GetTripPatternsResponseV2, error)
+	DetectCountAnomaliesV2(ctx context.Context, in *DetectCountAnomaliesRequestV2, opts ...grpc.CallOption) (*DetectCountAnomaliesResponseV2, error)
+	ForecastTripsV2(ctx context.Context, in *ForecastTripsRequestV2, opts ...grpc.CallOption) (*ForecastTripsResponseV2, error)
+	GetPassengerCountsV2(ctx context.Context, in *GetPassengerCountsRequestV2, opts ...grpc.CallOption) (*GetPassengerCountsResponseV2, error)
+	GetVendorStatsV2(ctx context.Context, in *GetVendorStatsRequestV2, opts ...grpc.CallOption) (*GetVendorStatsResponseV2, error)
+	CountZoneTripsV2(ctx context.Context, in *CountZoneTripsRequestV2, opts ...grpc.CallOption) (*CountZoneTripsResponseV2, error)
+	GetTaxiZoneTripCountsV2(ctx context.Context, in *GetTaxiZoneTripCountsRequestV2, opts ...grpc.CallOption) (*GetTaxiZoneTripCountsResponseV2, error)
+	GetCabZoneCoverageV2(ctx context.Context, in *GetCabZoneCoverageRequestV2, opts ...grpc.CallOption) (*GetCabZoneCoverageResponseV2, error)
+	GetCabRevenueV2(ctx context.Context, in *GetCabRevenueRequestV2, opts ...grpc.CallOption) (*GetCabRevenueResponseV2, error)
+	GetTipRatesV2(ctx context.Context, in *GetTipRatesRequestV2, opts ...grpc.CallOption) (*GetTipRatesResponseV2, error)
+	GetPaymentTypeMixV2(ctx context.Context, in *GetPaymentTypeMixRequestV2, opts ...grpc.CallOption) (*GetPaymentTypeMixResponseV2, error)
+}
+
+type nYCabServiceV2Client struct {
+	cc *grpc.ClientConn
+}
+
+func NewNYCabServiceV2Client(cc *grpc.ClientConn) NYCabServiceV2Client {
+	return &nYCabServiceV2Client{cc}
+}
+
+func (c *nYCabServiceV2Client) GetAllCabTripCountPerDayV2(ctx context.Context, in *GetAllCabTripsRequestV2, opts ...grpc.CallOption) (*GetAllCabTripsResponseV2, error) {
+	out := new(GetAllCabTripsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetAllCabTripCountPerDayV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) ClearCacheV2(ctx context.Context, in *ClearCacheRequestV2, opts ...grpc.CallOption) (*ClearCacheResponseV2, error) {
+	out := new(ClearCacheResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/ClearCacheV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetTripCountsForCabIDsV2(ctx context.Context, in *GetTripCountsForCabIDsRequestV2, opts ...grpc.CallOption) (*GetTripCountsForCabIDsResponseV2, error) {
+	out := new(GetTripCountsForCabIDsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetTripCountsForCabIDsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetTripCountsForHackLicensesV2(ctx context.Context, in *GetTripCountsForHackLicensesRequestV2, opts ...grpc.CallOption) (*GetTripCountsForHackLicensesResponseV2, error) {
+	out := new(GetTripCountsForHackLicensesResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetTripCountsForHackLicensesV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetAllDriverTripCountPerDayV2(ctx context.Context, in *GetAllDriverTripsRequestV2, opts ...grpc.CallOption) (*GetAllDriverTripsResponseV2, error) {
+	out := new(GetAllDriverTripsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetAllDriverTripCountPerDayV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetCabDriverMappingV2(ctx context.Context, in *GetCabDriverMappingRequestV2, opts ...grpc.CallOption) (*GetCabDriverMappingResponseV2, error) {
+	out := new(GetCabDriverMappingResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetCabDriverMappingV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) CountTripsInAreaV2(ctx context.Context, in *CountTripsInAreaRequestV2, opts ...grpc.CallOption) (*CountTripsInAreaResponseV2, error) {
+	out := new(CountTripsInAreaResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/CountTripsInAreaV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetPickupHeatmapV2(ctx context.Context, in *GetPickupHeatmapRequestV2, opts ...grpc.CallOption) (*GetPickupHeatmapResponseV2, error) {
+	out := new(GetPickupHeatmapResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetPickupHeatmapV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetOriginDestinationMatrixV2(ctx context.Context, in *GetOriginDestinationMatrixRequestV2, opts ...grpc.CallOption) (*GetOriginDestinationMatrixResponseV2, error) {
+	out := new(GetOriginDestinationMatrixResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetOriginDestinationMatrixV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetCabShiftsV2(ctx context.Context, in *GetCabShiftsRequestV2, opts ...grpc.CallOption) (*GetCabShiftsResponseV2, error) {
+	out := new(GetCabShiftsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetCabShiftsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) FindTripAnomaliesV2(ctx context.Context, in *FindTripAnomaliesRequestV2, opts ...grpc.CallOption) (*FindTripAnomaliesResponseV2, error) {
+	out := new(FindTripAnomaliesResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/FindTripAnomaliesV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) ListTripsV2(ctx context.Context, in *ListTripsRequestV2, opts ...grpc.CallOption) (*ListTripsResponseV2, error) {
+	out := new(ListTripsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/ListTripsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetCabUtilizationV2(ctx context.Context, in *GetCabUtilizationRequestV2, opts ...grpc.CallOption) (*GetCabUtilizationResponseV2, error) {
+	out := new(GetCabUtilizationResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetCabUtilizationV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetTripPatternsV2(ctx context.Context, in *GetTripPatternsRequestV2, opts ...grpc.CallOption) (*GetTripPatternsResponseV2, error) {
+	out := new(GetTripPatternsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetTripPatternsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) DetectCountAnomaliesV2(ctx context.Context, in *DetectCountAnomaliesRequestV2, opts ...grpc.CallOption) (*DetectCountAnomaliesResponseV2, error) {
+	out := new(DetectCountAnomaliesResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/DetectCountAnomaliesV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) ForecastTripsV2(ctx context.Context, in *ForecastTripsRequestV2, opts ...grpc.CallOption) (*ForecastTripsResponseV2, error) {
+	out := new(ForecastTripsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/ForecastTripsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetPassengerCountsV2(ctx context.Context, in *GetPassengerCountsRequestV2, opts ...grpc.CallOption) (*GetPassengerCountsResponseV2, error) {
+	out := new(GetPassengerCountsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetPassengerCountsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetVendorStatsV2(ctx context.Context, in *GetVendorStatsRequestV2, opts ...grpc.CallOption) (*GetVendorStatsResponseV2, error) {
+	out := new(GetVendorStatsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetVendorStatsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) CountZoneTripsV2(ctx context.Context, in *CountZoneTripsRequestV2, opts ...grpc.CallOption) (*CountZoneTripsResponseV2, error) {
+	out := new(CountZoneTripsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/CountZoneTripsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetTaxiZoneTripCountsV2(ctx context.Context, in *GetTaxiZoneTripCountsRequestV2, opts ...grpc.CallOption) (*GetTaxiZoneTripCountsResponseV2, error) {
+	out := new(GetTaxiZoneTripCountsResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetTaxiZoneTripCountsV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetCabZoneCoverageV2(ctx context.Context, in *GetCabZoneCoverageRequestV2, opts ...grpc.CallOption) (*GetCabZoneCoverageResponseV2, error) {
+	out := new(GetCabZoneCoverageResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetCabZoneCoverageV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetCabRevenueV2(ctx context.Context, in *GetCabRevenueRequestV2, opts ...grpc.CallOption) (*GetCabRevenueResponseV2, error) {
+	out := new(GetCabRevenueResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetCabRevenueV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetTipRatesV2(ctx context.Context, in *GetTipRatesRequestV2, opts ...grpc.CallOption) (*GetTipRatesResponseV2, error) {
+	out := new(GetTipRatesResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetTipRatesV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nYCabServiceV2Client) GetPaymentTypeMixV2(ctx context.Context, in *GetPaymentTypeMixRequestV2, opts ...grpc.CallOption) (*GetPaymentTypeMixResponseV2, error) {
+	out := new(GetPaymentTypeMixResponseV2)
+	err := c.cc.Invoke(ctx, "/nycab.rpc.NYCabServiceV2/GetPaymentTypeMixV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NYCabServiceV2Server is the server API for NYCabServiceV2 service.
+type NYCabServiceV2Server interface {
+	GetAllCabTripCountPerDayV2(context.Context, *GetAllCabTripsRequestV2) (*GetAllCabTripsResponseV2, error)
+	ClearCacheV2(context.Context, *ClearCacheRequestV2) (*ClearCacheResponseV2, error)
+	GetTripCountsForCabIDsV2(context.Context, *GetTripCountsForCabIDsRequestV2) (*GetTripCountsForCabIDsResponseV2, error)
+	GetTripCountsForHackLicensesV2(context.Context, *GetTripCountsForHackLicensesRequestV2) (*GetTripCountsForHackLicensesResponseV2, error)
+	GetAllDriverTripCountPerDayV2(context.Context, *GetAllDriverTripsRequestV2) (*GetAllDriverTripsResponseV2, error)
+	GetCabDriverMappingV2(context.Context, *GetCabDriverMappingRequestV2) (*GetCabDriverMappingResponseV2, error)
+	CountTripsInAreaV2(context.Context, *CountTripsInAreaRequestV2) (*CountTripsInAreaResponseV2, error)
+	GetPickupHeatmapV2(context.Context, *GetPickupHeatmapRequestV2) (*GetPickupHeatmapResponseV2, error)
+	GetOriginDestinationMatrixV2(context.Context, *GetOriginDestinationMatrixRequestV2) (*GetOriginDestinationMatrixResponseV2, error)
+	GetCabShiftsV2(context.Context, *GetCabShiftsRequestV2) (*GetCabShiftsResponseV2, error)
+	FindTripAnomaliesV2(context.Context, *FindTripAnomaliesRequestV2) (*FindTripAnomaliesResponseV2, error)
+	ListTripsV2(context.Context, *ListTripsRequestV2) (*ListTripsResponseV2, error)
+	GetCabUtilizationV2(context.Context, *GetCabUtilizationRequestV2) (*GetCabUtilizationResponseV2, error)
+	GetTripPatternsV2(context.Context, *GetTripPatternsRequestV2) (*GetTripPatternsResponseV2, error)
+	DetectCountAnomaliesV2(context.Context, *DetectCountAnomaliesRequestV2) (*DetectCountAnomaliesResponseV2, error)
+	ForecastTripsV2(context.Context, *ForecastTripsRequestV2) (*ForecastTripsResponseV2, error)
+	GetPassengerCountsV2(context.Context, *GetPassengerCountsRequestV2) (*GetPassengerCountsResponseV2, error)
+	GetVendorStatsV2(context.Context, *GetVendorStatsRequestV2) (*GetVendorStatsResponseV2, error)
+	CountZoneTripsV2(context.Context, *CountZoneTripsRequestV2) (*CountZoneTripsResponseV2, error)
+	GetTaxiZoneTripCountsV2(context.Context, *GetTaxiZoneTripCountsRequestV2) (*GetTaxiZoneTripCountsResponseV2, error)
+	GetCabZoneCoverageV2(context.Context, *GetCabZoneCoverageRequestV2) (*GetCabZoneCoverageResponseV2, error)
+	GetCabRevenueV2(context.Context, *GetCabRevenueRequestV2) (*GetCabRevenueResponseV2, error)
+	GetTipRatesV2(context.Context, *GetTipRatesRequestV2) (*GetTipRatesResponseV2, error)
+	GetPaymentTypeMixV2(context.Context, *GetPaymentTypeMixRequestV2) (*GetPaymentTypeMixResponseV2, error)
+}
+
+// UnimplementedNYCabServiceV2Server can be embedded to have forward compatible implementations.
+type UnimplementedNYCabServiceV2Server struct {
+}
+
+func (*UnimplementedNYCabServiceV2Server) GetAllCabTripCountPerDayV2(ctx context.Context, req *GetAllCabTripsRequestV2) (*GetAllCabTripsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCabTripCountPerDayV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) ClearCacheV2(ctx context.Context, req *ClearCacheRequestV2) (*ClearCacheResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCacheV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetTripCountsForCabIDsV2(ctx context.Context, req *GetTripCountsForCabIDsRequestV2) (*GetTripCountsForCabIDsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripCountsForCabIDsV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetTripCountsForHackLicensesV2(ctx context.Context, req *GetTripCountsForHackLicensesRequestV2) (*GetTripCountsForHackLicensesResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripCountsForHackLicensesV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetAllDriverTripCountPerDayV2(ctx context.Context, req *GetAllDriverTripsRequestV2) (*GetAllDriverTripsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllDriverTripCountPerDayV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetCabDriverMappingV2(ctx context.Context, req *GetCabDriverMappingRequestV2) (*GetCabDriverMappingResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCabDriverMappingV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) CountTripsInAreaV2(ctx context.Context, req *CountTripsInAreaRequestV2) (*CountTripsInAreaResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountTripsInAreaV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetPickupHeatmapV2(ctx context.Context, req *GetPickupHeatmapRequestV2) (*GetPickupHeatmapResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickupHeatmapV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetOriginDestinationMatrixV2(ctx context.Context, req *GetOriginDestinationMatrixRequestV2) (*GetOriginDestinationMatrixResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOriginDestinationMatrixV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetCabShiftsV2(ctx context.Context, req *GetCabShiftsRequestV2) (*GetCabShiftsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCabShiftsV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) FindTripAnomaliesV2(ctx context.Context, req *FindTripAnomaliesRequestV2) (*FindTripAnomaliesResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindTripAnomaliesV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) ListTripsV2(ctx context.Context, req *ListTripsRequestV2) (*ListTripsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTripsV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetCabUtilizationV2(ctx context.Context, req *GetCabUtilizationRequestV2) (*GetCabUtilizationResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCabUtilizationV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetTripPatternsV2(ctx context.Context, req *GetTripPatternsRequestV2) (*GetTripPatternsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripPatternsV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) DetectCountAnomaliesV2(ctx context.Context, req *DetectCountAnomaliesRequestV2) (*DetectCountAnomaliesResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectCountAnomaliesV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) ForecastTripsV2(ctx context.Context, req *ForecastTripsRequestV2) (*ForecastTripsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForecastTripsV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetPassengerCountsV2(ctx context.Context, req *GetPassengerCountsRequestV2) (*GetPassengerCountsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPassengerCountsV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetVendorStatsV2(ctx context.Context, req *GetVendorStatsRequestV2) (*GetVendorStatsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVendorStatsV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) CountZoneTripsV2(ctx context.Context, req *CountZoneTripsRequestV2) (*CountZoneTripsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountZoneTripsV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetTaxiZoneTripCountsV2(ctx context.Context, req *GetTaxiZoneTripCountsRequestV2) (*GetTaxiZoneTripCountsResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaxiZoneTripCountsV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetCabZoneCoverageV2(ctx context.Context, req *GetCabZoneCoverageRequestV2) (*GetCabZoneCoverageResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCabZoneCoverageV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetCabRevenueV2(ctx context.Context, req *GetCabRevenueRequestV2) (*GetCabRevenueResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCabRevenueV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetTipRatesV2(ctx context.Context, req *GetTipRatesRequestV2) (*GetTipRatesResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTipRatesV2 not implemented")
+}
+func (*UnimplementedNYCabServiceV2Server) GetPaymentTypeMixV2(ctx context.Context, req *GetPaymentTypeMixRequestV2) (*GetPaymentTypeMixResponseV2, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentTypeMixV2 not implemented")
+}
+
+func RegisterNYCabServiceV2Server(s *grpc.Server, srv NYCabServiceV2Server) {
+	s.RegisterService(&_NYCabServiceV2_serviceDesc, srv)
+}
+
+func _NYCabServiceV2_GetAllCabTripCountPerDayV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCabTripsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetAllCabTripCountPerDayV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetAllCabTripCountPerDayV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetAllCabTripCountPerDayV2(ctx, req.(*GetAllCabTripsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_ClearCacheV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCacheRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).ClearCacheV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/ClearCacheV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).ClearCacheV2(ctx, req.(*ClearCacheRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetTripCountsForCabIDsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripCountsForCabIDsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetTripCountsForCabIDsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetTripCountsForCabIDsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetTripCountsForCabIDsV2(ctx, req.(*GetTripCountsForCabIDsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetTripCountsForHackLicensesV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripCountsForHackLicensesRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetTripCountsForHackLicensesV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetTripCountsForHackLicensesV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetTripCountsForHackLicensesV2(ctx, req.(*GetTripCountsForHackLicensesRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetAllDriverTripCountPerDayV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllDriverTripsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetAllDriverTripCountPerDayV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetAllDriverTripCountPerDayV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetAllDriverTripCountPerDayV2(ctx, req.(*GetAllDriverTripsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetCabDriverMappingV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCabDriverMappingRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetCabDriverMappingV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetCabDriverMappingV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetCabDriverMappingV2(ctx, req.(*GetCabDriverMappingRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_CountTripsInAreaV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountTripsInAreaRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).CountTripsInAreaV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/CountTripsInAreaV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).CountTripsInAreaV2(ctx, req.(*CountTripsInAreaRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetPickupHeatmapV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPickupHeatmapRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetPickupHeatmapV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetPickupHeatmapV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetPickupHeatmapV2(ctx, req.(*GetPickupHeatmapRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetOriginDestinationMatrixV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOriginDestinationMatrixRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetOriginDestinationMatrixV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetOriginDestinationMatrixV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetOriginDestinationMatrixV2(ctx, req.(*GetOriginDestinationMatrixRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetCabShiftsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCabShiftsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetCabShiftsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetCabShiftsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetCabShiftsV2(ctx, req.(*GetCabShiftsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_FindTripAnomaliesV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindTripAnomaliesRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).FindTripAnomaliesV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/FindTripAnomaliesV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).FindTripAnomaliesV2(ctx, req.(*FindTripAnomaliesRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_ListTripsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTripsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).ListTripsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/ListTripsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).ListTripsV2(ctx, req.(*ListTripsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetCabUtilizationV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCabUtilizationRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetCabUtilizationV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetCabUtilizationV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetCabUtilizationV2(ctx, req.(*GetCabUtilizationRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetTripPatternsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripPatternsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetTripPatternsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetTripPatternsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetTripPatternsV2(ctx, req.(*GetTripPatternsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_DetectCountAnomaliesV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetectCountAnomaliesRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).DetectCountAnomaliesV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/DetectCountAnomaliesV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).DetectCountAnomaliesV2(ctx, req.(*DetectCountAnomaliesRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_ForecastTripsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastTripsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).ForecastTripsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/ForecastTripsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).ForecastTripsV2(ctx, req.(*ForecastTripsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetPassengerCountsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPassengerCountsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetPassengerCountsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetPassengerCountsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetPassengerCountsV2(ctx, req.(*GetPassengerCountsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetVendorStatsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVendorStatsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetVendorStatsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetVendorStatsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetVendorStatsV2(ctx, req.(*GetVendorStatsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_CountZoneTripsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountZoneTripsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).CountZoneTripsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/CountZoneTripsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).CountZoneTripsV2(ctx, req.(*CountZoneTripsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetTaxiZoneTripCountsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaxiZoneTripCountsRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetTaxiZoneTripCountsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetTaxiZoneTripCountsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetTaxiZoneTripCountsV2(ctx, req.(*GetTaxiZoneTripCountsRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetCabZoneCoverageV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCabZoneCoverageRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetCabZoneCoverageV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetCabZoneCoverageV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetCabZoneCoverageV2(ctx, req.(*GetCabZoneCoverageRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetCabRevenueV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCabRevenueRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetCabRevenueV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetCabRevenueV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetCabRevenueV2(ctx, req.(*GetCabRevenueRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetTipRatesV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTipRatesRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetTipRatesV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetTipRatesV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetTipRatesV2(ctx, req.(*GetTipRatesRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NYCabServiceV2_GetPaymentTypeMixV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentTypeMixRequestV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NYCabServiceV2Server).GetPaymentTypeMixV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nycab.rpc.NYCabServiceV2/GetPaymentTypeMixV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NYCabServiceV2Server).GetPaymentTypeMixV2(ctx, req.(*GetPaymentTypeMixRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NYCabServiceV2_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nycab.rpc.NYCabServiceV2",
+	HandlerType: (*NYCabServiceV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllCabTripCountPerDayV2",
+			Handler:    _NYCabServiceV2_GetAllCabTripCountPerDayV2_Handler,
+		},
+		{
+			MethodName: "ClearCacheV2",
+			Handler:    _NYCabServiceV2_ClearCacheV2_Handler,
+		},
+		{
+			MethodName: "GetTripCountsForCabIDsV2",
+			Handler:    _NYCabServiceV2_GetTripCountsForCabIDsV2_Handler,
+		},
+		{
+			MethodName: "GetTripCountsForHackLicensesV2",
+			Handler:    _NYCabServiceV2_GetTripCountsForHackLicensesV2_Handler,
+		},
+		{
+			MethodName: "GetAllDriverTripCountPerDayV2",
+			Handler:    _NYCabServiceV2_GetAllDriverTripCountPerDayV2_Handler,
+		},
+		{
+			MethodName: "GetCabDriverMappingV2",
+			Handler:    _NYCabServiceV2_GetCabDriverMappingV2_Handler,
+		},
+		{
+			MethodName: "CountTripsInAreaV2",
+			Handler:    _NYCabServiceV2_CountTripsInAreaV2_Handler,
+		},
+		{
+			MethodName: "GetPickupHeatmapV2",
+			Handler:    _NYCabServiceV2_GetPickupHeatmapV2_Handler,
+		},
+		{
+			MethodName: "GetOriginDestinationMatrixV2",
+			Handler:    _NYCabServiceV2_GetOriginDestinationMatrixV2_Handler,
+		},
+		{
+			MethodName: "GetCabShiftsV2",
+			Handler:    _NYCabServiceV2_GetCabShiftsV2_Handler,
+		},
+		{
+			MethodName: "FindTripAnomaliesV2",
+			Handler:    _NYCabServiceV2_FindTripAnomaliesV2_Handler,
+		},
+		{
+			MethodName: "ListTripsV2",
+			Handler:    _NYCabServiceV2_ListTripsV2_Handler,
+		},
+		{
+			MethodName: "GetCabUtilizationV2",
+			Handler:    _NYCabServiceV2_GetCabUtilizationV2_Handler,
+		},
+		{
+			MethodName: "GetTripPatternsV2",
+			Handler:    _NYCabServiceV2_GetTripPatternsV2_Handler,
+		},
+		{
+			MethodName: "DetectCountAnomaliesV2",
+			Handler:    _NYCabServiceV2_DetectCountAnomaliesV2_Handler,
+		},
+		{
+			MethodName: "ForecastTripsV2",
+			Handler:    _NYCabServiceV2_ForecastTripsV2_Handler,
+		},
+		{
+			MethodName: "GetPassengerCountsV2",
+			Handler:    _NYCabServiceV2_GetPassengerCountsV2_Handler,
+		},
+		{
+			MethodName: "GetVendorStatsV2",
+			Handler:    _NYCabServiceV2_GetVendorStatsV2_Handler,
+		},
+		{
+			MethodName: "CountZoneTripsV2",
+			Handler:    _NYCabServiceV2_CountZoneTripsV2_Handler,
+		},
+		{
+			MethodName: "GetTaxiZoneTripCountsV2",
+			Handler:    _NYCabServiceV2_GetTaxiZoneTripCountsV2_Handler,
+		},
+		{
+			MethodName: "GetCabZoneCoverageV2",
+			Handler:    _NYCabServiceV2_GetCabZoneCoverageV2_Handler,
+		},
+		{
+			MethodName: "GetCabRevenueV2",
+			Handler:    _NYCabServiceV2_GetCabRevenueV2_Handler,
+		},
+		{
+			MethodName: "GetTipRatesV2",
+			Handler:    _NYCabServiceV2_GetTipRatesV2_Handler,
+		},
+		{
+			MethodName: "GetPaymentTypeMixV2",
+			Handler:    _NYCabServiceV2_GetPaymentTypeMixV2_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "serviceV2.proto",
+}
@@ -0,0 +1,68 @@
+package analytics
+
+import (
+	"fmt"
+)
+
+// AnomalyType is the category of a trip anomaly
+type AnomalyType int
+
+const (
+	// OverlappingTrips is a trip picked up before the previous trip of the same cab was dropped off
+	OverlappingTrips AnomalyType = iota + 1
+	// ZeroDuration is a trip dropped off at or before its pickup time
+	ZeroDuration
+	// ImplausibleSpeed is a trip whose average speed exceeds the speed limit
+	ImplausibleSpeed
+)
+
+// Anomaly is a trip flagged as physically impossible
+type Anomaly struct {
+	Type AnomalyType
+	Trip Trip
+	// Conflicting is the earlier trip overlapping with Trip, only set for OverlappingTrips
+	Conflicting *Trip
+	Detail      string
+}
+
+// FindAnomalies returns the overlapping, zero duration and implausible speed trips of a single cab
+// maxSpeedMph: average speed in miles per hour above which a trip is implausible
+func FindAnomalies(trips []Trip, maxSpeedMph float64) []Anomaly {
+	anomalies := []Anomaly{}
+
+	var latest *Trip
+	for _, trip := range sortByPickup(trips) {
+		trip := trip
+		if latest != nil && trip.Pickup.Before(latest.Dropoff) {
+			conflicting := *latest
+			anomalies = append(anomalies, Anomaly{
+				Type:        OverlappingTrips,
+				Trip:        trip,
+				Conflicting: &conflicting,
+				Detail:      fmt.Sprintf("picked up %s before the previous trip was dropped off", latest.Dropoff.Sub(trip.Pickup)),
+			})
+		}
+
+		duration := trip.Duration()
+		if duration <= 0 {
+			anomalies = append(anomalies, Anomaly{
+				Type:   ZeroDuration,
+				Trip:   trip,
+				Detail: fmt.Sprintf("dropoff is %s before pickup", trip.Pickup.Sub(trip.Dropoff)),
+			})
+		} else if speed := trip.Distance / duration.Hours(); speed > maxSpeedMph {
+			anomalies = append(anomalies, Anomaly{
+				Type:   ImplausibleSpeed,
+				Trip:   trip,
+				Detail: fmt.Sprintf("average speed of %.1f mph over %.2f miles in %s", speed, trip.Distance, duration),
+			})
+		}
+
+		// keep the trip dropped off last, so overlaps with long trips are detected beyond the next trip
+		if latest == nil || trip.Dropoff.After(latest.Dropoff) {
+			latest = &trip
+		}
+	}
+
+	return anomalies
+}
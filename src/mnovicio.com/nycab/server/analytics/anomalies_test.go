@@ -0,0 +1,89 @@
+package analytics
+
+import (
+	"testing"
+)
+
+func TestFindAnomalies(t *testing.T) {
+	tests := []struct {
+		name  string
+		trips []Trip
+		want  []AnomalyType
+	}{
+		{
+			name: "consecutive trips",
+			trips: []Trip{
+				{Pickup: at(8, 0), Dropoff: at(8, 30), Distance: 5},
+				{Pickup: at(8, 30), Dropoff: at(9, 0), Distance: 5},
+			},
+			want: []AnomalyType{},
+		},
+		{
+			name: "overlapping trips",
+			trips: []Trip{
+				{Pickup: at(8, 20), Dropoff: at(8, 40), Distance: 2},
+				{Pickup: at(8, 0), Dropoff: at(8, 30), Distance: 5},
+			},
+			want: []AnomalyType{OverlappingTrips},
+		},
+		{
+			name: "overlap beyond the next trip",
+			trips: []Trip{
+				{Pickup: at(8, 0), Dropoff: at(10, 0), Distance: 30},
+				{Pickup: at(8, 30), Dropoff: at(8, 40), Distance: 1},
+				{Pickup: at(9, 0), Dropoff: at(9, 10), Distance: 1},
+			},
+			want: []AnomalyType{OverlappingTrips, OverlappingTrips},
+		},
+		{
+			name: "zero duration",
+			trips: []Trip{
+				{Pickup: at(8, 0), Dropoff: at(8, 0), Distance: 1},
+				{Pickup: at(9, 0), Dropoff: at(8, 50), Distance: 1},
+			},
+			want: []AnomalyType{ZeroDuration, ZeroDuration},
+		},
+		{
+			name: "implausible speed",
+			trips: []Trip{
+				// 100 miles in one hour
+				{Pickup: at(8, 0), Dropoff: at(9, 0), Distance: 100},
+				// 80 miles in one hour, at the limit
+				{Pickup: at(10, 0), Dropoff: at(11, 0), Distance: 80},
+			},
+			want: []AnomalyType{ImplausibleSpeed},
+		},
+	}
+
+	for _, test := range tests {
+		anomalies := FindAnomalies(test.trips, 80)
+		if len(anomalies) != len(test.want) {
+			t.Errorf("%s: FindAnomalies() returned %d anomalies, want %d: %+v", test.name, len(anomalies), len(test.want), anomalies)
+			continue
+		}
+		for i, anomaly := range anomalies {
+			if anomaly.Type != test.want[i] {
+				t.Errorf("%s: anomaly %d is of type %d, want %d", test.name, i, anomaly.Type, test.want[i])
+			}
+			if (anomaly.Type == OverlappingTrips) != (anomaly.Conflicting != nil) {
+				t.Errorf("%s: anomaly %d of type %d has conflicting trip %v", test.name, i, anomaly.Type, anomaly.Conflicting)
+			}
+		}
+	}
+}
+
+func TestFindAnomaliesConflictingTrip(t *testing.T) {
+	first := Trip{Pickup: at(8, 0), Dropoff: at(10, 0), Distance: 30}
+	trips := []Trip{
+		{Pickup: at(8, 30), Dropoff: at(8, 40), Distance: 1},
+		{Pickup: at(9, 0), Dropoff: at(9, 10), Distance: 1},
+		first,
+	}
+
+	for _, anomaly := range FindAnomalies(trips, 80) {
+		// both later trips overlap with the long trip, not with each other
+		if *anomaly.Conflicting != first {
+			t.Errorf("trip picked up at %s conflicts with %+v, want %+v", anomaly.Trip.Pickup, *anomaly.Conflicting, first)
+		}
+	}
+}
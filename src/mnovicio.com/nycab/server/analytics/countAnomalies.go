@@ -0,0 +1,83 @@
+package analytics
+
+import (
+	"math"
+	"time"
+)
+
+// BaselineMethod is how the expected trip count of a day is computed from the prior days
+type BaselineMethod int
+
+const (
+	// RollingMean expects the mean of the prior days, scored in standard deviations
+	RollingMean BaselineMethod = iota
+	// MedianAbsoluteDeviation expects the median of the prior days, scored in scaled median absolute deviations
+	MedianAbsoluteDeviation
+)
+
+// madScale makes the median absolute deviation comparable to the standard deviation of normally distributed counts
+const madScale = 1.4826
+
+// minSpread is the smallest spread a baseline is scored against
+// counts are integers, a spread below one trip would flag any change on a steady cab
+const minSpread = 1.0
+
+// CountAnomaly is a day whose trip count deviates from the baseline of the prior days
+type CountAnomaly struct {
+	Date     time.Time
+	Count    float64
+	Expected float64
+	// Score is the deviation from Expected in spreads, negative for fewer trips than expected
+	Score float64
+}
+
+// DetectCountAnomalies returns the days on or after from whose count deviates from the baseline of the prior window days by at least threshold spreads
+// counts must be ordered by date, days with less than two prior counts are not scored
+func DetectCountAnomalies(counts []DailyCount, from time.Time, window int, method BaselineMethod, threshold float64) []CountAnomaly {
+	anomalies := []CountAnomaly{}
+	for i, c := range counts {
+		if c.Date.Before(from) {
+			continue
+		}
+
+		first := i - window
+		if first < 0 {
+			first = 0
+		}
+		if i-first < 2 {
+			continue
+		}
+
+		prior := make([]float64, 0, i-first)
+		for _, p := range counts[first:i] {
+			prior = append(prior, p.Count)
+		}
+
+		expected, spread := baseline(prior, method)
+		score := (c.Count - expected) / math.Max(spread, minSpread)
+		if math.Abs(score) >= threshold {
+			anomalies = append(anomalies, CountAnomaly{
+				Date:     c.Date,
+				Count:    c.Count,
+				Expected: expected,
+				Score:    score,
+			})
+		}
+	}
+	return anomalies
+}
+
+// baseline returns the expected count and spread of the prior counts
+func baseline(prior []float64, method BaselineMethod) (float64, float64) {
+	if method == MedianAbsoluteDeviation {
+		median := Median(prior)
+		deviations := make([]float64, 0, len(prior))
+		for _, v := range prior {
+			deviations = append(deviations, math.Abs(v-median))
+		}
+		return median, madScale * Median(deviations)
+	}
+
+	summary := Summarize(prior)
+	return summary.Mean, summary.StdDev
+}
@@ -0,0 +1,110 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// dailyCounts returns consecutive daily counts starting on 2013-12-01
+func dailyCounts(counts ...float64) []DailyCount {
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+	daily := make([]DailyCount, 0, len(counts))
+	for i, count := range counts {
+		daily = append(daily, DailyCount{Date: start.AddDate(0, 0, i), Count: count})
+	}
+	return daily
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		values []float64
+		want   float64
+	}{
+		{nil, 0},
+		{[]float64{3}, 3},
+		{[]float64{5, 1, 3}, 3},
+		{[]float64{4, 1, 3, 2}, 2.5},
+	}
+
+	for _, test := range tests {
+		if median := Median(test.values); median != test.want {
+			t.Errorf("Median(%v) = %g, want %g", test.values, median, test.want)
+		}
+	}
+}
+
+func TestBaseline(t *testing.T) {
+	prior := []float64{10, 12, 11, 13, 100}
+
+	// the outlier drags the mean and standard deviation
+	mean, stdDev := baseline(prior, RollingMean)
+	if mean != 29.2 || math.Abs(stdDev-39.59) > 0.01 {
+		t.Errorf("baseline(RollingMean) = %g, %g, want 29.2, 39.59", mean, stdDev)
+	}
+
+	// but not the median and median absolute deviation: deviations from 12 are 2, 0, 1, 1, 88
+	median, mad := baseline(prior, MedianAbsoluteDeviation)
+	if median != 12 || math.Abs(mad-madScale) > 1e-9 {
+		t.Errorf("baseline(MedianAbsoluteDeviation) = %g, %g, want 12, %g", median, mad, madScale)
+	}
+}
+
+func TestDetectCountAnomalies(t *testing.T) {
+	// a steady cab with a spike on day 6 (2013-12-06) and a drop on day 8 (2013-12-08)
+	counts := dailyCounts(20, 22, 21, 19, 20, 60, 21, 2, 20)
+	from := counts[0].Date
+
+	tests := []struct {
+		name      string
+		method    BaselineMethod
+		window    int
+		threshold float64
+		want      []string
+	}{
+		// the spike inflates the standard deviation of the next days, hiding the drop
+		{"rolling mean", RollingMean, 7, 3, []string{"2013-12-06"}},
+		// the median absolute deviation ignores the spike, flagging both
+		{"median absolute deviation", MedianAbsoluteDeviation, 7, 3, []string{"2013-12-06", "2013-12-08"}},
+		// with a short window the spike is out of the baseline of the drop
+		{"short window", RollingMean, 1, 3, []string{}},
+		{"high threshold", MedianAbsoluteDeviation, 7, 100, []string{}},
+	}
+
+	for _, test := range tests {
+		anomalies := DetectCountAnomalies(counts, from, test.window, test.method, test.threshold)
+		dates := []string{}
+		for _, anomaly := range anomalies {
+			dates = append(dates, anomaly.Date.Format("2006-01-02"))
+		}
+		if len(dates) != len(test.want) {
+			t.Errorf("%s: DetectCountAnomalies() flagged %v, want %v", test.name, dates, test.want)
+			continue
+		}
+		for i := range dates {
+			if dates[i] != test.want[i] {
+				t.Errorf("%s: DetectCountAnomalies() flagged %v, want %v", test.name, dates, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestDetectCountAnomaliesScore(t *testing.T) {
+	// a cab with the exact same count each day has no spread, scored against minSpread
+	counts := dailyCounts(10, 10, 10, 7)
+
+	anomalies := DetectCountAnomalies(counts, counts[3].Date, 7, MedianAbsoluteDeviation, 2)
+	if len(anomalies) != 1 {
+		t.Fatalf("DetectCountAnomalies() returned %d anomalies, want 1", len(anomalies))
+	}
+	if anomaly := anomalies[0]; anomaly.Expected != 10 || anomaly.Score != -3 {
+		t.Errorf("anomaly expected %g with score %g, want 10 and -3", anomaly.Expected, anomaly.Score)
+	}
+
+	// days before from are only used as baseline
+	spiked := dailyCounts(10, 10, 10, 70, 10)
+	if anomalies := DetectCountAnomalies(spiked, spiked[4].Date, 7, MedianAbsoluteDeviation, 2); len(anomalies) != 0 {
+		t.Errorf("DetectCountAnomalies() flagged %+v before from", anomalies)
+	}
+}
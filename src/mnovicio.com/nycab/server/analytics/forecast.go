@@ -0,0 +1,153 @@
+package analytics
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ForecastMethod is the model fitted to the daily trip counts
+type ForecastMethod int
+
+const (
+	// SeasonalNaive forecasts the count of the same day of the previous week
+	SeasonalNaive ForecastMethod = iota
+	// HoltWinters forecasts with additive level, trend and day of the week seasonality
+	HoltWinters
+)
+
+// season is the number of days of the weekly seasonality
+const season = 7
+
+// holtWintersGrid are the smoothing parameters tried when fitting Holt-Winters
+var holtWintersGrid = []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9}
+
+// Forecast is the expected trip count of a day with its prediction interval
+type Forecast struct {
+	Date     time.Time
+	Expected float64
+	Lower    float64
+	Upper    float64
+}
+
+// ForecastCounts forecasts the horizon days following the daily counts
+// counts must be ordered by date with no missing day and span at least two weeks
+// level: probability of the prediction interval, between 0 and 1
+func ForecastCounts(counts []DailyCount, horizon int, method ForecastMethod, level float64) ([]Forecast, error) {
+	if len(counts) < 2*season {
+		return nil, fmt.Errorf("%d days of history, at least %d days are needed", len(counts), 2*season)
+	}
+
+	values := make([]float64, 0, len(counts))
+	for _, c := range counts {
+		values = append(values, c.Count)
+	}
+
+	var expected, stdErrors []float64
+	switch method {
+	case HoltWinters:
+		expected, stdErrors = forecastHoltWinters(values, horizon)
+	default:
+		expected, stdErrors = forecastSeasonalNaive(values, horizon)
+	}
+
+	// normal quantile of the two sided interval
+	z := math.Sqrt2 * math.Erfinv(level)
+
+	last := counts[len(counts)-1].Date
+	forecasts := make([]Forecast, 0, horizon)
+	for h := 0; h < horizon; h++ {
+		forecasts = append(forecasts, Forecast{
+			Date:     last.AddDate(0, 0, h+1),
+			Expected: math.Max(expected[h], 0),
+			Lower:    math.Max(expected[h]-z*stdErrors[h], 0),
+			Upper:    math.Max(expected[h]+z*stdErrors[h], 0),
+		})
+	}
+	return forecasts, nil
+}
+
+// forecastSeasonalNaive returns the forecasts and their standard errors, which grow with the number of weeks ahead
+func forecastSeasonalNaive(values []float64, horizon int) ([]float64, []float64) {
+	var squares float64
+	for t := season; t < len(values); t++ {
+		squares += (values[t] - values[t-season]) * (values[t] - values[t-season])
+	}
+	sigma := math.Sqrt(squares / float64(len(values)-season))
+
+	n := len(values)
+	expected := make([]float64, horizon)
+	stdErrors := make([]float64, horizon)
+	for h := 0; h < horizon; h++ {
+		weeks := h/season + 1
+		expected[h] = values[n+h-season*weeks]
+		stdErrors[h] = sigma * math.Sqrt(float64(weeks))
+	}
+	return expected, stdErrors
+}
+
+// forecastHoltWinters fits the smoothing parameters minimizing the one step ahead squared errors
+// returns the forecasts and their standard errors, approximated as growing with the square root of the days ahead
+func forecastHoltWinters(values []float64, horizon int) ([]float64, []float64) {
+	var best *holtWintersModel
+	for _, alpha := range holtWintersGrid {
+		for _, beta := range holtWintersGrid {
+			for _, gamma := range holtWintersGrid {
+				model := fitHoltWinters(values, alpha, beta, gamma)
+				if best == nil || model.sse < best.sse {
+					best = model
+				}
+			}
+		}
+	}
+
+	sigma := math.Sqrt(best.sse / float64(len(values)-season))
+
+	n := len(values)
+	expected := make([]float64, horizon)
+	stdErrors := make([]float64, horizon)
+	for h := 0; h < horizon; h++ {
+		expected[h] = best.level + float64(h+1)*best.trend + best.seasonal[(n+h)%season]
+		stdErrors[h] = sigma * math.Sqrt(float64(h+1))
+	}
+	return expected, stdErrors
+}
+
+type holtWintersModel struct {
+	level    float64
+	trend    float64
+	seasonal [season]float64
+	// sse is the sum of the one step ahead squared errors after the first week
+	sse float64
+}
+
+// fitHoltWinters runs additive Holt-Winters over the values, initialized from the first two weeks
+func fitHoltWinters(values []float64, alpha, beta, gamma float64) *holtWintersModel {
+	var firstWeek, secondWeek float64
+	for i := 0; i < season; i++ {
+		firstWeek += values[i]
+		secondWeek += values[season+i]
+	}
+	firstWeek /= season
+	secondWeek /= season
+
+	model := &holtWintersModel{
+		level: firstWeek,
+		trend: (secondWeek - firstWeek) / season,
+	}
+	for i := 0; i < season; i++ {
+		model.seasonal[i] = values[i] - firstWeek
+	}
+
+	for t := season; t < len(values); t++ {
+		s := model.seasonal[t%season]
+		predicted := model.level + model.trend + s
+		model.sse += (values[t] - predicted) * (values[t] - predicted)
+
+		level := alpha*(values[t]-s) + (1-alpha)*(model.level+model.trend)
+		model.trend = beta*(level-model.level) + (1-beta)*model.trend
+		model.seasonal[t%season] = gamma*(values[t]-level) + (1-gamma)*s
+		model.level = level
+	}
+	return model
+}
@@ -0,0 +1,92 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+)
+
+// weeklyPattern is a day of the week seasonality, busier on weekends
+var weeklyPattern = [season]float64{30, 10, 12, 14, 16, 25, 40}
+
+// seasonalCounts returns days of weekly pattern counts growing by trend trips per day
+func seasonalCounts(days int, trend float64) []DailyCount {
+	values := make([]float64, 0, days)
+	for i := 0; i < days; i++ {
+		values = append(values, 100+trend*float64(i)+weeklyPattern[i%season])
+	}
+	return dailyCounts(values...)
+}
+
+func TestForecastCountsHistory(t *testing.T) {
+	for _, method := range []ForecastMethod{SeasonalNaive, HoltWinters} {
+		if _, err := ForecastCounts(seasonalCounts(2*season-1, 0), 7, method, 0.95); err == nil {
+			t.Errorf("ForecastCounts(method %d) of 13 days = nil, want error", method)
+		}
+	}
+}
+
+func TestForecastCountsSeasonalNaive(t *testing.T) {
+	counts := seasonalCounts(3*season, 0)
+
+	forecasts, err := ForecastCounts(counts, 10, SeasonalNaive, 0.95)
+	if err != nil {
+		t.Fatalf("ForecastCounts() = %v", err)
+	}
+	if len(forecasts) != 10 {
+		t.Fatalf("ForecastCounts() returned %d forecasts, want 10", len(forecasts))
+	}
+
+	last := counts[len(counts)-1].Date
+	for h, forecast := range forecasts {
+		if want := last.AddDate(0, 0, h+1); !forecast.Date.Equal(want) {
+			t.Errorf("forecast %d is for %s, want %s", h, forecast.Date, want)
+		}
+		// a pattern repeating exactly every week is forecast without error
+		want := 100 + weeklyPattern[(len(counts)+h)%season]
+		if forecast.Expected != want || forecast.Lower != want || forecast.Upper != want {
+			t.Errorf("forecast %d = %+v, want %g without interval", h, forecast, want)
+		}
+	}
+}
+
+func TestForecastCountsHoltWinters(t *testing.T) {
+	counts := seasonalCounts(8*season, 2)
+
+	forecasts, err := ForecastCounts(counts, season, HoltWinters, 0.95)
+	if err != nil {
+		t.Fatalf("ForecastCounts() = %v", err)
+	}
+
+	for h, forecast := range forecasts {
+		// the trend and seasonality are extrapolated
+		n := len(counts) + h
+		want := 100 + 2*float64(n) + weeklyPattern[n%season]
+		if math.Abs(forecast.Expected-want) > 2 {
+			t.Errorf("forecast %d expected %g, want %g", h, forecast.Expected, want)
+		}
+		if forecast.Lower > forecast.Expected || forecast.Upper < forecast.Expected {
+			t.Errorf("forecast %d interval [%g, %g] does not contain %g", h, forecast.Lower, forecast.Upper, forecast.Expected)
+		}
+		if h > 0 && forecast.Upper-forecast.Lower < forecasts[h-1].Upper-forecasts[h-1].Lower {
+			t.Errorf("forecast %d interval is narrower than the day before", h)
+		}
+	}
+}
+
+func TestForecastCountsNonNegative(t *testing.T) {
+	// a cab stopping service trends below zero
+	values := make([]float64, 0, 3*season)
+	for i := 0; i < 3*season; i++ {
+		values = append(values, math.Max(60-3*float64(i), 0))
+	}
+
+	forecasts, err := ForecastCounts(dailyCounts(values...), season, HoltWinters, 0.95)
+	if err != nil {
+		t.Fatalf("ForecastCounts() = %v", err)
+	}
+	for h, forecast := range forecasts {
+		if forecast.Expected < 0 || forecast.Lower < 0 || forecast.Upper < 0 {
+			t.Errorf("forecast %d = %+v, want non negative counts", h, forecast)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package analytics
+
+import (
+	"sort"
+	"time"
+)
+
+// Trip is a single cab trip
+type Trip struct {
+	HackLicense string
+	Pickup      time.Time
+	Dropoff     time.Time
+	// Distance is the trip distance in miles
+	Distance float64
+}
+
+// Duration returns the time spent with a passenger, zero for trips with dropoff before pickup
+func (t Trip) Duration() time.Duration {
+	if t.Dropoff.Before(t.Pickup) {
+		return 0
+	}
+	return t.Dropoff.Sub(t.Pickup)
+}
+
+// Shift is a group of consecutive trips of a cab driven by the same driver
+type Shift struct {
+	HackLicense string
+	Start       time.Time
+	End         time.Time
+	// LastPickup is the pickup time of the last trip of the shift
+	LastPickup time.Time
+	TripCount  int
+	Active     time.Duration
+	Idle       time.Duration
+}
+
+// ReconstructShifts groups trips ordered by pickup time into shifts
+// a new shift starts when the idle time since the previous dropoff exceeds maxIdle or the driver changes
+func ReconstructShifts(trips []Trip, maxIdle time.Duration) []Shift {
+	sorted := sortByPickup(trips)
+
+	shifts := []Shift{}
+	var current *Shift
+	for _, trip := range sorted {
+		if current == nil || trip.HackLicense != current.HackLicense || trip.Pickup.Sub(current.End) > maxIdle {
+			if current != nil {
+				shifts = append(shifts, closeShift(*current))
+			}
+			current = &Shift{
+				HackLicense: trip.HackLicense,
+				Start:       trip.Pickup,
+				End:         trip.Pickup,
+			}
+		}
+
+		current.TripCount++
+		current.LastPickup = trip.Pickup
+		current.Active += trip.Duration()
+		if trip.Dropoff.After(current.End) {
+			current.End = trip.Dropoff
+		}
+	}
+
+	if current != nil {
+		shifts = append(shifts, closeShift(*current))
+	}
+
+	return shifts
+}
+
+// ShiftsPickedUpBetween returns the shifts with a trip picked up within [start, end)
+// shifts are reconstructed from trips around the range so that shifts crossing its limits (e.g. midnight) are returned whole
+func ShiftsPickedUpBetween(shifts []Shift, start, end time.Time) []Shift {
+	between := []Shift{}
+	for _, shift := range shifts {
+		if shift.Start.Before(end) && !shift.LastPickup.Before(start) {
+			between = append(between, shift)
+		}
+	}
+	return between
+}
+
+// closeShift computes the idle time of the shift, overlapping trips can make active time exceed the shift length
+func closeShift(shift Shift) Shift {
+	shift.Idle = shift.End.Sub(shift.Start) - shift.Active
+	if shift.Idle < 0 {
+		shift.Idle = 0
+	}
+	return shift
+}
+
+// sortByPickup returns a copy of the trips ordered by pickup time
+func sortByPickup(trips []Trip) []Trip {
+	sorted := make([]Trip, len(trips))
+	copy(sorted, trips)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Pickup.Before(sorted[j].Pickup)
+	})
+	return sorted
+}
@@ -0,0 +1,102 @@
+package analytics
+
+import (
+	"testing"
+	"time"
+)
+
+// at returns 2013-12-01 plus the given hours and minutes
+func at(hours, minutes int) time.Time {
+	return time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute)
+}
+
+func trip(hackLicense string, pickup, dropoff time.Time) Trip {
+	return Trip{HackLicense: hackLicense, Pickup: pickup, Dropoff: dropoff}
+}
+
+func TestReconstructShifts(t *testing.T) {
+	trips := []Trip{
+		// given out of order, trips are sorted by pickup
+		trip("A", at(9, 0), at(9, 30)),
+		trip("A", at(8, 0), at(8, 20)),
+		trip("A", at(8, 40), at(8, 50)),
+		// idle for 2 hours, new shift
+		trip("A", at(11, 30), at(11, 45)),
+		// driver change, new shift
+		trip("B", at(11, 50), at(12, 10)),
+	}
+
+	shifts := ReconstructShifts(trips, time.Hour)
+
+	want := []Shift{
+		{HackLicense: "A", Start: at(8, 0), End: at(9, 30), LastPickup: at(9, 0), TripCount: 3, Active: 60 * time.Minute, Idle: 30 * time.Minute},
+		{HackLicense: "A", Start: at(11, 30), End: at(11, 45), LastPickup: at(11, 30), TripCount: 1, Active: 15 * time.Minute},
+		{HackLicense: "B", Start: at(11, 50), End: at(12, 10), LastPickup: at(11, 50), TripCount: 1, Active: 20 * time.Minute},
+	}
+	if len(shifts) != len(want) {
+		t.Fatalf("ReconstructShifts() returned %d shifts, want %d: %+v", len(shifts), len(want), shifts)
+	}
+	for i := range want {
+		if shifts[i] != want[i] {
+			t.Errorf("shift %d = %+v, want %+v", i, shifts[i], want[i])
+		}
+	}
+}
+
+func TestReconstructShiftsOverlappingTrips(t *testing.T) {
+	// overlapping trips count their durations twice, idle time does not go negative
+	shifts := ReconstructShifts([]Trip{
+		trip("A", at(8, 0), at(9, 0)),
+		trip("A", at(8, 30), at(8, 45)),
+		// dropoff before pickup counts as zero duration
+		trip("A", at(8, 50), at(8, 40)),
+	}, time.Hour)
+
+	if len(shifts) != 1 {
+		t.Fatalf("ReconstructShifts() returned %d shifts, want 1", len(shifts))
+	}
+	if shifts[0].End != at(9, 0) || shifts[0].Active != 75*time.Minute || shifts[0].Idle != 0 {
+		t.Errorf("shift = %+v, want end 09:00, 75m active, no idle time", shifts[0])
+	}
+}
+
+func TestShiftsPickedUpBetweenKeepsShiftsCrossingMidnight(t *testing.T) {
+	trips := []Trip{
+		// shift of the previous day
+		trip("A", at(-6, 0), at(-5, 30)),
+		// night shift crossing into the day
+		trip("B", at(-2, 0), at(-1, 30)),
+		trip("B", at(-1, 0), at(0, 20)),
+		trip("B", at(0, 40), at(1, 0)),
+		// day shift
+		trip("C", at(12, 0), at(12, 30)),
+		// evening shift crossing into the next day
+		trip("D", at(23, 30), at(24, 15)),
+		trip("D", at(24, 30), at(25, 0)),
+		// dropped off on the day, but picked up the day before
+		trip("E", at(-8, 0), at(0, 10)),
+		// shift of the next day
+		trip("F", at(26, 0), at(26, 30)),
+	}
+
+	shifts := ShiftsPickedUpBetween(ReconstructShifts(trips, time.Hour), at(0, 0), at(24, 0))
+
+	want := []struct {
+		hackLicense string
+		start, end  time.Time
+		tripCount   int
+	}{
+		{"B", at(-2, 0), at(1, 0), 3},
+		{"C", at(12, 0), at(12, 30), 1},
+		{"D", at(23, 30), at(25, 0), 2},
+	}
+	if len(shifts) != len(want) {
+		t.Fatalf("ShiftsPickedUpBetween() returned %d shifts, want %d: %+v", len(shifts), len(want), shifts)
+	}
+	for i, w := range want {
+		shift := shifts[i]
+		if shift.HackLicense != w.hackLicense || shift.Start != w.start || shift.End != w.end || shift.TripCount != w.tripCount {
+			t.Errorf("shift %d = %+v, want driver %s from %s to %s with %d trips", i, shift, w.hackLicense, w.start, w.end, w.tripCount)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package analytics
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// DailyCount is the number of trips on a given day
+type DailyCount struct {
+	Date  time.Time
+	Count float64
+}
+
+// Summary describes a set of daily trip counts
+type Summary struct {
+	Days   int
+	Total  float64
+	Mean   float64
+	StdDev float64
+}
+
+// Summarize returns the total, mean and sample standard deviation of the values
+func Summarize(values []float64) Summary {
+	summary := Summary{Days: len(values)}
+	if len(values) == 0 {
+		return summary
+	}
+
+	for _, v := range values {
+		summary.Total += v
+	}
+	summary.Mean = summary.Total / float64(len(values))
+
+	if len(values) > 1 {
+		var squares float64
+		for _, v := range values {
+			squares += (v - summary.Mean) * (v - summary.Mean)
+		}
+		summary.StdDev = math.Sqrt(squares / float64(len(values)-1))
+	}
+
+	return summary
+}
+
+// Median returns the median of the values, 0 if empty
+func Median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
+
+// ByWeekday summarizes the daily counts of each day of the week, Sunday first
+func ByWeekday(counts []DailyCount) [7]Summary {
+	var values [7][]float64
+	for _, c := range counts {
+		values[c.Date.Weekday()] = append(values[c.Date.Weekday()], c.Count)
+	}
+
+	var summaries [7]Summary
+	for weekday := range values {
+		summaries[weekday] = Summarize(values[weekday])
+	}
+	return summaries
+}
+
+// ByMonth summarizes the daily counts of each month, keyed by 'YYYY-MM'
+func ByMonth(counts []DailyCount) map[string]Summary {
+	values := make(map[string][]float64)
+	for _, c := range counts {
+		month := c.Date.Format("2006-01")
+		values[month] = append(values[month], c.Count)
+	}
+
+	summaries := make(map[string]Summary, len(values))
+	for month, monthValues := range values {
+		summaries[month] = Summarize(monthValues)
+	}
+	return summaries
+}
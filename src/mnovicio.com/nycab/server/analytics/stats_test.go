@@ -0,0 +1,64 @@
+package analytics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   Summary
+	}{
+		{"no days", nil, Summary{}},
+		{"single day", []float64{4}, Summary{Days: 1, Total: 4, Mean: 4}},
+		{"sample standard deviation", []float64{2, 4, 4, 4, 5, 5, 7, 9}, Summary{Days: 8, Total: 40, Mean: 5, StdDev: math.Sqrt(32.0 / 7)}},
+		{"zero days", []float64{0, 0, 6}, Summary{Days: 3, Total: 6, Mean: 2, StdDev: math.Sqrt(12)}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := Summarize(test.values)
+			if got.Days != test.want.Days || got.Total != test.want.Total || got.Mean != test.want.Mean ||
+				math.Abs(got.StdDev-test.want.StdDev) > 1e-9 {
+				t.Errorf("Summarize(%v) = %+v, want %+v", test.values, got, test.want)
+			}
+		})
+	}
+}
+
+func TestByWeekday(t *testing.T) {
+	// 2013-12-01 is a Sunday, the 15 days cover every weekday twice and Sundays three times
+	counts := dailyCounts(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15)
+	byWeekday := ByWeekday(counts)
+
+	if sunday := byWeekday[0]; sunday.Days != 3 || sunday.Total != 1+8+15 || sunday.Mean != 8 {
+		t.Errorf("Sunday = %+v, want 3 days of mean 8", sunday)
+	}
+	if monday := byWeekday[1]; monday.Days != 2 || monday.Mean != 5.5 {
+		t.Errorf("Monday = %+v, want 2 days of mean 5.5", monday)
+	}
+	if saturday := byWeekday[6]; saturday.Days != 2 || saturday.Total != 7+14 {
+		t.Errorf("Saturday = %+v, want 2 days totalling 21", saturday)
+	}
+}
+
+func TestByMonth(t *testing.T) {
+	// December 30 to January 2
+	counts := dailyCounts(make([]float64, 33)...)[29:]
+	for i := range counts {
+		counts[i].Count = float64(i + 1)
+	}
+	byMonth := ByMonth(counts)
+
+	if len(byMonth) != 2 {
+		t.Fatalf("ByMonth() = %v, want 2 months", byMonth)
+	}
+	if december := byMonth["2013-12"]; december.Days != 2 || december.Total != 3 {
+		t.Errorf("2013-12 = %+v, want 2 days totalling 3", december)
+	}
+	if january := byMonth["2014-01"]; january.Days != 2 || january.Total != 7 {
+		t.Errorf("2014-01 = %+v, want 2 days totalling 7", january)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"flag"
 	"fmt"
+	"os"
 
 	// mysql driver
 	_ "github.com/go-sql-driver/mysql"
@@ -12,6 +13,12 @@ import (
 	// service implemenation
 	svc "mnovicio.com/nycab/server/service"
 
+	// holiday calendar
+	"mnovicio.com/nycab/server/holidays"
+
+	// named zones
+	"mnovicio.com/nycab/server/geo"
+
 	// grpc server
 	"mnovicio.com/nycab/server/grpc"
 
@@ -19,6 +26,9 @@ import (
 	"mnovicio.com/nycab/server/rest"
 )
 
+// taxiZoneCellSize is the cell size in degrees of the taxi zone index, about 1 km
+const taxiZoneCellSize = 0.01
+
 // Config is configuration for Server
 type Config struct {
 	// gRPC server start parameters section
@@ -38,6 +48,22 @@ type Config struct {
 	DatastoreDBPassword string
 	// DatastoreDBSchema is schema of database
 	DatastoreDBSchema string
+
+	// HolidayCalendarFile is a file with one 'YYYY-MM-DD,name' holiday per line
+	// NYC public holidays are used if empty
+	HolidayCalendarFile string
+
+	// ZonesFile is a GeoJSON feature collection of named zones, the 'name' property of each feature is the zone name
+	// JFK and LaGuardia airports are used if empty
+	ZonesFile string
+
+	// TaxiZonesFile is the GeoJSON export of the TLC taxi zone shapefile, taxi zone endpoints are disabled if empty
+	TaxiZonesFile string
+	// TaxiZoneIDProperty is the feature property holding the taxi zone ID
+	TaxiZoneIDProperty string
+
+	// ExportDir is the directory exported query results are written in, exports can only be streamed back if empty
+	ExportDir string
 }
 
 // RunServer runs gRPC server and HTTP gateway
@@ -52,6 +78,11 @@ func RunServer() error {
 	flag.StringVar(&cfg.DatastoreDBUser, "db-user", "root", "Database user")
 	flag.StringVar(&cfg.DatastoreDBPassword, "db-password", "admin123", "Database password")
 	flag.StringVar(&cfg.DatastoreDBSchema, "db-schema", "ny_cab_data", "Database schema")
+	flag.StringVar(&cfg.HolidayCalendarFile, "holiday-calendar", "", "Holiday calendar file, NYC public holidays if empty")
+	flag.StringVar(&cfg.ZonesFile, "zones", "", "GeoJSON file of named zones, JFK and LaGuardia airports if empty")
+	flag.StringVar(&cfg.TaxiZonesFile, "taxi-zones", "", "GeoJSON file of TLC taxi zones, taxi zone endpoints are disabled if empty")
+	flag.StringVar(&cfg.TaxiZoneIDProperty, "taxi-zone-id-property", "LocationID", "Taxi zone feature property holding the taxi zone ID")
+	flag.StringVar(&cfg.ExportDir, "export-dir", "", "Directory exported query results are written in, exports are only streamed back if empty")
 	flag.Parse()
 
 	if len(cfg.GRPCPort) == 0 {
@@ -78,7 +109,42 @@ func RunServer() error {
 	}
 	defer db.Close()
 
-	nyCabSvc := svc.GetServiceInstance(db)
+	holidayCalendar := holidays.NewNYCCalendar()
+	if len(cfg.HolidayCalendarFile) > 0 {
+		holidayCalendar, err = holidays.LoadCalendar(cfg.HolidayCalendarFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	zones := geo.AirportZones()
+	if len(cfg.ZonesFile) > 0 {
+		zones, err = geo.LoadZones(cfg.ZonesFile, "name")
+		if err != nil {
+			return err
+		}
+	}
+
+	var taxiZones *geo.ZoneIndex
+	if len(cfg.TaxiZonesFile) > 0 {
+		taxiZoneList, err := geo.LoadZones(cfg.TaxiZonesFile, cfg.TaxiZoneIDProperty)
+		if err != nil {
+			return err
+		}
+		taxiZones = geo.NewZoneIndex(taxiZoneList, taxiZoneCellSize)
+	}
+
+	if len(cfg.ExportDir) > 0 {
+		info, err := os.Stat(cfg.ExportDir)
+		if err != nil {
+			return fmt.Errorf("invalid export directory: %v", err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("invalid export directory: '%s' is not a directory", cfg.ExportDir)
+		}
+	}
+
+	nyCabSvc := svc.GetServiceInstance(db, holidayCalendar, zones, taxiZones, cfg.ExportDir)
 
 	// run HTTP gateway
 	go func() {
@@ -86,5 +152,5 @@ func RunServer() error {
 	}()
 
 	// run gRPC server
-	return grpc.RunServer(ctx, nyCabSvc, cfg.GRPCPort)
+	return grpc.RunServer(ctx, nyCabSvc, nyCabSvc, cfg.GRPCPort)
 }
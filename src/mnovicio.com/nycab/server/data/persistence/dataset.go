@@ -0,0 +1,111 @@
+package persistence
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dataset describes the table holding the trips of a TLC trip dataset
+// queries are written against the columns of the 2013 yellow cab table, other datasets map their columns to them
+type Dataset struct {
+	Name  string
+	Table string
+	// Columns maps the yellow cab columns to the columns of the dataset, nil if the dataset uses the yellow cab schema
+	Columns map[string]string
+	// FareTable holds the fares of the trips keyed by medallion, hack license and pickup datetime, empty if the dataset has no fare data
+	FareTable string
+}
+
+// yellowColumns are the columns of the 2013 yellow cab table used by queries
+var yellowColumns = []string{
+	"medallion",
+	"hack_license",
+	"vendor_id",
+	"pickup_datetime",
+	"dropoff_datetime",
+	"passenger_count",
+	"trip_distance",
+	"pickup_latitude",
+	"pickup_longitude",
+	"dropoff_latitude",
+	"dropoff_longitude",
+}
+
+var (
+	// YellowDataset is the 2013 yellow cab trip data
+	YellowDataset = &Dataset{
+		Name:      "yellow",
+		Table:     "cab_trip_data",
+		FareTable: "cab_trip_fare",
+	}
+
+	// GreenDataset is the green (boro) cab trip data, which does not identify cabs nor drivers
+	GreenDataset = &Dataset{
+		Name:  "green",
+		Table: "green_trip_data",
+		Columns: map[string]string{
+			"vendor_id":         "VendorID",
+			"pickup_datetime":   "lpep_pickup_datetime",
+			"dropoff_datetime":  "Lpep_dropoff_datetime",
+			"passenger_count":   "Passenger_count",
+			"trip_distance":     "Trip_distance",
+			"pickup_latitude":   "Pickup_latitude",
+			"pickup_longitude":  "Pickup_longitude",
+			"dropoff_latitude":  "Dropoff_latitude",
+			"dropoff_longitude": "Dropoff_longitude",
+		},
+	}
+
+	// FHVDataset is the for-hire vehicle trip data, trips are identified by their dispatching base instead of a medallion
+	FHVDataset = &Dataset{
+		Name:  "fhv",
+		Table: "fhv_trip_data",
+		Columns: map[string]string{
+			"medallion":        "Dispatching_base_num",
+			"pickup_datetime":  "Pickup_DateTime",
+			"dropoff_datetime": "DropOff_datetime",
+		},
+	}
+
+	// Datasets are the supported trip datasets keyed by name
+	Datasets = map[string]*Dataset{
+		YellowDataset.Name: YellowDataset,
+		GreenDataset.Name:  GreenDataset,
+		FHVDataset.Name:    FHVDataset,
+	}
+)
+
+// Missing returns the yellow cab columns the dataset does not have
+func (d *Dataset) Missing(columns ...string) []string {
+	if d.Columns == nil {
+		return nil
+	}
+
+	missing := []string{}
+	for _, column := range columns {
+		if _, found := d.Columns[column]; !found {
+			missing = append(missing, column)
+		}
+	}
+	return missing
+}
+
+// source returns the table expression queries select the trips from
+// other datasets are wrapped in a derived table renaming their columns, which MySQL merges into the outer query so the indexes of the table are still used
+// columns the dataset does not have are NULL
+func (d *Dataset) source() string {
+	if d.Columns == nil {
+		return d.Table
+	}
+
+	expressions := make([]string, 0, len(yellowColumns))
+	for _, column := range yellowColumns {
+		expression, found := d.Columns[column]
+		if !found {
+			expression = "NULL"
+		}
+		expressions = append(expressions, fmt.Sprintf("%s AS %s", expression, column))
+	}
+
+	return fmt.Sprintf("(SELECT %s FROM %s) AS %s", strings.Join(expressions, ", "), d.Table, d.Table)
+}
@@ -0,0 +1,152 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeQuery is the result of the queries containing match
+type fakeQuery struct {
+	match   string
+	columns []string
+	rows    [][]driver.Value
+	err     error
+	// wait, if set, blocks the query until it is closed or the query context is done
+	wait chan struct{}
+}
+
+// fakeDB answers each query with the first fakeQuery it matches, and records the queries it ran
+type fakeDB struct {
+	sync.Mutex
+	queries []fakeQuery
+	ran     []string
+}
+
+var (
+	fakeDBsLock sync.Mutex
+	fakeDBs     = make(map[string]*fakeDB)
+)
+
+func init() {
+	sql.Register("persistencetest", fakeDriver{})
+}
+
+// newFakeDBContext returns a DB context of the dataset whose queries are answered by queries
+func newFakeDBContext(t *testing.T, dataset *Dataset, queries ...fakeQuery) (*MySQLDBContext, *fakeDB) {
+	t.Helper()
+
+	fake := &fakeDB{queries: queries}
+	fakeDBsLock.Lock()
+	name := fmt.Sprintf("%s-%d", t.Name(), len(fakeDBs))
+	fakeDBs[name] = fake
+	fakeDBsLock.Unlock()
+
+	db, err := sql.Open("persistencetest", name)
+	if err != nil {
+		t.Fatalf("sql.Open() = %v", err)
+	}
+
+	return newMySQLDBContext(db, dataset), fake
+}
+
+// queriesRan returns the number of queries run containing match
+func (f *fakeDB) queriesRan(match string) int {
+	f.Lock()
+	defer f.Unlock()
+
+	count := 0
+	for _, query := range f.ran {
+		if strings.Contains(query, match) {
+			count++
+		}
+	}
+	return count
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	fakeDBsLock.Lock()
+	defer fakeDBsLock.Unlock()
+
+	fake, found := fakeDBs[name]
+	if !found {
+		return nil, fmt.Errorf("unknown fake DB [%s]", name)
+	}
+	return &fakeConn{db: fake}, nil
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.db.Lock()
+	c.db.ran = append(c.db.ran, query)
+	var result *fakeQuery
+	for i := range c.db.queries {
+		if strings.Contains(query, c.db.queries[i].match) {
+			result = &c.db.queries[i]
+			break
+		}
+	}
+	c.db.Unlock()
+
+	if result == nil {
+		return nil, fmt.Errorf("unexpected query [%s]", query)
+	}
+	if result.wait != nil {
+		select {
+		case <-result.wait:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+
+	return &fakeRows{columns: result.columns, rows: result.rows}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+	next    int
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
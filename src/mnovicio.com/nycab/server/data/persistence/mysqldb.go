@@ -1,6 +1,7 @@
 package persistence
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -14,20 +15,40 @@ import (
 )
 
 var (
-	sqlDBOnce     sync.Once
-	sqlDBInstance *MySQLDBContext
+	sqlDBLock      sync.Mutex
+	sqlDBInstances = make(map[string]*MySQLDBContext)
 )
 
 // Cache synchronized cache for cab trips
 type Cache struct {
 	sync.RWMutex
 	m *pbdata.CabTripsPerDay
+	// generation is incremented whenever the cache is cleared, so that results fetched before are not cached afterwards
+	generation uint64
 }
 
-// MySQLDBContext is an MySQL DB Context with simple caching support
+func newCache() *Cache {
+	return &Cache{
+		m: &pbdata.CabTripsPerDay{
+			CabTrips: make(map[string]*pbdata.TripsPerDay),
+		},
+	}
+}
+
+// MySQLDBContext is an MySQL DB Context of a trip dataset with simple caching support
 type MySQLDBContext struct {
-	db    *sql.DB
-	cache *Cache
+	db      *sql.DB
+	dataset *Dataset
+	// source is the table expression queries select the trips of the dataset from
+	source string
+	cache  *Cache
+
+	// driverCache holds trips per day keyed by hack license instead of medallion
+	driverCache *Cache
+	// queryCache holds aggregation results and cab/driver mappings keyed by query parameters
+	queryCache *QueryCache
+	// onCacheRefreshed is called with the cab IDs whose cached trip counts changed, see OnCacheRefreshed
+	onCacheRefreshed func(cabIDs []string)
 }
 
 // CabTripsPerDay is used for unmarhalling row bytes from query
@@ -37,48 +58,84 @@ type CabTripsPerDay struct {
 	TripCount  uint32 `json:"total_trip_count"`
 }
 
-// GetSQLDBContextInstance returns single instance of SQL DB context
-func GetSQLDBContextInstance(db *sql.DB) *MySQLDBContext {
-	sqlDBOnce.Do(func() {
-		sqlDBInstance = &MySQLDBContext{
-			db: db,
-			cache: &Cache{
-				m: &pbdata.CabTripsPerDay{
-					CabTrips: make(map[string]*pbdata.TripsPerDay),
-				},
-			},
-		}
-	})
-	return sqlDBInstance
+// GetSQLDBContextInstance returns single instance of SQL DB context per dataset, each with its own caches
+func GetSQLDBContextInstance(db *sql.DB, dataset *Dataset) *MySQLDBContext {
+	sqlDBLock.Lock()
+	defer sqlDBLock.Unlock()
+
+	instance, found := sqlDBInstances[dataset.Name]
+	if !found {
+		instance = newMySQLDBContext(db, dataset)
+		sqlDBInstances[dataset.Name] = instance
+	}
+	return instance
+}
+
+func newMySQLDBContext(db *sql.DB, dataset *Dataset) *MySQLDBContext {
+	return &MySQLDBContext{
+		db:          db,
+		dataset:     dataset,
+		source:      dataset.source(),
+		cache:       newCache(),
+		driverCache: newCache(),
+		queryCache:  newQueryCache(maxQueryCacheRows),
+	}
+}
+
+// OnCacheRefreshed sets the function called with the cab IDs whose cached trip counts are replaced by different counts,
+// e.g. when trip counts are fetched with ignoreCache after trips were imported. counts cached for the first time are not reported
+// notify is called with the cache locked and must not block, it must be set before the DB context is used
+func (m *MySQLDBContext) OnCacheRefreshed(notify func(cabIDs []string)) {
+	m.onCacheRefreshed = notify
+}
+
+// Dataset returns the trip dataset of the DB context
+func (m *MySQLDBContext) Dataset() *Dataset {
+	return m.dataset
 }
 
 // GetTripCountsForCabsByPickupDate returns the total number of trips the cab has made based on pickup_datetime column with time ignored
 // cabIDs: list of cab IDs to search
 // pickupDate: pickup date in 'YYYY-MM-DD' format
 // ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
-func (m *MySQLDBContext) GetTripCountsForCabsByPickupDate(cabIDs []string, pickupDate string, ignoreCache bool) (*pbdata.CabTripsPerDay, error) {
-	cabTripsPerDay := &pbdata.CabTripsPerDay{
+func (m *MySQLDBContext) GetTripCountsForCabsByPickupDate(ctx context.Context, cabIDs []string, pickupDate string, ignoreCache bool) (*pbdata.CabTripsPerDay, error) {
+	return m.getTripCountsByPickupDate(ctx, m.cache, "medallion", cabIDs, pickupDate, ignoreCache)
+}
+
+// GetAllCabTrips returns number of trips per day on record for each cab
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetAllCabTrips(ctx context.Context, ignoreCache bool) (*pbdata.CabTripsPerDay, error) {
+	return m.getAllTripCounts(ctx, m.cache, "medallion", ignoreCache)
+}
+
+// getTripCountsByPickupDate returns the total number of trips per ID based on pickup_datetime column with time ignored
+// cache: cache holding trip counts keyed by keyColumn values
+// keyColumn: column the trips are grouped by (e.g. 'medallion', 'hack_license')
+// ids: list of IDs to search
+// pickupDate: pickup date in 'YYYY-MM-DD' format
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) getTripCountsByPickupDate(ctx context.Context, cache *Cache, keyColumn string, ids []string, pickupDate string, ignoreCache bool) (*pbdata.CabTripsPerDay, error) {
+	tripsPerDay := &pbdata.CabTripsPerDay{
 		CabTrips: make(map[string]*pbdata.TripsPerDay),
 	}
 
 	notInCache := []string{}
+	cache.RLock()
+	generation := cache.generation
 	if ignoreCache {
 		// if ignore cache, search everthing from db
-		notInCache = append(notInCache, cabIDs...)
+		notInCache = append(notInCache, ids...)
 	} else {
-		// else, check cache if cabID with pickup date exists
-		m.cache.Lock()
-		defer m.cache.Unlock()
-
-		for _, cabID := range cabIDs {
+		// else, check cache if ID with pickup date exists
+		for _, id := range ids {
 			cachedDataFound := false
-			cachedCabTripsPerDay, cachedCabTripFound := m.cache.m.CabTrips[cabID]
-			if cachedCabTripFound {
-				cachedTripCountOnDate, cachedTripCountOnDateFound := cachedCabTripsPerDay.TripsPerDay[pickupDate]
+			cachedTripsPerDay, cachedTripsFound := cache.m.CabTrips[id]
+			if cachedTripsFound {
+				cachedTripCountOnDate, cachedTripCountOnDateFound := cachedTripsPerDay.TripsPerDay[pickupDate]
 				if cachedTripCountOnDateFound {
 					cachedDataFound = true
-					log.Println(fmt.Sprintf("Found in cache [cab_id='%s', pickup_date='%s', count='%d']", cabID, pickupDate, cachedTripCountOnDate))
-					cabTripsPerDay.CabTrips[cabID] = &pbdata.TripsPerDay{
+					log.Println(fmt.Sprintf("Found in cache [%s='%s', pickup_date='%s', count='%d']", keyColumn, id, pickupDate, cachedTripCountOnDate))
+					tripsPerDay.CabTrips[id] = &pbdata.TripsPerDay{
 						TripsPerDay: map[string]uint32{
 							pickupDate: cachedTripCountOnDate,
 						},
@@ -88,99 +145,133 @@ func (m *MySQLDBContext) GetTripCountsForCabsByPickupDate(cabIDs []string, picku
 
 			// cached data not found, add into list to be be queried from DB
 			if !cachedDataFound {
-				notInCache = append(notInCache, cabID)
-				_cabTripsPerDay := CabTripsPerDay{
-					CabID:      cabID,
-					PickUpDate: pickupDate,
-					TripCount:  0,
-				}
-				m.addTripCountToSet(cabTripsPerDay, _cabTripsPerDay.CabID, _cabTripsPerDay.PickUpDate, _cabTripsPerDay.TripCount)
-				m.addTripCountToSet(m.cache.m, _cabTripsPerDay.CabID, _cabTripsPerDay.PickUpDate, _cabTripsPerDay.TripCount)
+				notInCache = append(notInCache, id)
 			}
 		}
 	}
+	cache.RUnlock()
 
 	if len(notInCache) > 0 {
-		log.Println("fetching data from db for ff cabIDs: ", notInCache)
-		query := fmt.Sprintf("SELECT medallion AS cab_id, DATE(pickup_datetime) AS pickup_date, COUNT(pickup_datetime) AS total_trip_cnt FROM cab_trip_data WHERE medallion IN ('%s') AND DATE(pickup_datetime) = '%s' GROUP BY medallion, pickup_date",
-			strings.Join(notInCache, "', '"), pickupDate)
-
-		log.Printf("running query: [%s]", query)
-		results, err := m.db.Query(query)
-		if err != nil {
-			panic(err.Error())
+		fetched := &pbdata.CabTripsPerDay{
+			CabTrips: make(map[string]*pbdata.TripsPerDay),
 		}
 
-		for results.Next() {
-			var _cabTripsPerDay CabTripsPerDay
-			// for each row, scan the result into our tag composite object
-			err = results.Scan(&_cabTripsPerDay.CabID, &_cabTripsPerDay.PickUpDate, &_cabTripsPerDay.TripCount)
-			if err != nil {
-				panic(err.Error())
+		// IDs without trips on the pickup date are reported (and cached) with a zero count, unless the cache is ignored
+		// in which case only the IDs with trips are returned and refreshed in the cache
+		if !ignoreCache {
+			for _, id := range notInCache {
+				m.addTripCountToSet(fetched, id, pickupDate, 0)
 			}
+		}
 
-			// format date to 'YYYY-MM-DD'
-			t, _ := time.Parse(time.RFC3339, _cabTripsPerDay.PickUpDate)
-			_cabTripsPerDay.PickUpDate = t.Format("2006-01-02")
+		log.Printf("fetching data from db for ff %s values: %v", keyColumn, notInCache)
+		query := fmt.Sprintf("SELECT %s AS id, DATE(pickup_datetime) AS pickup_date, COUNT(pickup_datetime) AS total_trip_cnt FROM "+m.source+" WHERE %s IN (%s) AND DATE(pickup_datetime) = ? GROUP BY id, pickup_date",
+			keyColumn, keyColumn, placeholders(len(notInCache)))
+		args := append(stringArgs(notInCache), pickupDate)
 
-			m.addTripCountToSet(cabTripsPerDay, _cabTripsPerDay.CabID, _cabTripsPerDay.PickUpDate, _cabTripsPerDay.TripCount)
-			m.addTripCountToSet(m.cache.m, _cabTripsPerDay.CabID, _cabTripsPerDay.PickUpDate, _cabTripsPerDay.TripCount)
+		err := m.queryTripCounts(ctx, query, args, func(_tripsPerDay CabTripsPerDay) {
+			m.addTripCountToSet(fetched, _tripsPerDay.CabID, _tripsPerDay.PickUpDate, _tripsPerDay.TripCount)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// the cache is not locked while querying so that lookups of other IDs and dates can run concurrently
+		// counts fetched before the cache was cleared are returned but not cached
+		cache.Lock()
+		current := cache.generation == generation
+		refreshed := []string{}
+		for id, fetchedTripsPerDay := range fetched.CabTrips {
+			for date, tripCount := range fetchedTripsPerDay.TripsPerDay {
+				m.addTripCountToSet(tripsPerDay, id, date, tripCount)
+				if current && m.cacheTripCount(cache, id, date, tripCount) {
+					refreshed = append(refreshed, id)
+				}
+			}
 		}
+		m.notifyCacheRefreshed(cache, refreshed)
+		cache.Unlock()
 	}
 
-	return cabTripsPerDay, nil
+	return tripsPerDay, nil
 }
 
-// GetAllCabTrips returns number of trips per day on record for each cab
+// getAllTripCounts returns number of trips per day on record for each ID
+// cache: cache holding trip counts keyed by keyColumn values
+// keyColumn: column the trips are grouped by (e.g. 'medallion', 'hack_license')
 // ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
-func (m *MySQLDBContext) GetAllCabTrips(ignoreCache bool) (*pbdata.CabTripsPerDay, error) {
-	cabTripsPerDay := &pbdata.CabTripsPerDay{
+func (m *MySQLDBContext) getAllTripCounts(ctx context.Context, cache *Cache, keyColumn string, ignoreCache bool) (*pbdata.CabTripsPerDay, error) {
+	tripsPerDay := &pbdata.CabTripsPerDay{
 		CabTrips: make(map[string]*pbdata.TripsPerDay),
 	}
 
-	m.cache.Lock()
-	defer m.cache.Unlock()
+	cache.Lock()
+	defer cache.Unlock()
 
 	// if ignore cache or cach is empty, hit the db
-	if ignoreCache || len(m.cache.m.CabTrips) == 0 {
+	if ignoreCache || len(cache.m.CabTrips) == 0 {
 		log.Printf("getting data from db")
-		query := "select medallion as cab_id, DATE(pickup_datetime) as pickup_date, count(pickup_datetime) as total_trip_cnt from cab_trip_data group by medallion, pickup_date"
-		log.Printf("running query: [%s]", query)
-		results, err := m.db.Query(query)
+		query := fmt.Sprintf("SELECT %s AS id, DATE(pickup_datetime) AS pickup_date, COUNT(pickup_datetime) AS total_trip_cnt FROM "+m.source+" GROUP BY id, pickup_date", keyColumn)
+
+		err := m.queryTripCounts(ctx, query, nil, func(_tripsPerDay CabTripsPerDay) {
+			m.addTripCountToSet(tripsPerDay, _tripsPerDay.CabID, _tripsPerDay.PickUpDate, _tripsPerDay.TripCount)
+		})
 		if err != nil {
-			panic(err.Error())
+			return nil, err
 		}
 
-		for results.Next() {
-			var _cabTripsPerDay CabTripsPerDay
-			// for each row, scan the result into our tag composite object
-			err = results.Scan(&_cabTripsPerDay.CabID, &_cabTripsPerDay.PickUpDate, &_cabTripsPerDay.TripCount)
-			if err != nil {
-				panic(err.Error())
+		// the cache is only filled once all the rows are read, a cancelled query leaves it as is
+		refreshed := []string{}
+		for id, fetchedTripsPerDay := range tripsPerDay.CabTrips {
+			for date, tripCount := range fetchedTripsPerDay.TripsPerDay {
+				if m.cacheTripCount(cache, id, date, tripCount) {
+					refreshed = append(refreshed, id)
+				}
 			}
-
-			// format date to 'YYYY-MM-DD'
-			t, _ := time.Parse(time.RFC3339, _cabTripsPerDay.PickUpDate)
-			_cabTripsPerDay.PickUpDate = t.Format("2006-01-02")
-
-			m.addTripCountToSet(cabTripsPerDay, _cabTripsPerDay.CabID, _cabTripsPerDay.PickUpDate, _cabTripsPerDay.TripCount)
-			m.addTripCountToSet(m.cache.m, _cabTripsPerDay.CabID, _cabTripsPerDay.PickUpDate, _cabTripsPerDay.TripCount)
 		}
+		m.notifyCacheRefreshed(cache, refreshed)
 	} else {
 		log.Printf("returning cached data")
-		for cabID, tripsPerDay := range m.cache.m.CabTrips {
+		for id, cachedTripsPerDay := range cache.m.CabTrips {
 			copyTripsPerDay := &pbdata.TripsPerDay{
 				TripsPerDay: make(map[string]uint32),
 			}
 
-			for pickupDate, cnt := range tripsPerDay.TripsPerDay {
+			for pickupDate, cnt := range cachedTripsPerDay.TripsPerDay {
 				copyTripsPerDay.TripsPerDay[pickupDate] = cnt
 			}
-			cabTripsPerDay.CabTrips[cabID] = copyTripsPerDay
+			tripsPerDay.CabTrips[id] = copyTripsPerDay
+		}
+	}
+
+	return tripsPerDay, nil
+}
+
+// queryTripCounts runs a query returning (id, pickup_date, trip count) rows and calls onRow for each of them
+func (m *MySQLDBContext) queryTripCounts(ctx context.Context, query string, args []interface{}, onRow func(CabTripsPerDay)) error {
+	log.Printf("running query: [%s], args: %v", query, args)
+	results, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return queryError(ctx, fmt.Errorf("failed to run query: %v", err))
+	}
+	defer results.Close()
+
+	for results.Next() {
+		var _cabTripsPerDay CabTripsPerDay
+		// for each row, scan the result into our tag composite object
+		err = results.Scan(&_cabTripsPerDay.CabID, &_cabTripsPerDay.PickUpDate, &_cabTripsPerDay.TripCount)
+		if err != nil {
+			return queryError(ctx, fmt.Errorf("failed to scan row: %v", err))
 		}
+
+		// format date to 'YYYY-MM-DD'
+		t, _ := time.Parse(time.RFC3339, _cabTripsPerDay.PickUpDate)
+		_cabTripsPerDay.PickUpDate = t.Format("2006-01-02")
+
+		onRow(_cabTripsPerDay)
 	}
 
-	return cabTripsPerDay, nil
+	return queryError(ctx, results.Err())
 }
 
 func (m *MySQLDBContext) addTripCountToSet(set *pbdata.CabTripsPerDay, cabID, pickUpDate string, tripCount uint32) {
@@ -196,15 +287,116 @@ func (m *MySQLDBContext) addTripCountToSet(set *pbdata.CabTripsPerDay, cabID, pi
 	tripsPerDay.TripsPerDay[pickUpDate] = tripCount
 }
 
-// ClearCache clears the cache
-func (m *MySQLDBContext) ClearCache() (bool, error) {
-	m.cache.Lock()
-	defer m.cache.Unlock()
+// cacheTripCount caches the trip count of an ID on a pickup date, returns true if it replaces a different cached count
+// the cache must be locked
+func (m *MySQLDBContext) cacheTripCount(cache *Cache, id, pickUpDate string, tripCount uint32) bool {
+	previous, found := cache.m.CabTrips[id].GetTripsPerDay()[pickUpDate]
+	m.addTripCountToSet(cache.m, id, pickUpDate, tripCount)
+	return found && previous != tripCount
+}
 
-	log.Printf("clearing cache")
-	m.cache.m = &pbdata.CabTripsPerDay{
-		CabTrips: make(map[string]*pbdata.TripsPerDay),
+// notifyCacheRefreshed reports the IDs whose counts were replaced in the cache of cab trip counts, the driver cache is not reported
+func (m *MySQLDBContext) notifyCacheRefreshed(cache *Cache, refreshed []string) {
+	if cache != m.cache || len(refreshed) == 0 || m.onCacheRefreshed == nil {
+		return
 	}
+	m.onCacheRefreshed(sortedUnique(refreshed))
+}
+
+// cachedQuery returns the cached result for key, or calls fetch and caches its result
+// cached results are shared between callers and must not be modified
+// ignoreCache: true - always calls fetch and refreshes the cached result
+func (m *MySQLDBContext) cachedQuery(ctx context.Context, key string, ignoreCache bool, fetch func() (interface{}, error)) (interface{}, error) {
+	if !ignoreCache {
+		if result, found := m.queryCache.get(key); found {
+			log.Printf("returning cached data for [%.64s]", key)
+			return result, nil
+		}
+	}
+
+	generation := m.queryCache.currentGeneration()
+	result, err := fetch()
+	if err := queryError(ctx, err); err != nil {
+		return nil, err
+	}
+
+	m.queryCache.setSince(generation, key, result)
+
+	return result, nil
+}
+
+// queryError returns the error of ctx once it is done, so that a cancelled query is not mistaken for a failed one
+// and the rows read before it was cancelled, zero filled by the caller, are not cached
+// returns err otherwise
+func queryError(ctx context.Context, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	return err
+}
+
+// maxQueryIDs is the number of IDs of an 'IN (...)' list, keeping it well under the 65,535 placeholders of a MySQL statement
+const maxQueryIDs = 10000
+
+// chunkIDs splits ids into chunks of up to size IDs
+func chunkIDs(ids []string, size int) [][]string {
+	chunks := [][]string{}
+	for len(ids) > size {
+		chunks = append(chunks, ids[:size])
+		ids = ids[size:]
+	}
+	if len(ids) > 0 {
+		chunks = append(chunks, ids)
+	}
+	return chunks
+}
+
+// placeholders returns n comma separated '?' placeholders to be used in 'IN (...)' clauses
+func placeholders(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// stringArgs converts a list of strings into query arguments
+func stringArgs(values []string) []interface{} {
+	args := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		args = append(args, v)
+	}
+	return args
+}
+
+// TableUpdateTime returns the time of the last commit to the trip table of the dataset
+// MySQL only keeps it in memory for InnoDB tables, it is zero until the first commit after MySQL starts
+func (m *MySQLDBContext) TableUpdateTime(ctx context.Context) (time.Time, error) {
+	var updateTime sql.NullTime
+	err := m.db.QueryRowContext(ctx, "SELECT UPDATE_TIME FROM information_schema.TABLES WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ?",
+		m.dataset.Table).Scan(&updateTime)
+	if err == sql.ErrNoRows {
+		return time.Time{}, fmt.Errorf("table [%s] of dataset [%s] not found", m.dataset.Table, m.dataset.Name)
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return updateTime.Time, nil
+}
+
+// ClearCache clears the cache of the dataset
+func (m *MySQLDBContext) ClearCache() (bool, error) {
+	log.Printf("clearing cache of dataset [%s]", m.dataset.Name)
+	for _, cache := range []*Cache{m.cache, m.driverCache} {
+		cache.Lock()
+		cache.m = &pbdata.CabTripsPerDay{
+			CabTrips: make(map[string]*pbdata.TripsPerDay),
+		}
+		cache.generation++
+		cache.Unlock()
+	}
+
+	m.queryCache.clear()
 	log.Printf("cache cleared")
 
 	return true, nil
@@ -0,0 +1,167 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+// FleetID is the key of the fleet wide daily trip counts
+const FleetID = "fleet"
+
+// GetDailyTripCounts returns the number of trips per pickup date for each cab, or for the whole fleet keyed by FleetID if cabIDs is empty
+// dates without trips are reported with a zero count, the dates after the last pickup date of the table are not cached as they may still be imported
+// cabIDs: list of cab IDs to search, empty for the whole fleet
+// startDate: first pickup date, inclusive
+// endDate: last pickup date, inclusive
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetDailyTripCounts(ctx context.Context, cabIDs []string, startDate, endDate time.Time, ignoreCache bool) (*pbdata.CabTripsPerDay, error) {
+	lastDate, err := m.lastPickupDate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cabIDs) == 0 {
+		return m.getFleetDailyTripCounts(ctx, startDate, endDate, lastDate, ignoreCache)
+	}
+
+	dates := datesBetween(startDate, endDate)
+	cabTripsPerDay := &pbdata.CabTripsPerDay{
+		CabTrips: make(map[string]*pbdata.TripsPerDay),
+	}
+
+	// cab trip counts share the cache of GetTripCountsForCabsByPickupDate, only cabs with missing dates are fetched from DB
+	notInCache := []string{}
+	m.cache.RLock()
+	generation := m.cache.generation
+	for _, cabID := range sortedUnique(cabIDs) {
+		cached := m.cache.m.CabTrips[cabID]
+		found := !ignoreCache
+		for _, date := range dates {
+			if _, cachedOnDate := cached.GetTripsPerDay()[date]; !cachedOnDate {
+				found = false
+				break
+			}
+		}
+		if !found {
+			notInCache = append(notInCache, cabID)
+			continue
+		}
+
+		for _, date := range dates {
+			m.addTripCountToSet(cabTripsPerDay, cabID, date, cached.TripsPerDay[date])
+		}
+	}
+	m.cache.RUnlock()
+
+	if len(notInCache) > 0 {
+		fetched := &pbdata.CabTripsPerDay{
+			CabTrips: make(map[string]*pbdata.TripsPerDay),
+		}
+		for _, cabID := range notInCache {
+			for _, date := range dates {
+				m.addTripCountToSet(fetched, cabID, date, 0)
+			}
+		}
+
+		log.Println("fetching daily trip counts from db for ff cabIDs: ", notInCache)
+		query := fmt.Sprintf("SELECT medallion AS id, DATE(pickup_datetime) AS pickup_date, COUNT(pickup_datetime) AS total_trip_cnt FROM "+m.source+
+			" WHERE medallion IN (%s) AND pickup_datetime >= ? AND pickup_datetime < ? GROUP BY id, pickup_date", placeholders(len(notInCache)))
+		args := append(stringArgs(notInCache), startDate, endDate.AddDate(0, 0, 1))
+
+		err := m.queryTripCounts(ctx, query, args, func(_tripsPerDay CabTripsPerDay) {
+			m.addTripCountToSet(fetched, _tripsPerDay.CabID, _tripsPerDay.PickUpDate, _tripsPerDay.TripCount)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// like trip counts by pickup date, the cache is not locked while querying and is only filled once all the rows are read
+		// counts fetched before the cache was cleared are returned but not cached
+		m.cache.Lock()
+		current := m.cache.generation == generation
+		refreshed := []string{}
+		for cabID, tripsPerDay := range fetched.CabTrips {
+			for date, tripCount := range tripsPerDay.TripsPerDay {
+				m.addTripCountToSet(cabTripsPerDay, cabID, date, tripCount)
+				if current && date <= lastDate && m.cacheTripCount(m.cache, cabID, date, tripCount) {
+					refreshed = append(refreshed, cabID)
+				}
+			}
+		}
+		m.notifyCacheRefreshed(m.cache, refreshed)
+		m.cache.Unlock()
+	}
+
+	return cabTripsPerDay, nil
+}
+
+func (m *MySQLDBContext) getFleetDailyTripCounts(ctx context.Context, startDate, endDate time.Time, lastDate string, ignoreCache bool) (*pbdata.CabTripsPerDay, error) {
+	fetch := func() (interface{}, error) {
+		fleetTripsPerDay := &pbdata.CabTripsPerDay{
+			CabTrips: make(map[string]*pbdata.TripsPerDay),
+		}
+		for _, date := range datesBetween(startDate, endDate) {
+			m.addTripCountToSet(fleetTripsPerDay, FleetID, date, 0)
+		}
+
+		query := "SELECT ? AS id, DATE(pickup_datetime) AS pickup_date, COUNT(pickup_datetime) AS total_trip_cnt FROM " + m.source +
+			" WHERE pickup_datetime >= ? AND pickup_datetime < ? GROUP BY pickup_date"
+		args := []interface{}{FleetID, startDate, endDate.AddDate(0, 0, 1)}
+
+		err := m.queryTripCounts(ctx, query, args, func(_tripsPerDay CabTripsPerDay) {
+			m.addTripCountToSet(fleetTripsPerDay, FleetID, _tripsPerDay.PickUpDate, _tripsPerDay.TripCount)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return fleetTripsPerDay, nil
+	}
+
+	var result interface{}
+	var err error
+	if endDate.Format("2006-01-02") > lastDate {
+		result, err = fetch()
+	} else {
+		key := fmt.Sprintf("fleet_daily:%s:%s", startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+		result, err = m.cachedQuery(ctx, key, ignoreCache, fetch)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*pbdata.CabTripsPerDay), nil
+}
+
+// LastPickupDate returns the last pickup date of the table formatted as 'YYYY-MM-DD', empty if the table has no trips
+// daily trip counts are only cached up to this date, trips imported since are on or after it
+func (m *MySQLDBContext) LastPickupDate(ctx context.Context) (string, error) {
+	return m.lastPickupDate(ctx)
+}
+
+// lastPickupDate returns the last pickup date of the table formatted as 'YYYY-MM-DD', empty if the table has no trips
+// the date is cached until the cache is cleared, which only delays caching the daily trip counts of dates imported since
+func (m *MySQLDBContext) lastPickupDate(ctx context.Context) (string, error) {
+	result, err := m.cachedQuery(ctx, "last_pickup_date", false, func() (interface{}, error) {
+		var lastPickup sql.NullTime
+		query := "SELECT MAX(pickup_datetime) FROM " + m.source
+		log.Printf("running query: [%s]", query)
+		if err := m.db.QueryRowContext(ctx, query).Scan(&lastPickup); err != nil {
+			return nil, fmt.Errorf("failed to run query: %v", err)
+		}
+		if !lastPickup.Valid {
+			return "", nil
+		}
+		return lastPickup.Time.Format("2006-01-02"), nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return result.(string), nil
+}
@@ -0,0 +1,111 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// dailyTripCountsQueries returns the queries of a table whose last pickup is on 2013-12-02, cab A having trips on 2013-12-01 and 2013-12-02
+func dailyTripCountsQueries(wait chan struct{}) []fakeQuery {
+	return []fakeQuery{
+		{
+			match:   "SELECT MAX(pickup_datetime)",
+			columns: []string{"MAX(pickup_datetime)"},
+			rows:    [][]driver.Value{{time.Date(2013, 12, 2, 23, 0, 0, 0, time.UTC)}},
+		},
+		{
+			match:   "WHERE medallion IN",
+			columns: []string{"id", "pickup_date", "total_trip_cnt"},
+			rows: [][]driver.Value{
+				{"A", time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC), int64(3)},
+				{"A", time.Date(2013, 12, 2, 0, 0, 0, 0, time.UTC), int64(5)},
+			},
+			wait: wait,
+		},
+	}
+}
+
+func TestGetDailyTripCounts(t *testing.T) {
+	m, fake := newFakeDBContext(t, YellowDataset, dailyTripCountsQueries(nil)...)
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 2)
+
+	// dates without trips count as zero, the date after the last pickup date is fetched again
+	want := map[string]map[string]uint32{
+		"A": {"2013-12-01": 3, "2013-12-02": 5, "2013-12-03": 0},
+		"B": {"2013-12-01": 0, "2013-12-02": 0, "2013-12-03": 0},
+	}
+	for i, wantQueries := range []int{1, 2} {
+		counts, err := m.GetDailyTripCounts(context.Background(), []string{"B", "A", "B"}, start, end, false)
+		if err != nil {
+			t.Fatalf("GetDailyTripCounts() = %v", err)
+		}
+		got := make(map[string]map[string]uint32)
+		for cabID, tripsPerDay := range counts.CabTrips {
+			got[cabID] = tripsPerDay.TripsPerDay
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("call %d, GetDailyTripCounts() = %v, want %v", i, got, want)
+		}
+		if queries := fake.queriesRan("WHERE medallion IN"); queries != wantQueries {
+			t.Errorf("call %d, queries = %d, want %d", i, queries, wantQueries)
+		}
+	}
+
+	wantCached := map[string]uint32{"2013-12-01": 3, "2013-12-02": 5}
+	if cached := m.cache.m.CabTrips["A"].GetTripsPerDay(); !reflect.DeepEqual(cached, wantCached) {
+		t.Errorf("cached counts of A = %v, want %v", cached, wantCached)
+	}
+
+	// dates up to the last pickup date are served from the cache
+	if _, err := m.GetDailyTripCounts(context.Background(), []string{"A", "B"}, start, start.AddDate(0, 0, 1), false); err != nil {
+		t.Fatalf("GetDailyTripCounts() = %v", err)
+	}
+	if queries := fake.queriesRan("WHERE medallion IN"); queries != 2 {
+		t.Errorf("queries after a cached range = %d, want 2", queries)
+	}
+}
+
+func TestGetDailyTripCountsQueriesWithoutLockingTheCache(t *testing.T) {
+	release := make(chan struct{})
+	m, fake := newFakeDBContext(t, YellowDataset, dailyTripCountsQueries(release)...)
+	m.cacheTripCount(m.cache, "B", "2013-12-01", 7)
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := m.GetDailyTripCounts(context.Background(), []string{"A"}, start, start.AddDate(0, 0, 1), false)
+		done <- err
+	}()
+	for fake.queriesRan("WHERE medallion IN") == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// cached lookups are answered while the daily counts are queried
+	lookup := make(chan uint32, 1)
+	go func() {
+		counts, _ := m.GetTripCountsForCabsByPickupDate(context.Background(), []string{"B"}, "2013-12-01", false)
+		lookup <- counts.GetCabTrips()["B"].GetTripsPerDay()["2013-12-01"]
+	}()
+	select {
+	case count := <-lookup:
+		if count != 7 {
+			t.Errorf("cached count of B = %d, want 7", count)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("cached lookup blocked by the daily trip counts query")
+	}
+
+	// counts fetched before the cache is cleared are not cached
+	m.ClearCache()
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("GetDailyTripCounts() = %v", err)
+	}
+	if cached, found := m.cache.m.CabTrips["A"]; found {
+		t.Errorf("counts fetched before clearing the cache cached: %v", cached)
+	}
+}
@@ -0,0 +1,121 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+// CabDriverPair is used for unmarhalling row bytes from cab/driver mapping query
+type CabDriverPair struct {
+	CabID       string `json:"cab_id"`
+	HackLicense string `json:"hack_license"`
+}
+
+// GetTripCountsForDriversByPickupDate returns the total number of trips the driver has made based on pickup_datetime column with time ignored
+// hackLicenses: list of hack licenses to search
+// pickupDate: pickup date in 'YYYY-MM-DD' format
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetTripCountsForDriversByPickupDate(ctx context.Context, hackLicenses []string, pickupDate string, ignoreCache bool) (*pbdata.DriverTripsPerDay, error) {
+	tripsPerDay, err := m.getTripCountsByPickupDate(ctx, m.driverCache, "hack_license", hackLicenses, pickupDate, ignoreCache)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbdata.DriverTripsPerDay{
+		DriverTrips: tripsPerDay.CabTrips,
+	}, nil
+}
+
+// GetAllDriverTrips returns number of trips per day on record for each driver
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetAllDriverTrips(ctx context.Context, ignoreCache bool) (*pbdata.DriverTripsPerDay, error) {
+	tripsPerDay, err := m.getAllTripCounts(ctx, m.driverCache, "hack_license", ignoreCache)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbdata.DriverTripsPerDay{
+		DriverTrips: tripsPerDay.CabTrips,
+	}, nil
+}
+
+// GetCabDriverMapping returns which drivers drove which cabs on the given pickup date and vice versa
+// cabIDs: optional list of cab IDs to limit the mapping to
+// hackLicenses: optional list of hack licenses to limit the mapping to
+// pickupDate: pickup date in 'YYYY-MM-DD' format
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetCabDriverMapping(ctx context.Context, cabIDs, hackLicenses []string, pickupDate string, ignoreCache bool) (*pbdata.CabDriverMapping, error) {
+	// the pairs of a date are cached once for all filters, the query cache bounds them by rows like the other aggregations
+	result, err := m.cachedQuery(ctx, "cab_driver_mapping:"+pickupDate, ignoreCache, func() (interface{}, error) {
+		query := "SELECT DISTINCT medallion AS cab_id, hack_license FROM " + m.source + " WHERE DATE(pickup_datetime) = ?"
+		log.Printf("running query: [%s], args: [%s]", query, pickupDate)
+		results, err := m.db.QueryContext(ctx, query, pickupDate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %v", err)
+		}
+		defer results.Close()
+
+		pairs := []CabDriverPair{}
+		for results.Next() {
+			var pair CabDriverPair
+			if err := results.Scan(&pair.CabID, &pair.HackLicense); err != nil {
+				return nil, fmt.Errorf("failed to scan row: %v", err)
+			}
+			pairs = append(pairs, pair)
+		}
+
+		return pairs, results.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	pairs := result.([]CabDriverPair)
+
+	return buildCabDriverMapping(pairs, cabIDs, hackLicenses), nil
+}
+
+// buildCabDriverMapping groups the pairs by cab and by driver, keeping only the pairs that match the (optional) filters
+func buildCabDriverMapping(pairs []CabDriverPair, cabIDs, hackLicenses []string) *pbdata.CabDriverMapping {
+	cabFilter := toSet(cabIDs)
+	driverFilter := toSet(hackLicenses)
+
+	mapping := &pbdata.CabDriverMapping{
+		DriversPerCab: make(map[string]*pbdata.IDList),
+		CabsPerDriver: make(map[string]*pbdata.IDList),
+	}
+
+	for _, pair := range pairs {
+		if len(cabFilter) > 0 && !cabFilter[pair.CabID] {
+			continue
+		}
+		if len(driverFilter) > 0 && !driverFilter[pair.HackLicense] {
+			continue
+		}
+
+		addIDToList(mapping.DriversPerCab, pair.CabID, pair.HackLicense)
+		addIDToList(mapping.CabsPerDriver, pair.HackLicense, pair.CabID)
+	}
+
+	return mapping
+}
+
+func addIDToList(set map[string]*pbdata.IDList, key, id string) {
+	list := set[key]
+	if list == nil {
+		list = &pbdata.IDList{}
+		set[key] = list
+	}
+
+	list.Ids = append(list.Ids, id)
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
@@ -0,0 +1,106 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+func TestGetCabDriverMapping(t *testing.T) {
+	m, fake := newFakeDBContext(t, YellowDataset, fakeQuery{
+		match:   "SELECT DISTINCT medallion",
+		columns: []string{"cab_id", "hack_license"},
+		rows:    [][]driver.Value{{"A", "D1"}, {"A", "D2"}, {"B", "D1"}},
+	})
+
+	tests := []struct {
+		name         string
+		cabIDs       []string
+		hackLicenses []string
+		ignoreCache  bool
+		want         *pbdata.CabDriverMapping
+		wantQueries  int
+	}{
+		{
+			name: "all pairs",
+			want: &pbdata.CabDriverMapping{
+				DriversPerCab: map[string]*pbdata.IDList{"A": {Ids: []string{"D1", "D2"}}, "B": {Ids: []string{"D1"}}},
+				CabsPerDriver: map[string]*pbdata.IDList{"D1": {Ids: []string{"A", "B"}}, "D2": {Ids: []string{"A"}}},
+			},
+			wantQueries: 1,
+		},
+		{
+			// filters are applied to the cached pairs
+			name:   "filtered by cab",
+			cabIDs: []string{"B"},
+			want: &pbdata.CabDriverMapping{
+				DriversPerCab: map[string]*pbdata.IDList{"B": {Ids: []string{"D1"}}},
+				CabsPerDriver: map[string]*pbdata.IDList{"D1": {Ids: []string{"B"}}},
+			},
+			wantQueries: 1,
+		},
+		{
+			name:         "filtered by driver",
+			hackLicenses: []string{"D2"},
+			want: &pbdata.CabDriverMapping{
+				DriversPerCab: map[string]*pbdata.IDList{"A": {Ids: []string{"D2"}}},
+				CabsPerDriver: map[string]*pbdata.IDList{"D2": {Ids: []string{"A"}}},
+			},
+			wantQueries: 1,
+		},
+		{
+			name:         "ignore cache",
+			hackLicenses: []string{"D2"},
+			ignoreCache:  true,
+			want: &pbdata.CabDriverMapping{
+				DriversPerCab: map[string]*pbdata.IDList{"A": {Ids: []string{"D2"}}},
+				CabsPerDriver: map[string]*pbdata.IDList{"D2": {Ids: []string{"A"}}},
+			},
+			wantQueries: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := m.GetCabDriverMapping(context.Background(), test.cabIDs, test.hackLicenses, "2013-12-01", test.ignoreCache)
+			if err != nil {
+				t.Fatalf("GetCabDriverMapping() = %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("GetCabDriverMapping() = %v, want %v", got, test.want)
+			}
+			if queries := fake.queriesRan("SELECT DISTINCT medallion"); queries != test.wantQueries {
+				t.Errorf("queries = %d, want %d", queries, test.wantQueries)
+			}
+		})
+	}
+
+	// the pairs are held by the bounded query cache, and cleared with it
+	if _, found := m.queryCache.get("cab_driver_mapping:2013-12-01"); !found {
+		t.Error("pairs not in the query cache")
+	}
+	m.ClearCache()
+	if _, found := m.queryCache.get("cab_driver_mapping:2013-12-01"); found {
+		t.Error("pairs still cached after ClearCache")
+	}
+}
+
+func TestGetCabDriverMappingTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	m, _ := newFakeDBContext(t, YellowDataset, fakeQuery{match: "SELECT DISTINCT medallion", wait: release})
+
+	// a timed out query reports the context error, and is not cached
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := m.GetCabDriverMapping(ctx, nil, nil, "2013-12-01", false); err != context.DeadlineExceeded {
+		t.Errorf("GetCabDriverMapping() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if _, found := m.queryCache.get("cab_driver_mapping:2013-12-01"); found {
+		t.Error("timed out query cached")
+	}
+}
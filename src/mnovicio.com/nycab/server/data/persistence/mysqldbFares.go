@@ -0,0 +1,274 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+// cardPaymentType is the payment type of trips paid by card, the only ones with recorded tips
+const cardPaymentType = "CRD"
+
+// fareSource returns the trips of the dataset joined to their fares
+// columns of both tables are qualified with the trip table name and 'fare'
+func (m *MySQLDBContext) fareSource() string {
+	trips := m.dataset.Table
+	return fmt.Sprintf("%s JOIN %s AS fare ON fare.medallion = %s.medallion AND fare.hack_license = %s.hack_license AND fare.pickup_datetime = %s.pickup_datetime",
+		m.source, m.dataset.FareTable, trips, trips, trips)
+}
+
+// GetCabRevenue returns the fares collected by each cab on each day of the date range, ordered by cab ID and date
+// days without trips are reported with zero values
+// cabIDs: list of cab IDs to search
+// startDate: first pickup date, inclusive
+// endDate: last pickup date, inclusive
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetCabRevenue(ctx context.Context, cabIDs []string, startDate, endDate time.Time, ignoreCache bool) ([]*pbdata.CabRevenue, error) {
+	ids := sortedUnique(cabIDs)
+	key := fmt.Sprintf("revenue:%s:%s:%s", strings.Join(ids, ","), startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+	result, err := m.cachedQuery(ctx, key, ignoreCache, func() (interface{}, error) {
+		dates := datesBetween(startDate, endDate)
+		revenuePerCab := make(map[string]map[string]*pbdata.CabRevenue, len(ids))
+		for _, cabID := range ids {
+			revenuePerCab[cabID] = make(map[string]*pbdata.CabRevenue, len(dates))
+			for _, date := range dates {
+				revenuePerCab[cabID][date] = &pbdata.CabRevenue{CabId: cabID, Date: date}
+			}
+		}
+
+		trips := m.dataset.Table
+		query := fmt.Sprintf("SELECT %s.medallion AS cab_id, DATE(%s.pickup_datetime) AS pickup_date, COUNT(*) AS total_trip_cnt,"+
+			" COALESCE(SUM(fare_amount), 0), COALESCE(SUM(surcharge), 0), COALESCE(SUM(mta_tax), 0),"+
+			" COALESCE(SUM(tip_amount), 0), COALESCE(SUM(tolls_amount), 0), COALESCE(SUM(total_amount), 0)"+
+			" FROM %s WHERE %s.medallion IN (%s) AND %s.pickup_datetime >= ? AND %s.pickup_datetime < ?"+
+			" GROUP BY cab_id, pickup_date",
+			trips, trips, m.fareSource(), trips, placeholders(len(ids)), trips, trips)
+		args := append(stringArgs(ids), startDate, endDate.AddDate(0, 0, 1))
+
+		log.Printf("running query: [%s], args: %v", query, args)
+		results, err := m.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %v", err)
+		}
+		defer results.Close()
+
+		for results.Next() {
+			var cabID string
+			var pickupDate time.Time
+			var revenue pbdata.CabRevenue
+			err := results.Scan(&cabID, &pickupDate, &revenue.TripCount,
+				&revenue.FareAmount, &revenue.Surcharge, &revenue.MtaTax, &revenue.TipAmount, &revenue.TollsAmount, &revenue.TotalAmount)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan row: %v", err)
+			}
+
+			cabRevenue := revenuePerCab[cabID][pickupDate.Format("2006-01-02")]
+			if cabRevenue == nil {
+				continue
+			}
+			revenue.CabId = cabRevenue.CabId
+			revenue.Date = cabRevenue.Date
+			*cabRevenue = revenue
+		}
+		if err := results.Err(); err != nil {
+			return nil, err
+		}
+
+		revenue := make([]*pbdata.CabRevenue, 0, len(ids)*len(dates))
+		for _, cabID := range ids {
+			for _, date := range dates {
+				revenue = append(revenue, revenuePerCab[cabID][date])
+			}
+		}
+		return revenue, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*pbdata.CabRevenue), nil
+}
+
+// GetTipRates returns the tip rate of the trips paid by card for the whole fleet and for each cab
+// cabIDs: list of cab IDs to search, only the fleet tip rate is returned if empty
+// startDate: first pickup date, inclusive
+// endDate: last pickup date, inclusive
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetTipRates(ctx context.Context, cabIDs []string, startDate, endDate time.Time, ignoreCache bool) (*pbdata.TipRate, []*pbdata.TipRate, error) {
+	columns := "COUNT(*) AS total_trip_cnt," +
+		" COALESCE(SUM(payment_type = '" + cardPaymentType + "'), 0) AS card_trip_cnt," +
+		" COALESCE(SUM(payment_type = '" + cardPaymentType + "' AND tip_amount > 0), 0) AS tipped_trip_cnt," +
+		" COALESCE(SUM(CASE WHEN payment_type = '" + cardPaymentType + "' THEN tip_amount END), 0) AS tip_amount," +
+		" COALESCE(SUM(CASE WHEN payment_type = '" + cardPaymentType + "' THEN fare_amount END), 0) AS fare_amount"
+
+	fleet, cabs, err := m.fleetAndCabFares(ctx, "tips", columns, "", cabIDs, startDate, endDate, ignoreCache, func(ids []string, query string, args []interface{}) (interface{}, error) {
+		return m.queryTipRates(ctx, query, args, ids)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fleet.([]*pbdata.TipRate)[0], cabs.([]*pbdata.TipRate), nil
+}
+
+// GetPaymentTypeMix returns the number of trips per payment type for the whole fleet and for each cab
+// cabIDs: list of cab IDs to search, only the fleet payment type mix is returned if empty
+// startDate: first pickup date, inclusive
+// endDate: last pickup date, inclusive
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetPaymentTypeMix(ctx context.Context, cabIDs []string, startDate, endDate time.Time, ignoreCache bool) (*pbdata.PaymentTypeMix, []*pbdata.PaymentTypeMix, error) {
+	columns := "COALESCE(payment_type, '') AS payment_type, COUNT(*) AS total_trip_cnt, COALESCE(SUM(total_amount), 0) AS total_amount"
+
+	fleet, cabs, err := m.fleetAndCabFares(ctx, "payments", columns, "payment_type", cabIDs, startDate, endDate, ignoreCache, func(ids []string, query string, args []interface{}) (interface{}, error) {
+		return m.queryPaymentTypes(ctx, query, args, ids)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fleet.([]*pbdata.PaymentTypeMix)[0], cabs.([]*pbdata.PaymentTypeMix), nil
+}
+
+// fleetAndCabFares runs a fare aggregation for the whole fleet, then for each cab if cabIDs is not empty
+// each query selects an 'id' column followed by columns, grouped by id and groupBy if not empty
+// fetch scans the results of a query into one entry per ID in the order of ids
+func (m *MySQLDBContext) fleetAndCabFares(ctx context.Context, name, columns, groupBy string, cabIDs []string, startDate, endDate time.Time, ignoreCache bool,
+	fetch func(ids []string, query string, args []interface{}) (interface{}, error)) (interface{}, interface{}, error) {
+	trips := m.dataset.Table
+	dateRange := fmt.Sprintf("%s:%s", startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+	groupColumns := "id"
+	if groupBy != "" {
+		groupColumns += ", " + groupBy
+	}
+
+	fleet, err := m.cachedQuery(ctx, name+":"+FleetID+":"+dateRange, ignoreCache, func() (interface{}, error) {
+		query := "SELECT ? AS id, " + columns + " FROM " + m.fareSource() +
+			" WHERE " + trips + ".pickup_datetime >= ? AND " + trips + ".pickup_datetime < ? GROUP BY " + groupColumns
+		args := []interface{}{FleetID, startDate, endDate.AddDate(0, 0, 1)}
+
+		return fetch([]string{FleetID}, query, args)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids := sortedUnique(cabIDs)
+	cabs, err := m.cachedQuery(ctx, name+":"+strings.Join(ids, ",")+":"+dateRange, ignoreCache, func() (interface{}, error) {
+		if len(ids) == 0 {
+			return fetch(ids, "", nil)
+		}
+
+		query := fmt.Sprintf("SELECT %s.medallion AS id, %s FROM %s WHERE %s.medallion IN (%s) AND %s.pickup_datetime >= ? AND %s.pickup_datetime < ? GROUP BY %s",
+			trips, columns, m.fareSource(), trips, placeholders(len(ids)), trips, trips, groupColumns)
+		args := append(stringArgs(ids), startDate, endDate.AddDate(0, 0, 1))
+
+		return fetch(ids, query, args)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fleet, cabs, nil
+}
+
+// queryTipRates runs a query returning (id, trips, card trips, tipped card trips, tip amount, fare amount) rows
+// returns one tip rate per ID in the order of ids, IDs without trips have zero values
+func (m *MySQLDBContext) queryTipRates(ctx context.Context, query string, args []interface{}, ids []string) ([]*pbdata.TipRate, error) {
+	tipRates := make(map[string]*pbdata.TipRate, len(ids))
+	for _, id := range ids {
+		tipRates[id] = &pbdata.TipRate{CabId: id}
+	}
+
+	if query != "" {
+		log.Printf("running query: [%s], args: %v", query, args)
+		results, err := m.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %v", err)
+		}
+		defer results.Close()
+
+		for results.Next() {
+			var id string
+			var tipRate pbdata.TipRate
+			if err := results.Scan(&id, &tipRate.Trips, &tipRate.CardTrips, &tipRate.TippedCardTrips, &tipRate.TipAmount, &tipRate.FareAmount); err != nil {
+				return nil, fmt.Errorf("failed to scan row: %v", err)
+			}
+			if _, found := tipRates[id]; found {
+				tipRate.CabId = id
+				tipRates[id] = &tipRate
+			}
+		}
+		if err := results.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	rates := make([]*pbdata.TipRate, 0, len(ids))
+	for _, id := range ids {
+		tipRate := tipRates[id]
+		if tipRate.FareAmount > 0 {
+			tipRate.TipRate = tipRate.TipAmount / tipRate.FareAmount
+		}
+		if tipRate.CardTrips > 0 {
+			tipRate.TippedShare = float64(tipRate.TippedCardTrips) / float64(tipRate.CardTrips)
+		}
+		rates = append(rates, tipRate)
+	}
+	return rates, nil
+}
+
+// queryPaymentTypes runs a query returning (id, payment type, trips, total amount) rows
+// returns one payment type mix per ID in the order of ids, IDs without trips have an empty mix
+func (m *MySQLDBContext) queryPaymentTypes(ctx context.Context, query string, args []interface{}, ids []string) ([]*pbdata.PaymentTypeMix, error) {
+	mixes := make(map[string]*pbdata.PaymentTypeMix, len(ids))
+	for _, id := range ids {
+		mixes[id] = &pbdata.PaymentTypeMix{
+			CabId:        id,
+			PaymentTypes: []*pbdata.PaymentTypeShare{},
+		}
+	}
+
+	if query != "" {
+		log.Printf("running query: [%s], args: %v", query, args)
+		results, err := m.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %v", err)
+		}
+		defer results.Close()
+
+		for results.Next() {
+			var id string
+			share := &pbdata.PaymentTypeShare{}
+			if err := results.Scan(&id, &share.PaymentType, &share.Trips, &share.TotalAmount); err != nil {
+				return nil, fmt.Errorf("failed to scan row: %v", err)
+			}
+			if mix, found := mixes[id]; found {
+				mix.TotalTrips += share.Trips
+				mix.PaymentTypes = append(mix.PaymentTypes, share)
+			}
+		}
+		if err := results.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	paymentTypeMixes := make([]*pbdata.PaymentTypeMix, 0, len(ids))
+	for _, id := range ids {
+		mix := mixes[id]
+		sort.Slice(mix.PaymentTypes, func(i, j int) bool {
+			return mix.PaymentTypes[i].PaymentType < mix.PaymentTypes[j].PaymentType
+		})
+		if mix.TotalTrips > 0 {
+			for _, share := range mix.PaymentTypes {
+				share.Share = float64(share.Trips) / float64(mix.TotalTrips)
+			}
+		}
+		paymentTypeMixes = append(paymentTypeMixes, mix)
+	}
+	return paymentTypeMixes, nil
+}
@@ -0,0 +1,163 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+func TestGetCabRevenue(t *testing.T) {
+	m, fake := newFakeDBContext(t, YellowDataset, fakeQuery{
+		match:   "JOIN cab_trip_fare AS fare",
+		columns: []string{"cab_id", "pickup_date", "total_trip_cnt", "fare_amount", "surcharge", "mta_tax", "tip_amount", "tolls_amount", "total_amount"},
+		rows: [][]driver.Value{
+			{"A", time.Date(2013, 12, 2, 0, 0, 0, 0, time.UTC), int64(2), 20.0, 1.0, 1.0, 4.0, 5.33, 31.33},
+			// cabs not requested are ignored
+			{"C", time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC), int64(1), 10.0, 0.0, 0.5, 0.0, 0.0, 10.5},
+		},
+	})
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		revenue, err := m.GetCabRevenue(context.Background(), []string{"B", "A"}, start, start.AddDate(0, 0, 1), false)
+		if err != nil {
+			t.Fatalf("GetCabRevenue() = %v", err)
+		}
+
+		// ordered by cab ID and date, days without trips have zero values
+		want := []*pbdata.CabRevenue{
+			{CabId: "A", Date: "2013-12-01"},
+			{CabId: "A", Date: "2013-12-02", TripCount: 2, FareAmount: 20, Surcharge: 1, MtaTax: 1, TipAmount: 4, TollsAmount: 5.33, TotalAmount: 31.33},
+			{CabId: "B", Date: "2013-12-01"},
+			{CabId: "B", Date: "2013-12-02"},
+		}
+		if !reflect.DeepEqual(revenue, want) {
+			t.Errorf("GetCabRevenue() = %v, want %v", revenue, want)
+		}
+	}
+
+	// the second call is served from the cache
+	if queries := fake.queriesRan("JOIN cab_trip_fare AS fare"); queries != 1 {
+		t.Errorf("queries = %d, want 1", queries)
+	}
+}
+
+func TestGetTipRates(t *testing.T) {
+	columns := []string{"id", "total_trip_cnt", "card_trip_cnt", "tipped_trip_cnt", "tip_amount", "fare_amount"}
+	m, fake := newFakeDBContext(t, YellowDataset,
+		fakeQuery{
+			match:   "SELECT ? AS id",
+			columns: columns,
+			rows:    [][]driver.Value{{FleetID, int64(10), int64(4), int64(3), 6.0, 40.0}},
+		},
+		fakeQuery{
+			match:   "SELECT cab_trip_data.medallion AS id",
+			columns: columns,
+			// cab B only paid cash
+			rows: [][]driver.Value{{"A", int64(5), int64(2), int64(1), 2.0, 25.0}, {"B", int64(3), int64(0), int64(0), 0.0, 0.0}},
+		},
+	)
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		cabIDs      []string
+		wantCabs    []*pbdata.TipRate
+		wantQueries int
+	}{
+		{"fleet", nil, []*pbdata.TipRate{}, 1},
+		{
+			name:   "cabs",
+			cabIDs: []string{"C", "B", "A"},
+			// cabs without card trips have zero rates
+			wantCabs: []*pbdata.TipRate{
+				{CabId: "A", Trips: 5, CardTrips: 2, TippedCardTrips: 1, TipAmount: 2, FareAmount: 25, TipRate: 0.08, TippedShare: 0.5},
+				{CabId: "B", Trips: 3},
+				{CabId: "C"},
+			},
+			wantQueries: 2,
+		},
+		{
+			name:        "cabs cached",
+			cabIDs:      []string{"A", "B", "C"},
+			wantCabs:    []*pbdata.TipRate{{CabId: "A", Trips: 5, CardTrips: 2, TippedCardTrips: 1, TipAmount: 2, FareAmount: 25, TipRate: 0.08, TippedShare: 0.5}, {CabId: "B", Trips: 3}, {CabId: "C"}},
+			wantQueries: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fleet, cabs, err := m.GetTipRates(context.Background(), test.cabIDs, start, start.AddDate(0, 0, 6), false)
+			if err != nil {
+				t.Fatalf("GetTipRates() = %v", err)
+			}
+
+			wantFleet := &pbdata.TipRate{CabId: FleetID, Trips: 10, CardTrips: 4, TippedCardTrips: 3, TipAmount: 6, FareAmount: 40, TipRate: 0.15, TippedShare: 0.75}
+			if !reflect.DeepEqual(fleet, wantFleet) {
+				t.Errorf("fleet = %v, want %v", fleet, wantFleet)
+			}
+			if !reflect.DeepEqual(cabs, test.wantCabs) {
+				t.Errorf("cabs = %v, want %v", cabs, test.wantCabs)
+			}
+			if queries := len(fake.ran); queries != test.wantQueries {
+				t.Errorf("queries = %d, want %d", queries, test.wantQueries)
+			}
+		})
+	}
+}
+
+func TestGetPaymentTypeMix(t *testing.T) {
+	columns := []string{"id", "payment_type", "total_trip_cnt", "total_amount"}
+	m, _ := newFakeDBContext(t, YellowDataset,
+		fakeQuery{
+			match:   "SELECT ? AS id",
+			columns: columns,
+			rows:    [][]driver.Value{{FleetID, "CSH", int64(6), 60.0}, {FleetID, "CRD", int64(3), 45.0}, {FleetID, "", int64(1), 5.0}},
+		},
+		fakeQuery{
+			match:   "SELECT cab_trip_data.medallion AS id",
+			columns: columns,
+			rows:    [][]driver.Value{{"A", "NOC", int64(1), 0.0}, {"A", "CRD", int64(3), 45.0}},
+		},
+	)
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	fleet, cabs, err := m.GetPaymentTypeMix(context.Background(), []string{"B", "A"}, start, start.AddDate(0, 0, 6), false)
+	if err != nil {
+		t.Fatalf("GetPaymentTypeMix() = %v", err)
+	}
+
+	// payment types are ordered, trips without payment type have an empty one
+	wantFleet := &pbdata.PaymentTypeMix{
+		CabId:      FleetID,
+		TotalTrips: 10,
+		PaymentTypes: []*pbdata.PaymentTypeShare{
+			{PaymentType: "", Trips: 1, TotalAmount: 5, Share: 0.1},
+			{PaymentType: "CRD", Trips: 3, TotalAmount: 45, Share: 0.3},
+			{PaymentType: "CSH", Trips: 6, TotalAmount: 60, Share: 0.6},
+		},
+	}
+	if !reflect.DeepEqual(fleet, wantFleet) {
+		t.Errorf("fleet = %v, want %v", fleet, wantFleet)
+	}
+
+	// cabs without trips have an empty mix
+	wantCabs := []*pbdata.PaymentTypeMix{
+		{
+			CabId:      "A",
+			TotalTrips: 4,
+			PaymentTypes: []*pbdata.PaymentTypeShare{
+				{PaymentType: "CRD", Trips: 3, TotalAmount: 45, Share: 0.75},
+				{PaymentType: "NOC", Trips: 1, Share: 0.25},
+			},
+		},
+		{CabId: "B", PaymentTypes: []*pbdata.PaymentTypeShare{}},
+	}
+	if !reflect.DeepEqual(cabs, wantCabs) {
+		t.Errorf("cabs = %v, want %v", cabs, wantCabs)
+	}
+}
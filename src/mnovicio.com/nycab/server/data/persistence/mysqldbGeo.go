@@ -0,0 +1,234 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+
+	"mnovicio.com/nycab/server/geo"
+)
+
+// TripLocation is used for unmarhalling pickup location rows from query
+type TripLocation struct {
+	CabID     string  `json:"cab_id"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// excludeZeroPickup filters out the (0,0) coordinates the raw data uses for missing pickup locations
+const excludeZeroPickup = "NOT (pickup_latitude = 0 AND pickup_longitude = 0)"
+
+// CountTripsInBoundingBox returns the number of trips per cab picked up inside the bounding box
+// box: area to search, edges included
+// start: pickup datetime lower bound, inclusive
+// end: pickup datetime upper bound, exclusive
+func (m *MySQLDBContext) CountTripsInBoundingBox(ctx context.Context, box geo.BoundingBox, start, end time.Time) (map[string]uint32, error) {
+	query := "SELECT medallion AS cab_id, COUNT(*) AS total_trip_cnt FROM " + m.source +
+		" WHERE pickup_datetime >= ? AND pickup_datetime < ?" +
+		" AND pickup_latitude BETWEEN ? AND ? AND pickup_longitude BETWEEN ? AND ?" +
+		" AND " + excludeZeroPickup +
+		" GROUP BY cab_id"
+	args := []interface{}{start, end,
+		box.SouthWest.Latitude, box.NorthEast.Latitude, box.SouthWest.Longitude, box.NorthEast.Longitude}
+
+	log.Printf("running query: [%s], args: %v", query, args)
+	results, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(ctx, fmt.Errorf("failed to run query: %v", err))
+	}
+	defer results.Close()
+
+	tripsPerCab := make(map[string]uint32)
+	for results.Next() {
+		var cabID string
+		var count uint32
+		if err := results.Scan(&cabID, &count); err != nil {
+			return nil, queryError(ctx, fmt.Errorf("failed to scan row: %v", err))
+		}
+		tripsPerCab[cabID] = count
+	}
+
+	return tripsPerCab, queryError(ctx, results.Err())
+}
+
+// GetPickupLocationsInBoundingBox returns the pickup location of each trip picked up inside the bounding box
+// box: area to search, edges included
+// start: pickup datetime lower bound, inclusive
+// end: pickup datetime upper bound, exclusive
+func (m *MySQLDBContext) GetPickupLocationsInBoundingBox(ctx context.Context, box geo.BoundingBox, start, end time.Time) ([]TripLocation, error) {
+	query := "SELECT medallion AS cab_id, pickup_latitude, pickup_longitude FROM " + m.source +
+		" WHERE pickup_datetime >= ? AND pickup_datetime < ?" +
+		" AND pickup_latitude BETWEEN ? AND ? AND pickup_longitude BETWEEN ? AND ?" +
+		" AND " + excludeZeroPickup
+	args := []interface{}{start, end,
+		box.SouthWest.Latitude, box.NorthEast.Latitude, box.SouthWest.Longitude, box.NorthEast.Longitude}
+
+	log.Printf("running query: [%s], args: %v", query, args)
+	results, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(ctx, fmt.Errorf("failed to run query: %v", err))
+	}
+	defer results.Close()
+
+	locations := []TripLocation{}
+	for results.Next() {
+		var location TripLocation
+		if err := results.Scan(&location.CabID, &location.Latitude, &location.Longitude); err != nil {
+			return nil, queryError(ctx, fmt.Errorf("failed to scan row: %v", err))
+		}
+		locations = append(locations, location)
+	}
+
+	return locations, queryError(ctx, results.Err())
+}
+
+// GetPickupHeatmap returns the number of trips picked up in each geohash cell, busiest cells first
+// precision: geohash length
+// start: pickup datetime lower bound, inclusive
+// end: pickup datetime upper bound, exclusive
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetPickupHeatmap(ctx context.Context, precision uint32, start, end time.Time, ignoreCache bool) ([]*pbdata.HeatmapCell, error) {
+	key := fmt.Sprintf("heatmap:%d:%s:%s", precision, start.Format(time.RFC3339), end.Format(time.RFC3339))
+	result, err := m.cachedQuery(ctx, key, ignoreCache, func() (interface{}, error) {
+		// ST_GeoHash fails on out of range coordinates, which do exist in the raw data
+		query := "SELECT ST_GeoHash(pickup_longitude, pickup_latitude, ?) AS cell, COUNT(*) AS total_trip_cnt FROM " + m.source +
+			" WHERE pickup_datetime >= ? AND pickup_datetime < ?" +
+			" AND pickup_latitude BETWEEN -90 AND 90 AND pickup_longitude BETWEEN -180 AND 180" +
+			" AND " + excludeZeroPickup +
+			" GROUP BY cell"
+		args := []interface{}{precision, start, end}
+
+		log.Printf("running query: [%s], args: %v", query, args)
+		results, err := m.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, queryError(ctx, fmt.Errorf("failed to run query: %v", err))
+		}
+		defer results.Close()
+
+		cells := []*pbdata.HeatmapCell{}
+		for results.Next() {
+			cell := &pbdata.HeatmapCell{}
+			if err := results.Scan(&cell.Geohash, &cell.TripCount); err != nil {
+				return nil, queryError(ctx, fmt.Errorf("failed to scan row: %v", err))
+			}
+
+			bounds, err := geo.DecodeGeohash(cell.Geohash)
+			if err != nil {
+				return nil, err
+			}
+			cell.Bounds = toPBBoundingBox(bounds)
+
+			cells = append(cells, cell)
+		}
+		if err := queryError(ctx, results.Err()); err != nil {
+			return nil, err
+		}
+
+		sort.Slice(cells, func(i, j int) bool {
+			if cells[i].TripCount != cells[j].TripCount {
+				return cells[i].TripCount > cells[j].TripCount
+			}
+			return cells[i].Geohash < cells[j].Geohash
+		})
+
+		return cells, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*pbdata.HeatmapCell), nil
+}
+
+func toPBBoundingBox(b geo.BoundingBox) *pbdata.BoundingBox {
+	return &pbdata.BoundingBox{
+		SouthWest: &pbdata.GeoPoint{Latitude: b.SouthWest.Latitude, Longitude: b.SouthWest.Longitude},
+		NorthEast: &pbdata.GeoPoint{Latitude: b.NorthEast.Latitude, Longitude: b.NorthEast.Longitude},
+	}
+}
+
+// excludeInvalidDropoff filters out the (0,0) and out of range coordinates of dropoff locations
+const excludeInvalidDropoff = "dropoff_latitude BETWEEN -90 AND 90 AND dropoff_longitude BETWEEN -180 AND 180" +
+	" AND NOT (dropoff_latitude = 0 AND dropoff_longitude = 0)"
+
+// GetOriginDestinationMatrix returns the number of trips and average trip duration between pickup and dropoff cells
+// cells are geohashes if geohashPrecision is set, 'row:col' grid indices of gridSize degrees otherwise
+// start: pickup datetime lower bound, inclusive
+// end: pickup datetime upper bound, exclusive
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetOriginDestinationMatrix(ctx context.Context, geohashPrecision uint32, gridSize float64, start, end time.Time, ignoreCache bool) ([]*pbdata.ODMatrixEntry, error) {
+	result, err := m.cachedQuery(ctx, odMatrixCacheKey(geohashPrecision, gridSize, start, end), ignoreCache, func() (interface{}, error) {
+		originCell, originArgs := cellExpression("pickup_latitude", "pickup_longitude", geohashPrecision, gridSize)
+		destinationCell, destinationArgs := cellExpression("dropoff_latitude", "dropoff_longitude", geohashPrecision, gridSize)
+
+		query := "SELECT " + originCell + " AS origin_cell, " + destinationCell + " AS destination_cell," +
+			" COUNT(*) AS total_trip_cnt, AVG(TIMESTAMPDIFF(SECOND, pickup_datetime, dropoff_datetime)) AS avg_duration" +
+			" FROM " + m.source +
+			" WHERE pickup_datetime >= ? AND pickup_datetime < ?" +
+			" AND pickup_latitude BETWEEN -90 AND 90 AND pickup_longitude BETWEEN -180 AND 180" +
+			" AND " + excludeZeroPickup +
+			" AND " + excludeInvalidDropoff +
+			" GROUP BY origin_cell, destination_cell"
+		args := append(append(originArgs, destinationArgs...), start, end)
+
+		log.Printf("running query: [%s], args: %v", query, args)
+		results, err := m.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, queryError(ctx, fmt.Errorf("failed to run query: %v", err))
+		}
+		defer results.Close()
+
+		entries := []*pbdata.ODMatrixEntry{}
+		for results.Next() {
+			entry := &pbdata.ODMatrixEntry{}
+			// the average duration is NULL if no trip of the pair has a dropoff datetime
+			var avgDuration sql.NullFloat64
+			if err := results.Scan(&entry.OriginCell, &entry.DestinationCell, &entry.TripCount, &avgDuration); err != nil {
+				return nil, queryError(ctx, fmt.Errorf("failed to scan row: %v", err))
+			}
+			entry.AvgTripDurationSecs = avgDuration.Float64
+			entries = append(entries, entry)
+		}
+		if err := queryError(ctx, results.Err()); err != nil {
+			return nil, err
+		}
+
+		sort.Slice(entries, func(i, j int) bool {
+			if entries[i].TripCount != entries[j].TripCount {
+				return entries[i].TripCount > entries[j].TripCount
+			}
+			if entries[i].OriginCell != entries[j].OriginCell {
+				return entries[i].OriginCell < entries[j].OriginCell
+			}
+			return entries[i].DestinationCell < entries[j].DestinationCell
+		})
+
+		return entries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*pbdata.ODMatrixEntry), nil
+}
+
+// odMatrixCacheKey returns the query cache key of an origin-destination matrix, grid sizes are formatted with as many digits as needed
+func odMatrixCacheKey(geohashPrecision uint32, gridSize float64, start, end time.Time) string {
+	return fmt.Sprintf("odmatrix:%d:%s:%s:%s", geohashPrecision, strconv.FormatFloat(gridSize, 'g', -1, 64), start.Format(time.RFC3339), end.Format(time.RFC3339))
+}
+
+// cellExpression returns the SQL expression (and its arguments) mapping a latitude/longitude column pair into a cell ID
+// cells are geohashes if geohashPrecision is set, 'row:col' grid indices of gridSize degrees otherwise
+func cellExpression(latColumn, lngColumn string, geohashPrecision uint32, gridSize float64) (string, []interface{}) {
+	if geohashPrecision > 0 {
+		return fmt.Sprintf("ST_GeoHash(%s, %s, ?)", lngColumn, latColumn), []interface{}{geohashPrecision}
+	}
+
+	return fmt.Sprintf("CONCAT(FLOOR(%s / ?), ':', FLOOR(%s / ?))", latColumn, lngColumn), []interface{}{gridSize, gridSize}
+}
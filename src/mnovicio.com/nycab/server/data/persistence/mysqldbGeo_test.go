@@ -0,0 +1,77 @@
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"mnovicio.com/nycab/server/geo"
+)
+
+func TestODMatrixCacheKeyDistinguishesSmallGridSizes(t *testing.T) {
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+
+	keys := map[string]float64{}
+	for _, gridSize := range []float64{0.01, 0.001, 0.0001, 0.00001, 0.000011, 0.0000001} {
+		key := odMatrixCacheKey(0, gridSize, start, end)
+		if other, found := keys[key]; found {
+			t.Errorf("grid sizes %g and %g share cache key [%s]", other, gridSize, key)
+		}
+		keys[key] = gridSize
+	}
+
+	if odMatrixCacheKey(5, 0, start, end) == odMatrixCacheKey(6, 0, start, end) {
+		t.Error("geohash precisions 5 and 6 share a cache key")
+	}
+}
+
+func TestGeoQueriesReportContextErrors(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	m, _ := newFakeDBContext(t, YellowDataset, fakeQuery{match: "SELECT", wait: release})
+
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	box := geo.BoundingBox{
+		SouthWest: geo.Point{Latitude: 40.70, Longitude: -74.02},
+		NorthEast: geo.Point{Latitude: 40.75, Longitude: -73.97},
+	}
+
+	tests := []struct {
+		name  string
+		query func(ctx context.Context) error
+	}{
+		{"bounding box counts", func(ctx context.Context) error {
+			_, err := m.CountTripsInBoundingBox(ctx, box, start, end)
+			return err
+		}},
+		{"pickup locations", func(ctx context.Context) error {
+			_, err := m.GetPickupLocationsInBoundingBox(ctx, box, start, end)
+			return err
+		}},
+		{"heatmap", func(ctx context.Context) error {
+			_, err := m.GetPickupHeatmap(ctx, 6, start, end, false)
+			return err
+		}},
+		{"origin-destination matrix", func(ctx context.Context) error {
+			_, err := m.GetOriginDestinationMatrix(ctx, 6, 0, start, end, false)
+			return err
+		}},
+		{"zone endpoints", func(ctx context.Context) error {
+			_, err := m.GetTripEndpoints(ctx, []string{"A"}, start, end)
+			return err
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// a timed out query job reports DeadlineExceeded rather than the driver error
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			if err := test.query(ctx); err != context.DeadlineExceeded {
+				t.Errorf("query = %v, want %v", err, context.DeadlineExceeded)
+			}
+		})
+	}
+}
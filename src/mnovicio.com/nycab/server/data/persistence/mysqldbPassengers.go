@@ -0,0 +1,126 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+// maxValidPassengerCount is the largest number of passengers a cab can legally carry
+const maxValidPassengerCount = 6
+
+// GetPassengerCountDistribution returns the number of trips per passenger count for the whole fleet and for each cab
+// cabIDs: list of cab IDs to search, only the fleet distribution is returned if empty
+// startDate: first pickup date, inclusive
+// endDate: last pickup date, inclusive
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetPassengerCountDistribution(ctx context.Context, cabIDs []string, startDate, endDate time.Time, ignoreCache bool) (*pbdata.PassengerCountDistribution, []*pbdata.PassengerCountDistribution, error) {
+	dateRange := fmt.Sprintf("%s:%s", startDate.Format("2006-01-02"), endDate.Format("2006-01-02"))
+
+	fleet, err := m.cachedQuery(ctx, "passengers:"+FleetID+":"+dateRange, ignoreCache, func() (interface{}, error) {
+		query := "SELECT ? AS id, passenger_count, COUNT(*) AS total_trip_cnt FROM " + m.source +
+			" WHERE pickup_datetime >= ? AND pickup_datetime < ? GROUP BY passenger_count"
+		args := []interface{}{FleetID, startDate, endDate.AddDate(0, 0, 1)}
+
+		distributions, err := m.queryPassengerCounts(ctx, query, args, []string{FleetID})
+		if err != nil {
+			return nil, err
+		}
+		return distributions[0], nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(cabIDs) == 0 {
+		return fleet.(*pbdata.PassengerCountDistribution), []*pbdata.PassengerCountDistribution{}, nil
+	}
+
+	ids := sortedUnique(cabIDs)
+	cabs, err := m.cachedQuery(ctx, "passengers:"+strings.Join(ids, ",")+":"+dateRange, ignoreCache, func() (interface{}, error) {
+		query := fmt.Sprintf("SELECT medallion AS id, passenger_count, COUNT(*) AS total_trip_cnt FROM "+m.source+
+			" WHERE medallion IN (%s) AND pickup_datetime >= ? AND pickup_datetime < ? GROUP BY id, passenger_count", placeholders(len(ids)))
+		args := append(stringArgs(ids), startDate, endDate.AddDate(0, 0, 1))
+
+		return m.queryPassengerCounts(ctx, query, args, ids)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fleet.(*pbdata.PassengerCountDistribution), cabs.([]*pbdata.PassengerCountDistribution), nil
+}
+
+// queryPassengerCounts runs a query returning (id, passenger count, trip count) rows
+// returns one distribution per ID in the order of ids, IDs without trips have an empty distribution
+func (m *MySQLDBContext) queryPassengerCounts(ctx context.Context, query string, args []interface{}, ids []string) ([]*pbdata.PassengerCountDistribution, error) {
+	log.Printf("running query: [%s], args: %v", query, args)
+	results, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query: %v", err)
+	}
+	defer results.Close()
+
+	tripsByPassengerCount := make(map[string]map[int32]uint64, len(ids))
+	for _, id := range ids {
+		tripsByPassengerCount[id] = make(map[int32]uint64)
+	}
+
+	for results.Next() {
+		var id string
+		// trips without passenger count are counted with 0 passengers, as invalid
+		var passengerCount sql.NullInt32
+		var trips uint64
+		if err := results.Scan(&id, &passengerCount, &trips); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %v", err)
+		}
+		if _, found := tripsByPassengerCount[id]; found {
+			tripsByPassengerCount[id][passengerCount.Int32] += trips
+		}
+	}
+	if err := results.Err(); err != nil {
+		return nil, err
+	}
+
+	distributions := make([]*pbdata.PassengerCountDistribution, 0, len(ids))
+	for _, id := range ids {
+		distributions = append(distributions, toPassengerCountDistribution(id, tripsByPassengerCount[id]))
+	}
+	return distributions, nil
+}
+
+func toPassengerCountDistribution(id string, tripsByPassengerCount map[int32]uint64) *pbdata.PassengerCountDistribution {
+	distribution := &pbdata.PassengerCountDistribution{
+		CabId:   id,
+		Buckets: make([]*pbdata.PassengerCountBucket, 0, len(tripsByPassengerCount)),
+	}
+
+	for passengerCount, trips := range tripsByPassengerCount {
+		distribution.TotalTrips += trips
+		if passengerCount <= 0 || passengerCount > maxValidPassengerCount {
+			distribution.InvalidTrips += trips
+		}
+		distribution.Buckets = append(distribution.Buckets, &pbdata.PassengerCountBucket{
+			PassengerCount: passengerCount,
+			Trips:          trips,
+		})
+	}
+	sort.Slice(distribution.Buckets, func(i, j int) bool {
+		return distribution.Buckets[i].PassengerCount < distribution.Buckets[j].PassengerCount
+	})
+
+	if distribution.TotalTrips > 0 {
+		for _, bucket := range distribution.Buckets {
+			bucket.Share = float64(bucket.Trips) / float64(distribution.TotalTrips)
+		}
+		distribution.InvalidShare = float64(distribution.InvalidTrips) / float64(distribution.TotalTrips)
+	}
+
+	return distribution
+}
@@ -0,0 +1,74 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+func TestGetPassengerCountDistribution(t *testing.T) {
+	m, fake := newFakeDBContext(t, YellowDataset,
+		fakeQuery{
+			match:   "SELECT ? AS id, passenger_count",
+			columns: []string{"id", "passenger_count", "total_trip_cnt"},
+			rows:    [][]driver.Value{{FleetID, int64(2), int64(5)}, {FleetID, int64(0), int64(1)}, {FleetID, int64(1), int64(12)}, {FleetID, int64(9), int64(1)}, {FleetID, nil, int64(1)}},
+		},
+		fakeQuery{
+			match:   "SELECT medallion AS id, passenger_count",
+			columns: []string{"id", "passenger_count", "total_trip_cnt"},
+			rows:    [][]driver.Value{{"A", int64(1), int64(3)}, {"A", int64(7), int64(1)}},
+		},
+	)
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		fleet, cabs, err := m.GetPassengerCountDistribution(context.Background(), []string{"B", "A"}, start, start.AddDate(0, 0, 6), false)
+		if err != nil {
+			t.Fatalf("GetPassengerCountDistribution() = %v", err)
+		}
+
+		// buckets are ordered by passenger count, counts outside 1 to 6 are invalid, trips without passenger count have 0 passengers
+		wantFleet := &pbdata.PassengerCountDistribution{
+			CabId:        FleetID,
+			TotalTrips:   20,
+			InvalidTrips: 3,
+			InvalidShare: 0.15,
+			Buckets: []*pbdata.PassengerCountBucket{
+				{PassengerCount: 0, Trips: 2, Share: 0.1},
+				{PassengerCount: 1, Trips: 12, Share: 0.6},
+				{PassengerCount: 2, Trips: 5, Share: 0.25},
+				{PassengerCount: 9, Trips: 1, Share: 0.05},
+			},
+		}
+		if !reflect.DeepEqual(fleet, wantFleet) {
+			t.Errorf("fleet = %v, want %v", fleet, wantFleet)
+		}
+
+		// cabs are ordered by ID, cabs without trips have an empty distribution
+		wantCabs := []*pbdata.PassengerCountDistribution{
+			{
+				CabId:        "A",
+				TotalTrips:   4,
+				InvalidTrips: 1,
+				InvalidShare: 0.25,
+				Buckets: []*pbdata.PassengerCountBucket{
+					{PassengerCount: 1, Trips: 3, Share: 0.75},
+					{PassengerCount: 7, Trips: 1, Share: 0.25},
+				},
+			},
+			{CabId: "B", Buckets: []*pbdata.PassengerCountBucket{}},
+		}
+		if !reflect.DeepEqual(cabs, wantCabs) {
+			t.Errorf("cabs = %v, want %v", cabs, wantCabs)
+		}
+	}
+
+	// the second call is served from the cache
+	if queries := len(fake.ran); queries != 2 {
+		t.Errorf("queries = %d, want 2", queries)
+	}
+}
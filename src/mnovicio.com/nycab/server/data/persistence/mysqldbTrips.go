@@ -0,0 +1,200 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+)
+
+// Trip is used for unmarhalling trip rows from query, NULL columns (e.g. FHV trips without dropoff datetime) leave their fields unset
+type Trip struct {
+	CabID       string    `json:"cab_id"`
+	HackLicense string    `json:"hack_license"`
+	PickupTime  time.Time `json:"pickup_datetime"`
+	DropoffTime time.Time `json:"dropoff_datetime"`
+	// TripDistance is in miles
+	TripDistance     float64 `json:"trip_distance"`
+	PassengerCount   uint32  `json:"passenger_count"`
+	PickupLatitude   float64 `json:"pickup_latitude"`
+	PickupLongitude  float64 `json:"pickup_longitude"`
+	DropoffLatitude  float64 `json:"dropoff_latitude"`
+	DropoffLongitude float64 `json:"dropoff_longitude"`
+}
+
+// Selectable trip fields for ListTrips, cab_id is always returned
+const (
+	TripFieldHackLicense     = "hack_license"
+	TripFieldPickupTime      = "pickup_time"
+	TripFieldDropoffTime     = "dropoff_time"
+	TripFieldPickupLocation  = "pickup_location"
+	TripFieldDropoffLocation = "dropoff_location"
+	TripFieldTripDistance    = "trip_distance"
+	TripFieldPassengerCount  = "passenger_count"
+)
+
+// TripFields lists all selectable trip fields
+var TripFields = []string{
+	TripFieldHackLicense,
+	TripFieldPickupTime,
+	TripFieldDropoffTime,
+	TripFieldPickupLocation,
+	TripFieldDropoffLocation,
+	TripFieldTripDistance,
+	TripFieldPassengerCount,
+}
+
+// nullable scans a column into dest, leaving dest unset if the column is NULL
+type nullable struct {
+	dest interface{}
+}
+
+// Scan implements sql.Scanner for the trip field types
+func (n nullable) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	switch dest := n.dest.(type) {
+	case *string:
+		var v sql.NullString
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*dest = v.String
+	case *time.Time:
+		var v sql.NullTime
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*dest = v.Time
+	case *float64:
+		var v sql.NullFloat64
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		*dest = v.Float64
+	case *uint32:
+		var v sql.NullInt64
+		if err := v.Scan(value); err != nil {
+			return err
+		}
+		if v.Int64 < 0 || v.Int64 > math.MaxUint32 {
+			return fmt.Errorf("value %d out of range of uint32", v.Int64)
+		}
+		*dest = uint32(v.Int64)
+	default:
+		return fmt.Errorf("unsupported scan destination %T", n.dest)
+	}
+	return nil
+}
+
+// tripFieldColumns returns the columns of the field and the scan destinations of these columns in trip
+func tripFieldColumns(field string, trip *Trip) ([]string, []interface{}) {
+	switch field {
+	case TripFieldHackLicense:
+		return []string{"hack_license"}, []interface{}{nullable{&trip.HackLicense}}
+	case TripFieldPickupTime:
+		return []string{"pickup_datetime"}, []interface{}{nullable{&trip.PickupTime}}
+	case TripFieldDropoffTime:
+		return []string{"dropoff_datetime"}, []interface{}{nullable{&trip.DropoffTime}}
+	case TripFieldPickupLocation:
+		return []string{"pickup_latitude", "pickup_longitude"}, []interface{}{nullable{&trip.PickupLatitude}, nullable{&trip.PickupLongitude}}
+	case TripFieldDropoffLocation:
+		return []string{"dropoff_latitude", "dropoff_longitude"}, []interface{}{nullable{&trip.DropoffLatitude}, nullable{&trip.DropoffLongitude}}
+	case TripFieldTripDistance:
+		return []string{"trip_distance"}, []interface{}{nullable{&trip.TripDistance}}
+	case TripFieldPassengerCount:
+		return []string{"passenger_count"}, []interface{}{nullable{&trip.PassengerCount}}
+	}
+	return nil, nil
+}
+
+// TripColumns returns the columns fetched for the trip fields, medallion included. unknown fields are ignored
+func TripColumns(fields []string) []string {
+	columns := []string{"medallion"}
+	for _, field := range fields {
+		fieldColumns, _ := tripFieldColumns(field, &Trip{})
+		columns = append(columns, fieldColumns...)
+	}
+	return columns
+}
+
+// GetTripsForCabs returns the trips of the cabs ordered by medallion and pickup_datetime, trips without dropoff datetime are skipped
+// cabIDs: list of cab IDs to search
+// start: pickup datetime lower bound, inclusive
+// end: pickup datetime upper bound, exclusive
+func (m *MySQLDBContext) GetTripsForCabs(ctx context.Context, cabIDs []string, start, end time.Time) ([]Trip, error) {
+	query := fmt.Sprintf("SELECT medallion AS cab_id, hack_license, pickup_datetime, dropoff_datetime, trip_distance FROM "+m.source+
+		" WHERE medallion IN (%s) AND pickup_datetime >= ? AND pickup_datetime < ? AND dropoff_datetime IS NOT NULL"+
+		" ORDER BY medallion, pickup_datetime", placeholders(len(cabIDs)))
+	args := append(stringArgs(cabIDs), start, end)
+
+	log.Printf("running query: [%s], args: %v", query, args)
+	results, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(ctx, fmt.Errorf("failed to run query: %v", err))
+	}
+	defer results.Close()
+
+	trips := []Trip{}
+	for results.Next() {
+		var trip Trip
+		if err := results.Scan(&trip.CabID, nullable{&trip.HackLicense}, &trip.PickupTime, &trip.DropoffTime, nullable{&trip.TripDistance}); err != nil {
+			return nil, queryError(ctx, fmt.Errorf("failed to scan row: %v", err))
+		}
+		trips = append(trips, trip)
+	}
+
+	if err := results.Err(); err != nil {
+		return nil, queryError(ctx, err)
+	}
+
+	return trips, nil
+}
+
+// ListTrips returns a page of the trips of the cab ordered by pickup_datetime
+// cabID: cab ID to search
+// start: pickup datetime lower bound, inclusive
+// end: pickup datetime upper bound, exclusive
+// fields: trip fields to fetch (see TripFields), cab_id is always fetched. unknown fields are ignored
+// offset: number of trips to skip
+// limit: maximum number of trips to return
+func (m *MySQLDBContext) ListTrips(ctx context.Context, cabID string, start, end time.Time, fields []string, offset, limit uint32) ([]Trip, error) {
+	// scan destinations are bound per row
+	query := "SELECT " + strings.Join(TripColumns(fields), ", ") + " FROM " + m.source +
+		" WHERE medallion = ? AND pickup_datetime >= ? AND pickup_datetime < ?" +
+		" ORDER BY pickup_datetime, hack_license, dropoff_datetime LIMIT ? OFFSET ?"
+	args := []interface{}{cabID, start, end, limit, offset}
+
+	log.Printf("running query: [%s], args: %v", query, args)
+	results, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(ctx, fmt.Errorf("failed to run query: %v", err))
+	}
+	defer results.Close()
+
+	trips := []Trip{}
+	for results.Next() {
+		var trip Trip
+		dest := []interface{}{&trip.CabID}
+		for _, field := range fields {
+			_, fieldDest := tripFieldColumns(field, &trip)
+			dest = append(dest, fieldDest...)
+		}
+
+		if err := results.Scan(dest...); err != nil {
+			return nil, queryError(ctx, fmt.Errorf("failed to scan row: %v", err))
+		}
+		trips = append(trips, trip)
+	}
+
+	if err := results.Err(); err != nil {
+		return nil, queryError(ctx, err)
+	}
+
+	return trips, nil
+}
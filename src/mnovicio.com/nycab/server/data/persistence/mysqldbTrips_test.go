@@ -0,0 +1,84 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListTripsNullColumns(t *testing.T) {
+	pickup := time.Date(2015, 1, 1, 8, 0, 0, 0, time.UTC)
+	dropoff := pickup.Add(20 * time.Minute)
+	m, _ := newFakeDBContext(t, FHVDataset, fakeQuery{
+		match:   "WHERE medallion = ?",
+		columns: TripColumns(TripFields),
+		rows: [][]driver.Value{
+			{"B00013", "H1", pickup, dropoff, 40.75, -73.99, 40.76, -73.98, 2.5, int64(1)},
+			// FHV trips have no dropoff datetime, location, distance nor passenger count
+			{"B00013", nil, pickup, nil, nil, nil, nil, nil, nil, nil},
+		},
+	})
+
+	trips, err := m.ListTrips(context.Background(), "B00013", pickup, pickup.AddDate(0, 0, 1), TripFields, 0, 10)
+	if err != nil {
+		t.Fatalf("ListTrips() = %v", err)
+	}
+
+	// NULL columns leave their fields unset
+	want := []Trip{
+		{CabID: "B00013", HackLicense: "H1", PickupTime: pickup, DropoffTime: dropoff, TripDistance: 2.5, PassengerCount: 1,
+			PickupLatitude: 40.75, PickupLongitude: -73.99, DropoffLatitude: 40.76, DropoffLongitude: -73.98},
+		{CabID: "B00013", PickupTime: pickup},
+	}
+	if !reflect.DeepEqual(trips, want) {
+		t.Errorf("ListTrips() = %+v, want %+v", trips, want)
+	}
+}
+
+func TestGetTripsForCabs(t *testing.T) {
+	pickup := time.Date(2013, 12, 1, 8, 0, 0, 0, time.UTC)
+	dropoff := pickup.Add(20 * time.Minute)
+	m, fake := newFakeDBContext(t, YellowDataset, fakeQuery{
+		match:   "WHERE medallion IN (?, ?)",
+		columns: []string{"cab_id", "hack_license", "pickup_datetime", "dropoff_datetime", "trip_distance"},
+		rows: [][]driver.Value{
+			{"A", "H1", pickup, dropoff, 2.5},
+			{"B", nil, pickup, dropoff, nil},
+		},
+	})
+
+	trips, err := m.GetTripsForCabs(context.Background(), []string{"A", "B"}, pickup, pickup.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("GetTripsForCabs() = %v", err)
+	}
+
+	want := []Trip{
+		{CabID: "A", HackLicense: "H1", PickupTime: pickup, DropoffTime: dropoff, TripDistance: 2.5},
+		{CabID: "B", PickupTime: pickup, DropoffTime: dropoff},
+	}
+	if !reflect.DeepEqual(trips, want) {
+		t.Errorf("GetTripsForCabs() = %+v, want %+v", trips, want)
+	}
+
+	// shifts and anomalies need the dropoff datetime, trips without it are not fetched
+	fake.Lock()
+	query := fake.ran[0]
+	fake.Unlock()
+	if !strings.Contains(query, "dropoff_datetime IS NOT NULL") {
+		t.Errorf("query [%s] fetches trips without dropoff datetime", query)
+	}
+}
+
+func TestGetTripsForCabsReportsContextErrors(t *testing.T) {
+	m, _ := newFakeDBContext(t, YellowDataset, fakeQuery{match: "WHERE medallion IN", wait: make(chan struct{})})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := m.GetTripsForCabs(ctx, []string{"A"}, start, start.AddDate(0, 0, 1)); err != context.DeadlineExceeded {
+		t.Errorf("GetTripsForCabs() = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,132 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+// GetCabUtilization returns the utilization of each cab on each day of the date range, ordered by cab ID and date
+// days without trips are reported with zero values, the days after the last pickup date of the table are not cached as they may still be imported
+// cabIDs: list of cab IDs to search
+// startDate: first pickup date, inclusive
+// endDate: last pickup date, inclusive
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetCabUtilization(ctx context.Context, cabIDs []string, startDate, endDate time.Time, ignoreCache bool) ([]*pbdata.CabUtilization, error) {
+	dates := datesBetween(startDate, endDate)
+	ids := sortedUnique(cabIDs)
+
+	// like trip counts, utilization is cached per cab and pickup date, only cabs with missing days are fetched from DB
+	utilizationPerDay := make(map[string]*pbdata.CabUtilization, len(ids)*len(dates))
+	notInCache := []string{}
+	for _, cabID := range ids {
+		for _, date := range dates {
+			key := utilizationCacheKey(cabID, date)
+			cached, found := m.queryCache.get(key)
+			if ignoreCache || !found {
+				notInCache = append(notInCache, cabID)
+				break
+			}
+			utilizationPerDay[key] = cached.(*pbdata.CabUtilization)
+		}
+	}
+
+	if len(notInCache) > 0 {
+		lastDate, err := m.lastPickupDate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		generation := m.queryCache.currentGeneration()
+		fetched := make(map[string]*pbdata.CabUtilization)
+		for _, cabID := range notInCache {
+			for _, date := range dates {
+				fetched[utilizationCacheKey(cabID, date)] = &pbdata.CabUtilization{CabId: cabID, Date: date}
+			}
+		}
+
+		log.Println("fetching utilization from db for ff cabIDs: ", notInCache)
+		query := fmt.Sprintf("SELECT medallion AS cab_id, DATE(pickup_datetime) AS pickup_date, COUNT(*) AS total_trip_cnt,"+
+			" COALESCE(SUM(GREATEST(TIMESTAMPDIFF(SECOND, pickup_datetime, dropoff_datetime), 0)), 0) AS busy_secs,"+
+			" COALESCE(GREATEST(TIMESTAMPDIFF(SECOND, MIN(pickup_datetime), MAX(dropoff_datetime)), 0), 0) AS active_secs"+
+			" FROM "+m.source+" WHERE medallion IN (%s) AND pickup_datetime >= ? AND pickup_datetime < ?"+
+			" GROUP BY cab_id, pickup_date", placeholders(len(notInCache)))
+		args := append(stringArgs(notInCache), startDate, endDate.AddDate(0, 0, 1))
+
+		log.Printf("running query: [%s], args: %v", query, args)
+		results, err := m.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, queryError(ctx, fmt.Errorf("failed to run query: %v", err))
+		}
+		defer results.Close()
+
+		for results.Next() {
+			var cabID string
+			var pickupDate time.Time
+			var tripCount, busySecs, activeSecs uint32
+			if err := results.Scan(&cabID, &pickupDate, &tripCount, &busySecs, &activeSecs); err != nil {
+				return nil, queryError(ctx, fmt.Errorf("failed to scan row: %v", err))
+			}
+
+			utilization := fetched[utilizationCacheKey(cabID, pickupDate.Format("2006-01-02"))]
+			if utilization == nil {
+				continue
+			}
+			utilization.TripCount = tripCount
+			utilization.BusySecs = busySecs
+			utilization.ActiveSecs = activeSecs
+			if activeSecs > 0 {
+				// overlapping trips can make the busy time exceed the active window
+				utilization.Utilization = math.Min(float64(busySecs)/float64(activeSecs), 1)
+				utilization.TripsPerActiveHour = float64(tripCount) / (float64(activeSecs) / 3600)
+			}
+		}
+		if err := queryError(ctx, results.Err()); err != nil {
+			return nil, err
+		}
+
+		for key, utilization := range fetched {
+			utilizationPerDay[key] = utilization
+			if utilization.Date <= lastDate {
+				m.queryCache.setSince(generation, key, utilization)
+			}
+		}
+	}
+
+	utilization := make([]*pbdata.CabUtilization, 0, len(ids)*len(dates))
+	for _, cabID := range ids {
+		for _, date := range dates {
+			utilization = append(utilization, utilizationPerDay[utilizationCacheKey(cabID, date)])
+		}
+	}
+
+	return utilization, nil
+}
+
+func utilizationCacheKey(cabID, date string) string {
+	return fmt.Sprintf("utilization:%s:%s", cabID, date)
+}
+
+// datesBetween returns the dates from start to end, both inclusive, in 'YYYY-MM-DD' format
+func datesBetween(start, end time.Time) []string {
+	dates := []string{}
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		dates = append(dates, date.Format("2006-01-02"))
+	}
+	return dates
+}
+
+func sortedUnique(values []string) []string {
+	set := toSet(values)
+	unique := make([]string, 0, len(set))
+	for v := range set {
+		unique = append(unique, v)
+	}
+	sort.Strings(unique)
+	return unique
+}
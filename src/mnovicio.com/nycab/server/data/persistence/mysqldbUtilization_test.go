@@ -0,0 +1,73 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func TestGetCabUtilization(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2013, 12, d, 0, 0, 0, 0, time.UTC)
+	}
+	m, fake := newFakeDBContext(t, YellowDataset,
+		fakeQuery{
+			match:   "SELECT MAX(pickup_datetime)",
+			columns: []string{"MAX(pickup_datetime)"},
+			rows:    [][]driver.Value{{day(2).Add(23 * time.Hour)}},
+		},
+		fakeQuery{
+			match:   "AS busy_secs",
+			columns: []string{"cab_id", "pickup_date", "total_trip_cnt", "busy_secs", "active_secs"},
+			// busy time exceeding the active window is capped
+			rows: [][]driver.Value{{"A", day(1), int64(4), int64(1800), int64(3600)}, {"A", day(2), int64(2), int64(900), int64(600)}},
+		},
+	)
+
+	// the last pickup date is 2013-12-02, 2013-12-03 may still be imported
+	for i, wantQueries := range []int{1, 2} {
+		utilization, err := m.GetCabUtilization(context.Background(), []string{"A", "A"}, day(1), day(3), false)
+		if err != nil {
+			t.Fatalf("GetCabUtilization() = %v", err)
+		}
+		if len(utilization) != 3 {
+			t.Fatalf("GetCabUtilization() returned %d days, want 3", len(utilization))
+		}
+
+		want := []struct {
+			date        string
+			trips       uint32
+			utilization float64
+		}{
+			{"2013-12-01", 4, 0.5},
+			{"2013-12-02", 2, 1},
+			{"2013-12-03", 0, 0},
+		}
+		for d, w := range want {
+			got := utilization[d]
+			if got.CabId != "A" || got.Date != w.date || got.TripCount != w.trips || got.Utilization != w.utilization {
+				t.Errorf("call %d, day %d = %v, want %s with %d trips and %g utilization", i, d, got, w.date, w.trips, w.utilization)
+			}
+		}
+
+		// days after the last pickup date are fetched again
+		if queries := fake.queriesRan("AS busy_secs"); queries != wantQueries {
+			t.Errorf("call %d, queries = %d, want %d", i, queries, wantQueries)
+		}
+	}
+
+	for date, wantCached := range map[string]bool{"2013-12-01": true, "2013-12-02": true, "2013-12-03": false} {
+		if _, found := m.queryCache.get(utilizationCacheKey("A", date)); found != wantCached {
+			t.Errorf("%s cached = %t, want %t", date, found, wantCached)
+		}
+	}
+
+	// cached days are not fetched again
+	if _, err := m.GetCabUtilization(context.Background(), []string{"A"}, day(1), day(2), false); err != nil {
+		t.Fatalf("GetCabUtilization() = %v", err)
+	}
+	if queries := fake.queriesRan("AS busy_secs"); queries != 2 {
+		t.Errorf("queries after a cached range = %d, want 2", queries)
+	}
+}
@@ -0,0 +1,77 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+// GetVendorStats returns the number of trips, average distance and number of trips with suspicious values of each vendor, ordered by vendor ID
+// cabIDs: list of cab IDs to search, the whole fleet if empty
+// startDate: first pickup date, inclusive
+// endDate: last pickup date, inclusive
+// maxSpeedMph: average speed in miles per hour above which a trip is implausible
+// ignoreCache: true - ignores cache and make query to DB. uses cached data otherwise.
+func (m *MySQLDBContext) GetVendorStats(ctx context.Context, cabIDs []string, startDate, endDate time.Time, maxSpeedMph float64, ignoreCache bool) ([]*pbdata.VendorStats, error) {
+	ids := sortedUnique(cabIDs)
+	key := fmt.Sprintf("vendors:%s:%s:%s:%g", strings.Join(ids, ","), startDate.Format("2006-01-02"), endDate.Format("2006-01-02"), maxSpeedMph)
+	result, err := m.cachedQuery(ctx, key, ignoreCache, func() (interface{}, error) {
+		zeroDuration := "dropoff_datetime <= pickup_datetime"
+		implausibleSpeed := "(dropoff_datetime > pickup_datetime AND trip_distance * 3600 / TIMESTAMPDIFF(SECOND, pickup_datetime, dropoff_datetime) > ?)"
+		invalidPassengerCount := fmt.Sprintf("(COALESCE(passenger_count, 0) <= 0 OR passenger_count > %d)", maxValidPassengerCount)
+		missingPickup := "NOT " + excludeZeroPickup
+
+		query := fmt.Sprintf("SELECT vendor_id, COUNT(*) AS total_trip_cnt, COALESCE(AVG(trip_distance), 0) AS avg_distance,"+
+			" COALESCE(SUM(%s), 0), COALESCE(SUM(%s), 0), COALESCE(SUM(%s), 0), COALESCE(SUM(%s), 0),"+
+			" COALESCE(SUM(%s OR %s OR %s OR %s), 0) FROM "+m.source+
+			" WHERE pickup_datetime >= ? AND pickup_datetime < ?",
+			zeroDuration, implausibleSpeed, invalidPassengerCount, missingPickup,
+			zeroDuration, implausibleSpeed, invalidPassengerCount, missingPickup)
+		args := []interface{}{maxSpeedMph, maxSpeedMph, startDate, endDate.AddDate(0, 0, 1)}
+		if len(ids) > 0 {
+			query += fmt.Sprintf(" AND medallion IN (%s)", placeholders(len(ids)))
+			args = append(args, stringArgs(ids)...)
+		}
+		query += " GROUP BY vendor_id ORDER BY vendor_id"
+
+		log.Printf("running query: [%s], args: %v", query, args)
+		results, err := m.db.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run query: %v", err)
+		}
+		defer results.Close()
+
+		vendors := []*pbdata.VendorStats{}
+		for results.Next() {
+			// trips without vendor are grouped under an empty vendor ID
+			var vendorID sql.NullString
+			vendor := &pbdata.VendorStats{}
+			err := results.Scan(&vendorID, &vendor.TripCount, &vendor.AvgDistance,
+				&vendor.ZeroDurationTrips, &vendor.ImplausibleSpeedTrips, &vendor.InvalidPassengerCountTrips, &vendor.MissingPickupLocationTrips,
+				&vendor.AnomalousTrips)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan row: %v", err)
+			}
+			vendor.VendorId = vendorID.String
+			if vendor.TripCount > 0 {
+				vendor.AnomalyRate = float64(vendor.AnomalousTrips) / float64(vendor.TripCount)
+			}
+			vendors = append(vendors, vendor)
+		}
+		if err := results.Err(); err != nil {
+			return nil, err
+		}
+
+		return vendors, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.([]*pbdata.VendorStats), nil
+}
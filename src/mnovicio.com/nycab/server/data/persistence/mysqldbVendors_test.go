@@ -0,0 +1,60 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+func TestGetVendorStats(t *testing.T) {
+	m, fake := newFakeDBContext(t, YellowDataset, fakeQuery{
+		match:   "GROUP BY vendor_id",
+		columns: []string{"vendor_id", "total_trip_cnt", "avg_distance", "zero_duration", "implausible_speed", "invalid_passengers", "missing_pickup", "anomalous"},
+		rows: [][]driver.Value{
+			{nil, int64(2), 1.0, int64(0), int64(0), int64(1), int64(0), int64(1)},
+			{"CMT", int64(8), 2.5, int64(1), int64(2), int64(0), int64(1), int64(3)},
+			{"VTS", int64(0), 0.0, int64(0), int64(0), int64(0), int64(0), int64(0)},
+		},
+	})
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		cabIDs      []string
+		maxSpeedMph float64
+		wantQueries int
+	}{
+		{"fleet", nil, 100, 1},
+		{"fleet cached", nil, 100, 1},
+		{"other speed", nil, 80, 2},
+		{"cabs", []string{"B", "A"}, 100, 3},
+		// the cache key does not depend on the order of the cab IDs
+		{"cabs cached", []string{"A", "B", "A"}, 100, 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vendors, err := m.GetVendorStats(context.Background(), test.cabIDs, start, start.AddDate(0, 0, 6), test.maxSpeedMph, false)
+			if err != nil {
+				t.Fatalf("GetVendorStats() = %v", err)
+			}
+
+			// trips without vendor have an empty vendor ID, vendors without trips have a zero anomaly rate
+			want := []*pbdata.VendorStats{
+				{TripCount: 2, AvgDistance: 1, InvalidPassengerCountTrips: 1, AnomalousTrips: 1, AnomalyRate: 0.5},
+				{VendorId: "CMT", TripCount: 8, AvgDistance: 2.5, ZeroDurationTrips: 1, ImplausibleSpeedTrips: 2, MissingPickupLocationTrips: 1, AnomalousTrips: 3, AnomalyRate: 0.375},
+				{VendorId: "VTS"},
+			}
+			if !reflect.DeepEqual(vendors, want) {
+				t.Errorf("GetVendorStats() = %v, want %v", vendors, want)
+			}
+			if queries := fake.queriesRan("GROUP BY vendor_id"); queries != test.wantQueries {
+				t.Errorf("queries = %d, want %d", queries, test.wantQueries)
+			}
+		})
+	}
+}
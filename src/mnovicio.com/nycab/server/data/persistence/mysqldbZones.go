@@ -0,0 +1,173 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"mnovicio.com/nycab/server/geo"
+)
+
+// TripEndpoints is used for unmarshalling pickup and dropoff location rows from query
+type TripEndpoints struct {
+	// CabID is empty for datasets which do not identify cabs
+	CabID      string
+	PickupDate string
+	Pickup     geo.Point
+	Dropoff    geo.Point
+}
+
+// GetTripEndpointsInBoundingBoxes returns the pickup and dropoff locations of the trips picked up or dropped off inside any of the bounding boxes
+// boxes: areas to search, edges included
+// cabIDs: list of cab IDs to search, all cabs if empty
+// startDate: first pickup date, inclusive
+// endDate: last pickup date, inclusive
+func (m *MySQLDBContext) GetTripEndpointsInBoundingBoxes(ctx context.Context, boxes []geo.BoundingBox, cabIDs []string, startDate, endDate time.Time) ([]TripEndpoints, error) {
+	if len(boxes) == 0 {
+		return []TripEndpoints{}, nil
+	}
+
+	conditions := make([]string, 0, 2*len(boxes))
+	args := []interface{}{startDate, endDate.AddDate(0, 0, 1)}
+	for _, box := range boxes {
+		conditions = append(conditions,
+			"(pickup_latitude BETWEEN ? AND ? AND pickup_longitude BETWEEN ? AND ?)",
+			"(dropoff_latitude BETWEEN ? AND ? AND dropoff_longitude BETWEEN ? AND ?)")
+		boxArgs := []interface{}{box.SouthWest.Latitude, box.NorthEast.Latitude, box.SouthWest.Longitude, box.NorthEast.Longitude}
+		args = append(args, boxArgs...)
+		args = append(args, boxArgs...)
+	}
+
+	query := "SELECT COALESCE(medallion, '') AS cab_id, DATE(pickup_datetime) AS pickup_date, pickup_latitude, pickup_longitude, dropoff_latitude, dropoff_longitude" +
+		" FROM " + m.source + " WHERE pickup_datetime >= ? AND pickup_datetime < ?" +
+		" AND (" + strings.Join(conditions, " OR ") + ")"
+
+	return m.queryTripEndpointsOfCabs(ctx, query, args, cabIDs)
+}
+
+// EndpointCount is the number of trips picked up and dropped off at a location on a pickup date
+type EndpointCount struct {
+	PickupDate string
+	Location   geo.Point
+	Pickups    uint32
+	Dropoffs   uint32
+}
+
+// endpointCoordinateDecimals is the number of decimals CountTripEndpointsInBoundingBoxes rounds coordinates to, about 110 meters of latitude
+const endpointCoordinateDecimals = 3
+
+// CountTripEndpointsInBoundingBoxes returns the number of trips picked up and dropped off around each location inside any of the bounding boxes, per pickup date
+// trips are aggregated in the database by coordinates rounded to endpointCoordinateDecimals decimals, the raw GPS coordinates being almost all unique
+// locations are the rounded coordinates, trips within about 55 meters of a zone border may be counted in the neighbouring zone
+// boxes: areas to search, edges included
+// startDate: first pickup date, inclusive
+// endDate: last pickup date, inclusive
+func (m *MySQLDBContext) CountTripEndpointsInBoundingBoxes(ctx context.Context, boxes []geo.BoundingBox, startDate, endDate time.Time) ([]EndpointCount, error) {
+	if len(boxes) == 0 {
+		return []EndpointCount{}, nil
+	}
+
+	selects := []string{}
+	args := []interface{}{}
+	for _, endpoint := range []string{"pickup", "dropoff"} {
+		conditions := make([]string, 0, len(boxes))
+		args = append(args, startDate, endDate.AddDate(0, 0, 1))
+		for _, box := range boxes {
+			conditions = append(conditions, fmt.Sprintf("(%s_latitude BETWEEN ? AND ? AND %s_longitude BETWEEN ? AND ?)", endpoint, endpoint))
+			args = append(args, box.SouthWest.Latitude, box.NorthEast.Latitude, box.SouthWest.Longitude, box.NorthEast.Longitude)
+		}
+
+		pickups, dropoffs := 1, 0
+		if endpoint == "dropoff" {
+			pickups, dropoffs = 0, 1
+		}
+		// coordinates are rounded in the derived table, MySQL resolves the GROUP BY columns of the outer query to its columns before the select aliases
+		selects = append(selects, fmt.Sprintf("SELECT DATE(pickup_datetime) AS pickup_date, ROUND(%s_latitude, %d) AS latitude, ROUND(%s_longitude, %d) AS longitude,"+
+			" %d AS pickups, %d AS dropoffs FROM "+m.source+" WHERE pickup_datetime >= ? AND pickup_datetime < ? AND (%s)",
+			endpoint, endpointCoordinateDecimals, endpoint, endpointCoordinateDecimals, pickups, dropoffs, strings.Join(conditions, " OR ")))
+	}
+
+	query := "SELECT pickup_date, latitude, longitude, SUM(pickups), SUM(dropoffs) FROM (" + strings.Join(selects, " UNION ALL ") + ") endpoints" +
+		" GROUP BY pickup_date, latitude, longitude"
+
+	log.Printf("running query: [%s], args: %v", query, args)
+	results, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(ctx, fmt.Errorf("failed to run query: %v", err))
+	}
+	defer results.Close()
+
+	counts := []EndpointCount{}
+	for results.Next() {
+		var count EndpointCount
+		var pickupDate time.Time
+		if err := results.Scan(&pickupDate, &count.Location.Latitude, &count.Location.Longitude, &count.Pickups, &count.Dropoffs); err != nil {
+			return nil, queryError(ctx, fmt.Errorf("failed to scan row: %v", err))
+		}
+		count.PickupDate = pickupDate.Format("2006-01-02")
+		counts = append(counts, count)
+	}
+
+	return counts, queryError(ctx, results.Err())
+}
+
+// GetTripEndpoints returns the pickup and dropoff locations of the trips picked up within a date range
+// cabIDs: list of cab IDs to search, all cabs if empty
+// startDate: first pickup date, inclusive
+// endDate: last pickup date, inclusive
+func (m *MySQLDBContext) GetTripEndpoints(ctx context.Context, cabIDs []string, startDate, endDate time.Time) ([]TripEndpoints, error) {
+	query := "SELECT COALESCE(medallion, '') AS cab_id, DATE(pickup_datetime) AS pickup_date, pickup_latitude, pickup_longitude, dropoff_latitude, dropoff_longitude" +
+		" FROM " + m.source + " WHERE pickup_datetime >= ? AND pickup_datetime < ?"
+	args := []interface{}{startDate, endDate.AddDate(0, 0, 1)}
+
+	return m.queryTripEndpointsOfCabs(ctx, query, args, cabIDs)
+}
+
+// queryTripEndpointsOfCabs runs the query of queryTripEndpoints with a 'medallion IN (...)' condition per chunk of up to maxQueryIDs cab IDs
+// the query runs once without condition if cabIDs is empty
+func (m *MySQLDBContext) queryTripEndpointsOfCabs(ctx context.Context, query string, args []interface{}, cabIDs []string) ([]TripEndpoints, error) {
+	if len(cabIDs) == 0 {
+		return m.queryTripEndpoints(ctx, query, args)
+	}
+
+	trips := []TripEndpoints{}
+	for _, chunk := range chunkIDs(sortedUnique(cabIDs), maxQueryIDs) {
+		chunkQuery := query + fmt.Sprintf(" AND medallion IN (%s)", placeholders(len(chunk)))
+		chunkArgs := append(append([]interface{}{}, args...), stringArgs(chunk)...)
+
+		chunkTrips, err := m.queryTripEndpoints(ctx, chunkQuery, chunkArgs)
+		if err != nil {
+			return nil, err
+		}
+		trips = append(trips, chunkTrips...)
+	}
+
+	return trips, nil
+}
+
+// queryTripEndpoints runs a query returning (cab id, pickup date, pickup latitude, pickup longitude, dropoff latitude, dropoff longitude) rows
+func (m *MySQLDBContext) queryTripEndpoints(ctx context.Context, query string, args []interface{}) ([]TripEndpoints, error) {
+	log.Printf("running query: [%s], args: %v", query, args)
+	results, err := m.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, queryError(ctx, fmt.Errorf("failed to run query: %v", err))
+	}
+	defer results.Close()
+
+	trips := []TripEndpoints{}
+	for results.Next() {
+		var trip TripEndpoints
+		var pickupDate time.Time
+		err := results.Scan(&trip.CabID, &pickupDate,
+			&trip.Pickup.Latitude, &trip.Pickup.Longitude, &trip.Dropoff.Latitude, &trip.Dropoff.Longitude)
+		if err != nil {
+			return nil, queryError(ctx, fmt.Errorf("failed to scan row: %v", err))
+		}
+		trip.PickupDate = pickupDate.Format("2006-01-02")
+		trips = append(trips, trip)
+	}
+
+	return trips, queryError(ctx, results.Err())
+}
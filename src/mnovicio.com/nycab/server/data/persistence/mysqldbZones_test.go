@@ -0,0 +1,120 @@
+package persistence
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"mnovicio.com/nycab/server/geo"
+)
+
+func TestChunkIDs(t *testing.T) {
+	tests := []struct {
+		ids  []string
+		size int
+		want [][]string
+	}{
+		{nil, 2, [][]string{}},
+		{[]string{"a"}, 2, [][]string{{"a"}}},
+		{[]string{"a", "b"}, 2, [][]string{{"a", "b"}}},
+		{[]string{"a", "b", "c", "d", "e"}, 2, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}},
+	}
+
+	for _, test := range tests {
+		if got := chunkIDs(test.ids, test.size); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("chunkIDs(%v, %d) = %v, want %v", test.ids, test.size, got, test.want)
+		}
+	}
+}
+
+func TestGetTripEndpointsChunksCabIDs(t *testing.T) {
+	day := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+	m, fake := newFakeDBContext(t, YellowDataset, fakeQuery{
+		match:   "AS cab_id, DATE(pickup_datetime)",
+		columns: []string{"cab_id", "pickup_date", "pickup_latitude", "pickup_longitude", "dropoff_latitude", "dropoff_longitude"},
+		rows:    [][]driver.Value{{"A", day, 40.64, -73.78, 40.75, -73.99}},
+	})
+
+	tests := []struct {
+		name        string
+		cabIDs      []string
+		wantQueries int
+	}{
+		{"all cabs", nil, 1},
+		{"under the placeholder limit", []string{"A", "B"}, 1},
+		{"over the placeholder limit", cabIDs(maxQueryIDs + 1), 2},
+		// duplicates are not queried twice
+		{"duplicates", append(cabIDs(maxQueryIDs), cabIDs(maxQueryIDs)...), 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			before := fake.queriesRan("")
+			trips, err := m.GetTripEndpoints(context.Background(), test.cabIDs, day, day)
+			if err != nil {
+				t.Fatalf("GetTripEndpoints() = %v", err)
+			}
+			queries := fake.queriesRan("") - before
+			if queries != test.wantQueries {
+				t.Errorf("queries = %d, want %d", queries, test.wantQueries)
+			}
+			// each chunk returns the fake row
+			if len(trips) != queries || trips[0].CabID != "A" || trips[0].PickupDate != "2013-12-01" {
+				t.Errorf("trips = %v, want %d trips of cab A on 2013-12-01", trips, queries)
+			}
+
+			fake.Lock()
+			last := fake.ran[len(fake.ran)-1]
+			fake.Unlock()
+			if len(test.cabIDs) == 0 && strings.Contains(last, "medallion IN") {
+				t.Errorf("query [%s] filters cabs, want all cabs", last)
+			}
+		})
+	}
+}
+
+func TestCountTripEndpointsInBoundingBoxesRoundsCoordinates(t *testing.T) {
+	day := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+	m, fake := newFakeDBContext(t, YellowDataset, fakeQuery{
+		match:   "UNION ALL",
+		columns: []string{"pickup_date", "latitude", "longitude", "pickups", "dropoffs"},
+		rows:    [][]driver.Value{{day, 40.645, -73.782, int64(12), int64(3)}},
+	})
+
+	box := geo.BoundingBox{
+		SouthWest: geo.Point{Latitude: 40.62, Longitude: -73.83},
+		NorthEast: geo.Point{Latitude: 40.67, Longitude: -73.74},
+	}
+	counts, err := m.CountTripEndpointsInBoundingBoxes(context.Background(), []geo.BoundingBox{box}, day, day)
+	if err != nil {
+		t.Fatalf("CountTripEndpointsInBoundingBoxes() = %v", err)
+	}
+	if len(counts) != 1 || counts[0].PickupDate != "2013-12-01" || counts[0].Pickups != 12 || counts[0].Dropoffs != 3 {
+		t.Errorf("CountTripEndpointsInBoundingBoxes() = %v, want 12 pickups and 3 dropoffs on 2013-12-01", counts)
+	}
+
+	// both endpoints are grouped by rounded coordinates
+	fake.Lock()
+	query := fake.ran[0]
+	fake.Unlock()
+	for _, endpoint := range []string{"pickup", "dropoff"} {
+		for _, coordinate := range []string{"latitude", "longitude"} {
+			if rounded := fmt.Sprintf("ROUND(%s_%s, %d) AS %s", endpoint, coordinate, endpointCoordinateDecimals, coordinate); !strings.Contains(query, rounded) {
+				t.Errorf("query [%s] does not select %s", query, rounded)
+			}
+		}
+	}
+}
+
+// cabIDs returns n distinct cab IDs
+func cabIDs(n int) []string {
+	ids := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		ids = append(ids, fmt.Sprintf("cab%d", i))
+	}
+	return ids
+}
@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCacheTripCountNotifiesRefreshedCabs(t *testing.T) {
+	m := &MySQLDBContext{
+		cache:       newCache(),
+		driverCache: newCache(),
+	}
+	notified := [][]string{}
+	m.OnCacheRefreshed(func(cabIDs []string) {
+		notified = append(notified, cabIDs)
+	})
+
+	tests := []struct {
+		name  string
+		cache *Cache
+		id    string
+		count uint32
+		want  bool
+	}{
+		{"first count", m.cache, "A", 3, false},
+		{"same count", m.cache, "A", 3, false},
+		{"different count", m.cache, "A", 4, true},
+		{"driver count", m.driverCache, "A", 4, false},
+		{"different driver count", m.driverCache, "A", 5, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := m.cacheTripCount(test.cache, test.id, "2013-12-01", test.count); got != test.want {
+				t.Errorf("cacheTripCount() = %t, want %t", got, test.want)
+			}
+			if got := test.cache.m.CabTrips[test.id].GetTripsPerDay()["2013-12-01"]; got != test.count {
+				t.Errorf("cached count = %d, want %d", got, test.count)
+			}
+		})
+	}
+
+	// only the cab trip counts cache is reported, with unique sorted cab IDs
+	m.notifyCacheRefreshed(m.cache, []string{"B", "A", "B"})
+	m.notifyCacheRefreshed(m.cache, nil)
+	m.notifyCacheRefreshed(m.driverCache, []string{"C"})
+	if want := [][]string{{"A", "B"}}; !reflect.DeepEqual(notified, want) {
+		t.Errorf("notified = %v, want %v", notified, want)
+	}
+}
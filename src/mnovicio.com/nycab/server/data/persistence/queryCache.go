@@ -0,0 +1,133 @@
+package persistence
+
+import (
+	"container/list"
+	"log"
+	"reflect"
+	"sync"
+)
+
+const (
+	// maxQueryCacheRows is the number of result rows the query cache of a dataset holds before evicting the least recently used results
+	maxQueryCacheRows = 1000000
+	// maxQueryCacheKeyLength is the length of the longest cached key, results of queries with longer keys (e.g. thousands of cab IDs) are not cached
+	maxQueryCacheKeyLength = 4096
+)
+
+// QueryCache synchronized LRU cache for aggregation results keyed by query parameters
+// the cache holds up to maxRows result rows, a result being one row unless it is a slice
+type QueryCache struct {
+	sync.Mutex
+	maxRows int
+	rows    int
+	// results holds the elements of lru keyed by query parameters
+	results map[string]*list.Element
+	// lru holds the cached results, most recently used first
+	lru *list.List
+	// generation is incremented whenever the cache is cleared
+	generation uint64
+}
+
+type queryCacheEntry struct {
+	key    string
+	result interface{}
+	rows   int
+}
+
+func newQueryCache(maxRows int) *QueryCache {
+	return &QueryCache{
+		maxRows: maxRows,
+		results: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// get returns the result cached for key, and marks it as the most recently used
+func (c *QueryCache) get(key string) (interface{}, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	element, found := c.results[key]
+	if !found {
+		return nil, false
+	}
+	c.lru.MoveToFront(element)
+	return element.Value.(*queryCacheEntry).result, true
+}
+
+// set caches the result for key, evicting the least recently used results once the cache holds more than maxRows rows
+// results with keys longer than maxQueryCacheKeyLength, or with more than maxRows rows, are not cached
+func (c *QueryCache) set(key string, result interface{}) {
+	if len(key) > maxQueryCacheKeyLength {
+		log.Printf("not caching result of %d characters long key [%.64s...]", len(key), key)
+		return
+	}
+	rows := resultRows(result)
+	if rows > c.maxRows {
+		log.Printf("not caching result of %d rows for [%s]", rows, key)
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if element, found := c.results[key]; found {
+		c.remove(element)
+	}
+	c.results[key] = c.lru.PushFront(&queryCacheEntry{
+		key:    key,
+		result: result,
+		rows:   rows,
+	})
+	c.rows += rows
+
+	for c.rows > c.maxRows {
+		c.remove(c.lru.Back())
+	}
+}
+
+// currentGeneration returns the number of times the cache was cleared, to be passed to setSince
+func (c *QueryCache) currentGeneration() uint64 {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.generation
+}
+
+// setSince caches the result for key unless the cache was cleared since generation was read, the result may be stale then
+func (c *QueryCache) setSince(generation uint64, key string, result interface{}) {
+	c.Lock()
+	current := c.generation == generation
+	c.Unlock()
+
+	if current {
+		c.set(key, result)
+	}
+}
+
+// clear removes every cached result
+func (c *QueryCache) clear() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.results = make(map[string]*list.Element)
+	c.lru.Init()
+	c.rows = 0
+	c.generation++
+}
+
+// remove removes a cached result, the cache must be locked
+func (c *QueryCache) remove(element *list.Element) {
+	entry := c.lru.Remove(element).(*queryCacheEntry)
+	delete(c.results, entry.key)
+	c.rows -= entry.rows
+}
+
+// resultRows returns the number of rows of a result, its length if it is a slice, 1 otherwise
+func resultRows(result interface{}) int {
+	value := reflect.ValueOf(result)
+	if value.Kind() == reflect.Slice && value.Len() > 0 {
+		return value.Len()
+	}
+	return 1
+}
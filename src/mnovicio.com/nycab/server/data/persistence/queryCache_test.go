@@ -0,0 +1,105 @@
+package persistence
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestQueryCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newQueryCache(3)
+	cache.set("a", 1)
+	cache.set("b", 2)
+	cache.set("c", 3)
+
+	// reading a makes b the least recently used result
+	if result, found := cache.get("a"); !found || result != 1 {
+		t.Fatalf("get(a) = %v, %t, want 1, true", result, found)
+	}
+	cache.set("d", 4)
+
+	for key, want := range map[string]bool{"a": true, "b": false, "c": true, "d": true} {
+		if _, found := cache.get(key); found != want {
+			t.Errorf("get(%s) found = %t, want %t", key, found, want)
+		}
+	}
+}
+
+func TestQueryCacheCountsSliceRows(t *testing.T) {
+	cache := newQueryCache(5)
+	cache.set("fleet", 1)
+	cache.set("cells", []string{"a", "b", "c"})
+	if cache.rows != 4 {
+		t.Fatalf("rows = %d, want 4", cache.rows)
+	}
+
+	// replacing a result releases its rows
+	cache.set("cells", []string{"a", "b"})
+	if cache.rows != 3 {
+		t.Fatalf("rows after replacing cells = %d, want 3", cache.rows)
+	}
+
+	// 3 more rows evict the fleet result, then the cells
+	cache.set("matrix", []int{1, 2, 3})
+	if _, found := cache.get("fleet"); found {
+		t.Error("fleet still cached, want evicted")
+	}
+	if _, found := cache.get("cells"); !found {
+		t.Error("cells evicted, want cached")
+	}
+	cache.set("more", 1)
+	if _, found := cache.get("matrix"); found {
+		t.Error("matrix still cached, want evicted as least recently used")
+	}
+	if cache.rows > 5 {
+		t.Errorf("rows = %d, want at most 5", cache.rows)
+	}
+}
+
+func TestQueryCacheRejectsOversizedEntries(t *testing.T) {
+	cache := newQueryCache(2)
+
+	longKey := "revenue:" + strings.Repeat("D7D598CD99978BD012A87A76A7C891B7,", 200)
+	cache.set(longKey, 1)
+	if _, found := cache.get(longKey); found {
+		t.Errorf("result of %d characters long key cached, want rejected", len(longKey))
+	}
+
+	cache.set("cells", []int{1, 2, 3})
+	if _, found := cache.get("cells"); found {
+		t.Error("result larger than the cache cached, want rejected")
+	}
+	if cache.rows != 0 {
+		t.Errorf("rows = %d, want 0", cache.rows)
+	}
+}
+
+func TestQueryCacheClear(t *testing.T) {
+	cache := newQueryCache(10)
+	cache.set("a", []int{1, 2})
+	cache.clear()
+
+	if _, found := cache.get("a"); found {
+		t.Error("get(a) found after clear")
+	}
+	if cache.rows != 0 || cache.lru.Len() != 0 {
+		t.Errorf("rows = %d, entries = %d after clear, want 0", cache.rows, cache.lru.Len())
+	}
+}
+
+func TestQueryCacheSkipsResultsFetchedBeforeClear(t *testing.T) {
+	cache := newQueryCache(10)
+
+	generation := cache.currentGeneration()
+	cache.setSince(generation, "fresh", 1)
+	if _, found := cache.get("fresh"); !found {
+		t.Fatal("fresh not cached, want cached")
+	}
+
+	// a result fetched before the cache is cleared may be stale
+	generation = cache.currentGeneration()
+	cache.clear()
+	cache.setSince(generation, "stale", 2)
+	if _, found := cache.get("stale"); found {
+		t.Error("stale cached, want skipped after clear")
+	}
+}
@@ -0,0 +1,219 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"sort"
+	"time"
+)
+
+// parquetMagic starts and ends Parquet files
+const parquetMagic = "PAR1"
+
+// Parquet physical types, converted types, repetition types, encodings and page types
+const (
+	parquetBoolean   = 0
+	parquetInt32     = 1
+	parquetInt64     = 2
+	parquetDouble    = 5
+	parquetByteArray = 6
+
+	parquetUTF8 = 0
+	parquetDate = 6
+
+	parquetOptional = 1
+
+	parquetPlain = 0
+	parquetRLE   = 3
+
+	parquetDataPage = 0
+)
+
+// parquetTypes maps the column types to Parquet physical and converted types, -1 if there is no converted type
+var parquetTypes = map[ColumnType][2]int32{
+	String: {parquetByteArray, parquetUTF8},
+	Int32:  {parquetInt32, -1},
+	Int64:  {parquetInt64, -1},
+	Double: {parquetDouble, -1},
+	Bool:   {parquetBoolean, -1},
+	Date:   {parquetInt32, parquetDate},
+}
+
+// columnChunk is a column of the single row group of a Parquet file
+type columnChunk struct {
+	offset int64
+	size   int64
+}
+
+// WriteParquet writes the table as an uncompressed Parquet file with a single row group of optional flat columns
+// nil values are nulls, the metadata key values are written in the file metadata
+func WriteParquet(w io.Writer, t *Table, metadata map[string]string) error {
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+
+	chunks := make([]columnChunk, len(t.Columns))
+	for i := range t.Columns {
+		page := parquetPage(t, i)
+
+		header := &compactWriter{}
+		header.begin()
+		header.i32Field(1, parquetDataPage)
+		header.i32Field(2, int32(len(page)))
+		header.i32Field(3, int32(len(page)))
+		header.structField(5)
+		header.i32Field(1, int32(len(t.Rows)))
+		header.i32Field(2, parquetPlain)
+		header.i32Field(3, parquetRLE)
+		header.i32Field(4, parquetRLE)
+		header.end()
+		header.end()
+
+		chunks[i] = columnChunk{
+			offset: int64(file.Len()),
+			size:   int64(header.Len() + len(page)),
+		}
+		file.Write(header.Bytes())
+		file.Write(page)
+	}
+
+	footer := parquetFooter(t, chunks, metadata)
+	file.Write(footer)
+	binary.Write(&file, binary.LittleEndian, uint32(len(footer)))
+	file.WriteString(parquetMagic)
+
+	_, err := w.Write(file.Bytes())
+	return err
+}
+
+// parquetPage returns the data page of a column: its definition levels followed by its non-null values, PLAIN encoded
+func parquetPage(t *Table, column int) []byte {
+	levels := &bytes.Buffer{}
+	values := &bytes.Buffer{}
+	bools := []bool{}
+	for start := 0; start < len(t.Rows); {
+		// RLE run of rows with the same definition level, 1 if the value is set
+		defined := t.Rows[start][column] != nil
+		end := start
+		for ; end < len(t.Rows) && (t.Rows[end][column] != nil) == defined; end++ {
+			value := t.Rows[end][column]
+			switch v := value.(type) {
+			case string:
+				binary.Write(values, binary.LittleEndian, uint32(len(v)))
+				values.WriteString(v)
+			case int32:
+				binary.Write(values, binary.LittleEndian, v)
+			case int64:
+				binary.Write(values, binary.LittleEndian, v)
+			case float64:
+				binary.Write(values, binary.LittleEndian, math.Float64bits(v))
+			case bool:
+				bools = append(bools, v)
+			case time.Time:
+				binary.Write(values, binary.LittleEndian, int32(v.Unix()/(24*60*60)))
+			}
+		}
+
+		var run [binary.MaxVarintLen64]byte
+		levels.Write(run[:binary.PutUvarint(run[:], uint64(end-start)<<1)])
+		if defined {
+			levels.WriteByte(1)
+		} else {
+			levels.WriteByte(0)
+		}
+		start = end
+	}
+
+	// booleans are bit-packed, least significant bit first
+	if len(bools) > 0 {
+		packed := make([]byte, (len(bools)+7)/8)
+		for i, value := range bools {
+			if value {
+				packed[i/8] |= 1 << uint(i%8)
+			}
+		}
+		values.Write(packed)
+	}
+
+	page := &bytes.Buffer{}
+	binary.Write(page, binary.LittleEndian, uint32(levels.Len()))
+	page.Write(levels.Bytes())
+	page.Write(values.Bytes())
+	return page.Bytes()
+}
+
+// parquetFooter returns the file metadata of the table whose column chunks were written at chunks
+func parquetFooter(t *Table, chunks []columnChunk, metadata map[string]string) []byte {
+	footer := &compactWriter{}
+	footer.begin()
+	footer.i32Field(1, 1)
+
+	// schema: root followed by the columns
+	footer.listField(2, thriftStruct, len(t.Columns)+1)
+	footer.begin()
+	footer.stringField(4, "schema")
+	footer.i32Field(5, int32(len(t.Columns)))
+	footer.end()
+	for _, column := range t.Columns {
+		types := parquetTypes[column.Type]
+		footer.begin()
+		footer.i32Field(1, types[0])
+		footer.i32Field(3, parquetOptional)
+		footer.stringField(4, column.Name)
+		if types[1] >= 0 {
+			footer.i32Field(6, types[1])
+		}
+		footer.end()
+	}
+
+	footer.i64Field(3, int64(len(t.Rows)))
+
+	// single row group
+	var rowGroupSize int64
+	for _, chunk := range chunks {
+		rowGroupSize += chunk.size
+	}
+	footer.listField(4, thriftStruct, 1)
+	footer.begin()
+	footer.listField(1, thriftStruct, len(t.Columns))
+	for i, column := range t.Columns {
+		footer.begin()
+		footer.i64Field(2, chunks[i].offset)
+		footer.structField(3)
+		footer.i32Field(1, parquetTypes[column.Type][0])
+		footer.listField(2, thriftI32, 2)
+		footer.i32(parquetPlain)
+		footer.i32(parquetRLE)
+		footer.listField(3, thriftBinary, 1)
+		footer.string(column.Name)
+		footer.i32Field(4, 0) // uncompressed
+		footer.i64Field(5, int64(len(t.Rows)))
+		footer.i64Field(6, chunks[i].size)
+		footer.i64Field(7, chunks[i].size)
+		footer.i64Field(9, chunks[i].offset)
+		footer.end()
+		footer.end()
+	}
+	footer.i64Field(2, rowGroupSize)
+	footer.i64Field(3, int64(len(t.Rows)))
+	footer.end()
+
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	footer.listField(5, thriftStruct, len(keys))
+	for _, key := range keys {
+		footer.begin()
+		footer.stringField(1, key)
+		footer.stringField(2, metadata[key])
+		footer.end()
+	}
+
+	footer.stringField(6, "nycab")
+	footer.end()
+
+	return footer.Bytes()
+}
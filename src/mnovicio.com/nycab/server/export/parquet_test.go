@@ -0,0 +1,80 @@
+package export
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func TestWriteParquetRoundTrip(t *testing.T) {
+	day := time.Date(2013, 1, 2, 0, 0, 0, 0, time.UTC)
+	table := &Table{
+		Columns: []Column{
+			{Name: "cab_id", Type: String},
+			{Name: "passenger_count", Type: Int32},
+			{Name: "trips", Type: Int64},
+			{Name: "share", Type: Double},
+			{Name: "is_holiday", Type: Bool},
+			{Name: "date", Type: Date},
+		},
+		Rows: [][]interface{}{
+			{"A", int32(1), int64(10), 0.5, true, day},
+			{"B", nil, nil, nil, nil, nil},
+			{"", int32(-2), int64(1) << 40, 1.25, false, day.AddDate(0, 0, 1)},
+			{"C", int32(3), int64(0), 0.0, true, nil},
+		},
+	}
+	metadata := map[string]string{"nycab.schema": `{"query":"tip_rates"}`, "other": "value"}
+
+	var data bytes.Buffer
+	if err := WriteParquet(&data, table, metadata); err != nil {
+		t.Fatalf("WriteParquet() = %v", err)
+	}
+
+	file, err := buffer.NewBufferFile(data.Bytes())
+	if err != nil {
+		t.Fatalf("NewBufferFile() = %v", err)
+	}
+	pr, err := reader.NewParquetColumnReader(file, 1)
+	if err != nil {
+		t.Fatalf("NewParquetColumnReader() = %v", err)
+	}
+	defer pr.ReadStop()
+
+	if rows := pr.GetNumRows(); rows != int64(len(table.Rows)) {
+		t.Errorf("rows = %d, want %d", rows, len(table.Rows))
+	}
+
+	gotMetadata := make(map[string]string)
+	for _, keyValue := range pr.Footer.KeyValueMetadata {
+		if keyValue.Value != nil {
+			gotMetadata[keyValue.Key] = *keyValue.Value
+		}
+	}
+	if !reflect.DeepEqual(gotMetadata, metadata) {
+		t.Errorf("metadata = %v, want %v", gotMetadata, metadata)
+	}
+
+	for i, column := range table.Columns {
+		values, _, _, err := pr.ReadColumnByIndex(int64(i), int64(len(table.Rows)))
+		if err != nil {
+			t.Fatalf("ReadColumnByIndex(%s) = %v", column.Name, err)
+		}
+
+		want := make([]interface{}, len(table.Rows))
+		for r, row := range table.Rows {
+			want[r] = row[i]
+			// dates are stored as days since the epoch
+			if date, ok := row[i].(time.Time); ok {
+				want[r] = int32(date.Unix() / (24 * 60 * 60))
+			}
+		}
+		if !reflect.DeepEqual(values, want) {
+			t.Errorf("column %s = %v, want %v", column.Name, values, want)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package export
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/type/date"
+)
+
+// ColumnType is the type of the values of a column
+type ColumnType string
+
+// Column types, DATE values are time.Time at midnight UTC
+const (
+	String ColumnType = "STRING"
+	Int32  ColumnType = "INT32"
+	Int64  ColumnType = "INT64"
+	Double ColumnType = "DOUBLE"
+	Bool   ColumnType = "BOOL"
+	Date   ColumnType = "DATE"
+)
+
+// dateFormat is the format of the string date fields of the messages, e.g. '2013-01-01'
+const dateFormat = "2006-01-02"
+
+// Column describes a column of a table
+type Column struct {
+	Name string
+	Type ColumnType
+	// message is the index of the message of the row holding the column
+	message int
+	// field is the index path of the column field in the message struct
+	field []int
+}
+
+// Table holds rows made of one or more protobuf messages, flattened to columns
+// scalar fields of the messages are columns, nested messages are flattened to columns prefixed with the field name,
+// repeated and map fields are skipped: rows of nested repeated messages are appended as messages of their own
+// google.type.Date fields and string fields named 'date' are DATE columns
+// values are nil for unset nested messages, empty dates and the columns of nil row messages
+type Table struct {
+	Columns []Column
+	Rows    [][]interface{}
+}
+
+// NewTable returns an empty table of the columns of the row messages, the messages are only used for their types
+func NewTable(row ...proto.Message) (*Table, error) {
+	t := &Table{}
+	names := make(map[string]bool)
+	for i, message := range row {
+		columns := messageColumns(reflect.TypeOf(message).Elem(), "", nil)
+		for _, column := range columns {
+			if names[column.Name] {
+				return nil, fmt.Errorf("duplicate column [%s] in %T", column.Name, message)
+			}
+			names[column.Name] = true
+			column.message = i
+			t.Columns = append(t.Columns, column)
+		}
+	}
+
+	return t, nil
+}
+
+// messageColumns returns the columns of the fields of a message struct, prefixed with prefix
+func messageColumns(messageType reflect.Type, prefix string, index []int) []Column {
+	columns := []Column{}
+	for i := 0; i < messageType.NumField(); i++ {
+		field := messageType.Field(i)
+		name := protoFieldName(field)
+		if name == "" {
+			continue
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+
+		columnType, nested := fieldColumnType(field.Type, name)
+		switch {
+		case columnType != "":
+			columns = append(columns, Column{
+				Name:  prefix + name,
+				Type:  columnType,
+				field: fieldIndex,
+			})
+		case nested:
+			columns = append(columns, messageColumns(field.Type.Elem(), prefix+name+"_", fieldIndex)...)
+		}
+	}
+	return columns
+}
+
+// protoFieldName returns the name of a protobuf field from its struct tag, empty for oneof and internal fields
+func protoFieldName(field reflect.StructField) string {
+	for _, option := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(option, "name=") {
+			return strings.TrimPrefix(option, "name=")
+		}
+	}
+	return ""
+}
+
+// fieldColumnType returns the column type of a field, or true if the field is a nested message to flatten
+// returns neither for repeated and map fields
+func fieldColumnType(fieldType reflect.Type, name string) (ColumnType, bool) {
+	if fieldType == reflect.TypeOf(&date.Date{}) {
+		return Date, false
+	}
+
+	switch fieldType.Kind() {
+	case reflect.String:
+		if name == "date" {
+			return Date, false
+		}
+		return String, false
+	case reflect.Bool:
+		return Bool, false
+	case reflect.Int32:
+		// enums are exported by name
+		if fieldType.Implements(reflect.TypeOf((*fmt.Stringer)(nil)).Elem()) {
+			return String, false
+		}
+		return Int32, false
+	case reflect.Int64, reflect.Uint32, reflect.Uint64:
+		return Int64, false
+	case reflect.Float32, reflect.Float64:
+		return Double, false
+	case reflect.Ptr:
+		return "", fieldType.Elem().Kind() == reflect.Struct
+	}
+	return "", false
+}
+
+// Append appends a row made of messages of the same types as the ones the table was created with
+// the columns of nil messages are nil
+func (t *Table) Append(row ...proto.Message) error {
+	values := make([]interface{}, len(t.Columns))
+	for i, column := range t.Columns {
+		message := reflect.ValueOf(row[column.message])
+		if !message.IsValid() || message.IsNil() {
+			continue
+		}
+		value, err := columnValue(message.Elem(), column)
+		if err != nil {
+			return err
+		}
+		values[i] = value
+	}
+	t.Rows = append(t.Rows, values)
+
+	return nil
+}
+
+// columnValue returns the value of the column field of a message struct
+func columnValue(message reflect.Value, column Column) (interface{}, error) {
+	value := message
+	for i, index := range column.field {
+		if i > 0 {
+			// nested message
+			if value.IsNil() {
+				return nil, nil
+			}
+			value = value.Elem()
+		}
+		value = value.Field(index)
+	}
+
+	switch column.Type {
+	case Date:
+		return dateValue(value)
+	case String:
+		if value.Kind() != reflect.String {
+			return fmt.Sprint(value.Interface()), nil
+		}
+		return value.String(), nil
+	case Int32:
+		return int32(value.Int()), nil
+	case Int64:
+		if value.Kind() == reflect.Int64 {
+			return value.Int(), nil
+		}
+		return int64(value.Uint()), nil
+	case Double:
+		return value.Float(), nil
+	case Bool:
+		return value.Bool(), nil
+	}
+	return nil, fmt.Errorf("unsupported column type [%s] of column [%s]", column.Type, column.Name)
+}
+
+// dateValue returns the time of a google.type.Date or 'YYYY-MM-DD' string field, nil if unset
+func dateValue(value reflect.Value) (interface{}, error) {
+	if value.Kind() == reflect.String {
+		if value.String() == "" {
+			return nil, nil
+		}
+		t, err := time.Parse(dateFormat, value.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse date [%s]: %v", value.String(), err)
+		}
+		return t, nil
+	}
+
+	d, _ := value.Interface().(*date.Date)
+	if d == nil {
+		return nil, nil
+	}
+	return time.Date(int(d.Year), time.Month(d.Month), int(d.Day), 0, 0, 0, 0, time.UTC), nil
+}
@@ -0,0 +1,93 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// thrift compact protocol types of the Parquet metadata fields
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// compactWriter encodes Parquet metadata structs with the thrift compact protocol
+type compactWriter struct {
+	bytes.Buffer
+	// lastField is the ID of the last field written of each open struct
+	lastField []int16
+}
+
+// begin opens a struct, top-level or element of a list
+func (w *compactWriter) begin() {
+	w.lastField = append(w.lastField, 0)
+}
+
+// end closes the innermost open struct
+func (w *compactWriter) end() {
+	w.WriteByte(0)
+	w.lastField = w.lastField[:len(w.lastField)-1]
+}
+
+func (w *compactWriter) field(id int16, fieldType byte) {
+	last := &w.lastField[len(w.lastField)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.WriteByte(byte(delta)<<4 | fieldType)
+	} else {
+		w.WriteByte(fieldType)
+		w.varint(zigzag(int64(id)))
+	}
+	*last = id
+}
+
+func (w *compactWriter) structField(id int16) {
+	w.field(id, thriftStruct)
+	w.begin()
+}
+
+func (w *compactWriter) i32Field(id int16, value int32) {
+	w.field(id, thriftI32)
+	w.i32(value)
+}
+
+func (w *compactWriter) i64Field(id int16, value int64) {
+	w.field(id, thriftI64)
+	w.varint(zigzag(value))
+}
+
+func (w *compactWriter) stringField(id int16, value string) {
+	w.field(id, thriftBinary)
+	w.string(value)
+}
+
+// listField writes the header of a list field, followed by its size elements
+func (w *compactWriter) listField(id int16, elementType byte, size int) {
+	w.field(id, thriftList)
+	if size < 15 {
+		w.WriteByte(byte(size)<<4 | elementType)
+		return
+	}
+	w.WriteByte(0xf0 | elementType)
+	w.varint(uint64(size))
+}
+
+func (w *compactWriter) i32(value int32) {
+	w.varint(zigzag(int64(value)))
+}
+
+func (w *compactWriter) string(value string) {
+	w.varint(uint64(len(value)))
+	w.WriteString(value)
+}
+
+func (w *compactWriter) varint(value uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.Write(buf[:binary.PutUvarint(buf[:], value)])
+}
+
+func zigzag(value int64) uint64 {
+	return uint64(value<<1) ^ uint64(value>>63)
+}
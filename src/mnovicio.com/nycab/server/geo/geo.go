@@ -0,0 +1,119 @@
+package geo
+
+import (
+	"fmt"
+)
+
+// Point is a WGS84 coordinate
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// BoundingBox is a rectangular area delimited by its south west and north east corners
+type BoundingBox struct {
+	SouthWest Point
+	NorthEast Point
+}
+
+// Polygon is a closed area delimited by its vertices, the last vertex connects back to the first one
+type Polygon []Point
+
+// IsZero returns true for the (0,0) point used by the raw trip data for missing coordinates
+func (p Point) IsZero() bool {
+	return p.Latitude == 0 && p.Longitude == 0
+}
+
+// Validate returns an error if the point is not a valid coordinate
+func (p Point) Validate() error {
+	if p.Latitude < -90 || p.Latitude > 90 {
+		return fmt.Errorf("invalid latitude [%f], expecting value between -90 and 90", p.Latitude)
+	}
+
+	if p.Longitude < -180 || p.Longitude > 180 {
+		return fmt.Errorf("invalid longitude [%f], expecting value between -180 and 180", p.Longitude)
+	}
+
+	if p.IsZero() {
+		return fmt.Errorf("invalid coordinate (0,0)")
+	}
+
+	return nil
+}
+
+// Validate returns an error if the corners are not valid coordinates or are swapped
+func (b BoundingBox) Validate() error {
+	if err := b.SouthWest.Validate(); err != nil {
+		return fmt.Errorf("south west corner: %v", err)
+	}
+
+	if err := b.NorthEast.Validate(); err != nil {
+		return fmt.Errorf("north east corner: %v", err)
+	}
+
+	if b.SouthWest.Latitude > b.NorthEast.Latitude || b.SouthWest.Longitude > b.NorthEast.Longitude {
+		return fmt.Errorf("south west corner %v must be south west of north east corner %v", b.SouthWest, b.NorthEast)
+	}
+
+	return nil
+}
+
+// Contains returns true if the point is inside the bounding box, edges included
+func (b BoundingBox) Contains(p Point) bool {
+	return p.Latitude >= b.SouthWest.Latitude && p.Latitude <= b.NorthEast.Latitude &&
+		p.Longitude >= b.SouthWest.Longitude && p.Longitude <= b.NorthEast.Longitude
+}
+
+// Validate returns an error if the polygon has less than 3 vertices or has invalid vertices
+func (pg Polygon) Validate() error {
+	if len(pg) < 3 {
+		return fmt.Errorf("polygon needs at least 3 vertices, got %d", len(pg))
+	}
+
+	for i, p := range pg {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("vertex %d: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+// Bounds returns the smallest bounding box containing the polygon
+func (pg Polygon) Bounds() BoundingBox {
+	if len(pg) == 0 {
+		return BoundingBox{}
+	}
+
+	b := BoundingBox{SouthWest: pg[0], NorthEast: pg[0]}
+	for _, p := range pg[1:] {
+		if p.Latitude < b.SouthWest.Latitude {
+			b.SouthWest.Latitude = p.Latitude
+		}
+		if p.Longitude < b.SouthWest.Longitude {
+			b.SouthWest.Longitude = p.Longitude
+		}
+		if p.Latitude > b.NorthEast.Latitude {
+			b.NorthEast.Latitude = p.Latitude
+		}
+		if p.Longitude > b.NorthEast.Longitude {
+			b.NorthEast.Longitude = p.Longitude
+		}
+	}
+
+	return b
+}
+
+// Contains returns true if the point is inside the polygon (ray casting)
+func (pg Polygon) Contains(p Point) bool {
+	inside := false
+	for i, j := 0, len(pg)-1; i < len(pg); j, i = i, i+1 {
+		a, b := pg[i], pg[j]
+		if (a.Latitude > p.Latitude) != (b.Latitude > p.Latitude) &&
+			p.Longitude < (b.Longitude-a.Longitude)*(p.Latitude-a.Latitude)/(b.Latitude-a.Latitude)+a.Longitude {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
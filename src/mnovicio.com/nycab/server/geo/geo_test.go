@@ -0,0 +1,134 @@
+package geo
+
+import (
+	"testing"
+)
+
+func TestPointValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		point Point
+		valid bool
+	}{
+		{"manhattan", Point{Latitude: 40.7580, Longitude: -73.9855}, true},
+		{"poles and antimeridian", Point{Latitude: -90, Longitude: 180}, true},
+		{"zero", Point{}, false},
+		{"latitude out of range", Point{Latitude: 90.1, Longitude: -73.9855}, false},
+		{"longitude out of range", Point{Latitude: 40.7580, Longitude: -180.1}, false},
+	}
+
+	for _, test := range tests {
+		if err := test.point.Validate(); (err == nil) != test.valid {
+			t.Errorf("%s: Validate(%v) = %v, want valid %t", test.name, test.point, err, test.valid)
+		}
+	}
+}
+
+func TestBoundingBoxValidate(t *testing.T) {
+	sw := Point{Latitude: 40.70, Longitude: -74.02}
+	ne := Point{Latitude: 40.80, Longitude: -73.93}
+
+	if err := (BoundingBox{SouthWest: sw, NorthEast: ne}).Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+	if err := (BoundingBox{SouthWest: ne, NorthEast: sw}).Validate(); err == nil {
+		t.Error("Validate() of swapped corners = nil, want error")
+	}
+	if err := (BoundingBox{SouthWest: Point{}, NorthEast: ne}).Validate(); err == nil {
+		t.Error("Validate() of (0,0) corner = nil, want error")
+	}
+}
+
+func TestBoundingBoxContains(t *testing.T) {
+	box := BoundingBox{
+		SouthWest: Point{Latitude: 40.70, Longitude: -74.02},
+		NorthEast: Point{Latitude: 40.80, Longitude: -73.93},
+	}
+
+	tests := []struct {
+		name     string
+		point    Point
+		contains bool
+	}{
+		{"inside", Point{Latitude: 40.75, Longitude: -73.99}, true},
+		{"south west corner", box.SouthWest, true},
+		{"north east edge", Point{Latitude: 40.80, Longitude: -73.95}, true},
+		{"north", Point{Latitude: 40.81, Longitude: -73.99}, false},
+		{"east", Point{Latitude: 40.75, Longitude: -73.92}, false},
+	}
+
+	for _, test := range tests {
+		if contains := box.Contains(test.point); contains != test.contains {
+			t.Errorf("%s: Contains(%v) = %t, want %t", test.name, test.point, contains, test.contains)
+		}
+	}
+}
+
+func TestPolygonContains(t *testing.T) {
+	// U shaped polygon, the notch between its arms is outside
+	polygon := Polygon{
+		{Latitude: 0, Longitude: 0},
+		{Latitude: 0, Longitude: 3},
+		{Latitude: 3, Longitude: 3},
+		{Latitude: 3, Longitude: 2},
+		{Latitude: 1, Longitude: 2},
+		{Latitude: 1, Longitude: 1},
+		{Latitude: 3, Longitude: 1},
+		{Latitude: 3, Longitude: 0},
+	}
+
+	tests := []struct {
+		name     string
+		point    Point
+		contains bool
+	}{
+		{"base", Point{Latitude: 0.5, Longitude: 1.5}, true},
+		{"left arm", Point{Latitude: 2, Longitude: 0.5}, true},
+		{"right arm", Point{Latitude: 2, Longitude: 2.5}, true},
+		{"notch", Point{Latitude: 2, Longitude: 1.5}, false},
+		{"outside bounds", Point{Latitude: 4, Longitude: 1.5}, false},
+		{"left of polygon", Point{Latitude: 2, Longitude: -1}, false},
+	}
+
+	for _, test := range tests {
+		if contains := polygon.Contains(test.point); contains != test.contains {
+			t.Errorf("%s: Contains(%v) = %t, want %t", test.name, test.point, contains, test.contains)
+		}
+	}
+}
+
+func TestPolygonValidate(t *testing.T) {
+	triangle := Polygon{
+		{Latitude: 40.70, Longitude: -74.02},
+		{Latitude: 40.80, Longitude: -73.93},
+		{Latitude: 40.70, Longitude: -73.93},
+	}
+	if err := triangle.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+	if err := triangle[:2].Validate(); err == nil {
+		t.Error("Validate() of 2 vertices = nil, want error")
+	}
+	if err := append(triangle[:2:2], Point{}).Validate(); err == nil {
+		t.Error("Validate() of (0,0) vertex = nil, want error")
+	}
+}
+
+func TestPolygonBounds(t *testing.T) {
+	polygon := Polygon{
+		{Latitude: 40.75, Longitude: -74.02},
+		{Latitude: 40.80, Longitude: -73.99},
+		{Latitude: 40.70, Longitude: -73.93},
+	}
+
+	want := BoundingBox{
+		SouthWest: Point{Latitude: 40.70, Longitude: -74.02},
+		NorthEast: Point{Latitude: 40.80, Longitude: -73.93},
+	}
+	if bounds := polygon.Bounds(); bounds != want {
+		t.Errorf("Bounds() = %v, want %v", bounds, want)
+	}
+	if bounds := (Polygon{}).Bounds(); bounds != (BoundingBox{}) {
+		t.Errorf("Bounds() of empty polygon = %v, want zero", bounds)
+	}
+}
@@ -0,0 +1,54 @@
+package geo
+
+import (
+	"fmt"
+	"strings"
+)
+
+const geohashBase32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// MaxGeohashPrecision is the longest geohash supported, about 3.7cm x 1.9cm cells
+const MaxGeohashPrecision = 12
+
+// DecodeGeohash returns the cell covered by the geohash
+func DecodeGeohash(hash string) (BoundingBox, error) {
+	if len(hash) == 0 || len(hash) > MaxGeohashPrecision {
+		return BoundingBox{}, fmt.Errorf("invalid geohash [%s], expecting 1 to %d characters", hash, MaxGeohashPrecision)
+	}
+
+	minLat, maxLat := -90.0, 90.0
+	minLng, maxLng := -180.0, 180.0
+	// bits alternate between longitude and latitude, starting with longitude
+	isLng := true
+	for _, c := range strings.ToLower(hash) {
+		idx := strings.IndexRune(geohashBase32, c)
+		if idx < 0 {
+			return BoundingBox{}, fmt.Errorf("invalid geohash [%s], unexpected character '%c'", hash, c)
+		}
+
+		for bit := 4; bit >= 0; bit-- {
+			set := idx&(1<<uint(bit)) != 0
+			if isLng {
+				mid := (minLng + maxLng) / 2
+				if set {
+					minLng = mid
+				} else {
+					maxLng = mid
+				}
+			} else {
+				mid := (minLat + maxLat) / 2
+				if set {
+					minLat = mid
+				} else {
+					maxLat = mid
+				}
+			}
+			isLng = !isLng
+		}
+	}
+
+	return BoundingBox{
+		SouthWest: Point{Latitude: minLat, Longitude: minLng},
+		NorthEast: Point{Latitude: maxLat, Longitude: maxLng},
+	}, nil
+}
@@ -0,0 +1,45 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDecodeGeohash(t *testing.T) {
+	tests := []struct {
+		hash string
+		// point is inside the cell of the geohash
+		point Point
+		// latSpan and lngSpan are the cell size in degrees
+		latSpan, lngSpan float64
+	}{
+		{"u", Point{Latitude: 57.64911, Longitude: 10.40744}, 45, 45},
+		{"u4pru", Point{Latitude: 57.64911, Longitude: 10.40744}, 180 / math.Pow(2, 12), 360 / math.Pow(2, 13)},
+		{"u4pruydqqvj", Point{Latitude: 57.64911, Longitude: 10.40744}, 180 / math.Pow(2, 27), 360 / math.Pow(2, 28)},
+		{"DR5RU", Point{Latitude: 40.7580, Longitude: -73.9855}, 180 / math.Pow(2, 12), 360 / math.Pow(2, 13)},
+	}
+
+	for _, test := range tests {
+		cell, err := DecodeGeohash(test.hash)
+		if err != nil {
+			t.Errorf("DecodeGeohash(%s) failed: %v", test.hash, err)
+			continue
+		}
+		if !cell.Contains(test.point) {
+			t.Errorf("DecodeGeohash(%s) = %v, want cell containing %v", test.hash, cell, test.point)
+		}
+		latSpan := cell.NorthEast.Latitude - cell.SouthWest.Latitude
+		lngSpan := cell.NorthEast.Longitude - cell.SouthWest.Longitude
+		if math.Abs(latSpan-test.latSpan) > 1e-9 || math.Abs(lngSpan-test.lngSpan) > 1e-9 {
+			t.Errorf("DecodeGeohash(%s) cell is %g x %g degrees, want %g x %g", test.hash, latSpan, lngSpan, test.latSpan, test.lngSpan)
+		}
+	}
+}
+
+func TestDecodeGeohashInvalid(t *testing.T) {
+	for _, hash := range []string{"", "u4pruydqqvjh0", "u4pra", "u4 ru"} {
+		if cell, err := DecodeGeohash(hash); err == nil {
+			t.Errorf("DecodeGeohash(%q) = %v, want error", hash, cell)
+		}
+	}
+}
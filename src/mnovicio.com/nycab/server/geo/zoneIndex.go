@@ -0,0 +1,67 @@
+package geo
+
+import (
+	"math"
+)
+
+// ZoneIndex finds the zone containing a point, using a grid of cells to only test the zones overlapping the cell of the point
+type ZoneIndex struct {
+	zones    []Zone
+	byName   map[string]int
+	cellSize float64
+	// cells holds the indexes of the zones whose bounds overlap each cell
+	cells map[[2]int][]int
+}
+
+// NewZoneIndex indexes the zones
+// cellSize: cell size in degrees, smaller cells test less zones per lookup but take more memory
+func NewZoneIndex(zones []Zone, cellSize float64) *ZoneIndex {
+	index := &ZoneIndex{
+		zones:    zones,
+		byName:   make(map[string]int, len(zones)),
+		cellSize: cellSize,
+		cells:    make(map[[2]int][]int),
+	}
+
+	for i, zone := range zones {
+		index.byName[zone.Name] = i
+
+		bounds := zone.Bounds()
+		sw, ne := index.cell(bounds.SouthWest), index.cell(bounds.NorthEast)
+		for row := sw[0]; row <= ne[0]; row++ {
+			for col := sw[1]; col <= ne[1]; col++ {
+				index.cells[[2]int{row, col}] = append(index.cells[[2]int{row, col}], i)
+			}
+		}
+	}
+
+	return index
+}
+
+// Zones returns the indexed zones
+func (x *ZoneIndex) Zones() []Zone {
+	return x.zones
+}
+
+// Zone returns the zone with the given name
+func (x *ZoneIndex) Zone(name string) (Zone, bool) {
+	i, found := x.byName[name]
+	if !found {
+		return Zone{}, false
+	}
+	return x.zones[i], true
+}
+
+// Lookup returns the zone containing the point, the first indexed one if zones overlap
+func (x *ZoneIndex) Lookup(p Point) (Zone, bool) {
+	for _, i := range x.cells[x.cell(p)] {
+		if x.zones[i].Contains(p) {
+			return x.zones[i], true
+		}
+	}
+	return Zone{}, false
+}
+
+func (x *ZoneIndex) cell(p Point) [2]int {
+	return [2]int{int(math.Floor(p.Latitude / x.cellSize)), int(math.Floor(p.Longitude / x.cellSize))}
+}
@@ -0,0 +1,53 @@
+package geo
+
+import (
+	"testing"
+)
+
+func TestZoneIndex(t *testing.T) {
+	zones := []Zone{
+		// spans several cells
+		{Name: "big", Polygons: []Polygon{square(40, -74, 0.5)}},
+		// overlaps big, indexed after it
+		{Name: "overlap", Polygons: []Polygon{square(40.4, -73.6, 0.2)}},
+		// a triangle whose bounds cover points outside of it
+		{Name: "triangle", Polygons: []Polygon{{
+			{Latitude: 41, Longitude: -74},
+			{Latitude: 41, Longitude: -73},
+			{Latitude: 42, Longitude: -74},
+		}}},
+	}
+
+	for _, cellSize := range []float64{0.01, 0.1, 1, 10} {
+		index := NewZoneIndex(zones, cellSize)
+
+		tests := []struct {
+			point Point
+			zone  string
+		}{
+			{Point{Latitude: 40.1, Longitude: -73.9}, "big"},
+			{Point{Latitude: 40.45, Longitude: -73.55}, "big"},
+			{Point{Latitude: 40.55, Longitude: -73.45}, "overlap"},
+			{Point{Latitude: 41.2, Longitude: -73.9}, "triangle"},
+			// inside the bounds of the triangle, outside of it
+			{Point{Latitude: 41.9, Longitude: -73.1}, ""},
+			{Point{Latitude: 39, Longitude: -74}, ""},
+		}
+		for _, test := range tests {
+			zone, found := index.Lookup(test.point)
+			if found != (test.zone != "") || zone.Name != test.zone {
+				t.Errorf("cell size %g: Lookup(%v) = [%s], %t, want [%s]", cellSize, test.point, zone.Name, found, test.zone)
+			}
+		}
+
+		if zone, found := index.Zone("overlap"); !found || zone.Name != "overlap" {
+			t.Errorf("cell size %g: Zone(overlap) = [%s], %t", cellSize, zone.Name, found)
+		}
+		if _, found := index.Zone("unknown"); found {
+			t.Errorf("cell size %g: Zone(unknown) found", cellSize)
+		}
+		if len(index.Zones()) != len(zones) {
+			t.Errorf("cell size %g: Zones() returned %d zones, want %d", cellSize, len(index.Zones()), len(zones))
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+)
+
+// Zone is a named area made of one or more polygons
+type Zone struct {
+	Name string
+	// Properties are the GeoJSON feature properties of the zone
+	Properties map[string]string
+	// Polygons are the outer rings of the zone, Holes are cut out of them
+	Polygons []Polygon
+	Holes    []Polygon
+}
+
+// Contains returns true if the point is inside one of the polygons of the zone and outside its holes
+func (z Zone) Contains(p Point) bool {
+	for _, hole := range z.Holes {
+		if hole.Contains(p) {
+			return false
+		}
+	}
+
+	for _, polygon := range z.Polygons {
+		if polygon.Contains(p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Bounds returns the smallest bounding box containing the zone
+func (z Zone) Bounds() BoundingBox {
+	vertices := Polygon{}
+	for _, polygon := range z.Polygons {
+		vertices = append(vertices, polygon...)
+	}
+	return vertices.Bounds()
+}
+
+// geoJSONFeatureCollection is the subset of a GeoJSON feature collection needed to load zones
+type geoJSONFeatureCollection struct {
+	Features []struct {
+		Properties map[string]interface{} `json:"properties"`
+		Geometry   struct {
+			Type        string          `json:"type"`
+			Coordinates json.RawMessage `json:"coordinates"`
+		} `json:"geometry"`
+	} `json:"features"`
+}
+
+// LoadZones loads the Polygon and MultiPolygon features of a GeoJSON feature collection as zones, ordered by name
+// nameProperty: feature property holding the zone name, which must be unique
+func LoadZones(path, nameProperty string) ([]Zone, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zones [%s]: %v", path, err)
+	}
+
+	var collection geoJSONFeatureCollection
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, fmt.Errorf("failed to parse zones [%s]: %v", path, err)
+	}
+
+	zones := make([]Zone, 0, len(collection.Features))
+	names := make(map[string]bool, len(collection.Features))
+	for i, feature := range collection.Features {
+		zone := Zone{
+			Properties: make(map[string]string, len(feature.Properties)),
+		}
+		for key, value := range feature.Properties {
+			if value != nil {
+				zone.Properties[key] = fmt.Sprint(value)
+			}
+		}
+
+		zone.Name = zone.Properties[nameProperty]
+		if zone.Name == "" {
+			return nil, fmt.Errorf("zone %d in [%s] has no '%s' property", i, path, nameProperty)
+		}
+		if names[zone.Name] {
+			return nil, fmt.Errorf("duplicate zone [%s] in [%s]", zone.Name, path)
+		}
+		names[zone.Name] = true
+
+		var polygons [][][][2]float64
+		switch feature.Geometry.Type {
+		case "Polygon":
+			var polygon [][][2]float64
+			err = json.Unmarshal(feature.Geometry.Coordinates, &polygon)
+			polygons = append(polygons, polygon)
+		case "MultiPolygon":
+			err = json.Unmarshal(feature.Geometry.Coordinates, &polygons)
+		default:
+			return nil, fmt.Errorf("zone [%s] in [%s] has unsupported geometry [%s], expecting Polygon or MultiPolygon", zone.Name, path, feature.Geometry.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid coordinates of zone [%s] in [%s]: %v", zone.Name, path, err)
+		}
+
+		for _, rings := range polygons {
+			for r, ring := range rings {
+				// GeoJSON positions are (longitude, latitude)
+				polygon := make(Polygon, 0, len(ring))
+				for _, position := range ring {
+					polygon = append(polygon, Point{Latitude: position[1], Longitude: position[0]})
+				}
+				if err := polygon.Validate(); err != nil {
+					return nil, fmt.Errorf("invalid polygon of zone [%s] in [%s]: %v", zone.Name, path, err)
+				}
+
+				// the first ring is the outer ring, the others are holes
+				if r == 0 {
+					zone.Polygons = append(zone.Polygons, polygon)
+				} else {
+					zone.Holes = append(zone.Holes, polygon)
+				}
+			}
+		}
+		if len(zone.Polygons) == 0 {
+			return nil, fmt.Errorf("zone [%s] in [%s] has no polygon", zone.Name, path)
+		}
+
+		zones = append(zones, zone)
+	}
+
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].Name < zones[j].Name
+	})
+
+	return zones, nil
+}
+
+// AirportZones returns approximate outlines of the JFK and LaGuardia airports, including their terminals and taxi holding lots
+func AirportZones() []Zone {
+	return []Zone{
+		{
+			Name:       "JFK",
+			Properties: map[string]string{"name": "JFK", "description": "John F. Kennedy International Airport"},
+			Polygons: []Polygon{{
+				{Latitude: 40.6655, Longitude: -73.8220},
+				{Latitude: 40.6660, Longitude: -73.7890},
+				{Latitude: 40.6570, Longitude: -73.7460},
+				{Latitude: 40.6280, Longitude: -73.7470},
+				{Latitude: 40.6190, Longitude: -73.7700},
+				{Latitude: 40.6230, Longitude: -73.8050},
+				{Latitude: 40.6430, Longitude: -73.8240},
+			}},
+		},
+		{
+			Name:       "LGA",
+			Properties: map[string]string{"name": "LGA", "description": "LaGuardia Airport"},
+			Polygons: []Polygon{{
+				{Latitude: 40.7860, Longitude: -73.8900},
+				{Latitude: 40.7860, Longitude: -73.8560},
+				{Latitude: 40.7720, Longitude: -73.8540},
+				{Latitude: 40.7655, Longitude: -73.8620},
+				{Latitude: 40.7665, Longitude: -73.8800},
+				{Latitude: 40.7740, Longitude: -73.8900},
+			}},
+		},
+	}
+}
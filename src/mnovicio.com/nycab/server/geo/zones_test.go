@@ -0,0 +1,183 @@
+package geo
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// square returns a square polygon with its south west corner at (lat, lng)
+func square(lat, lng, size float64) Polygon {
+	return Polygon{
+		{Latitude: lat, Longitude: lng},
+		{Latitude: lat, Longitude: lng + size},
+		{Latitude: lat + size, Longitude: lng + size},
+		{Latitude: lat + size, Longitude: lng},
+	}
+}
+
+func TestZoneContains(t *testing.T) {
+	// two islands, the first one with a lake
+	zone := Zone{
+		Name:     "islands",
+		Polygons: []Polygon{square(40, -74, 1), square(42, -74, 1)},
+		Holes:    []Polygon{square(40.25, -73.75, 0.5)},
+	}
+
+	tests := []struct {
+		name     string
+		point    Point
+		contains bool
+	}{
+		{"first island", Point{Latitude: 40.1, Longitude: -73.9}, true},
+		{"second island", Point{Latitude: 42.5, Longitude: -73.5}, true},
+		{"lake", Point{Latitude: 40.5, Longitude: -73.5}, false},
+		{"between islands", Point{Latitude: 41.5, Longitude: -73.5}, false},
+	}
+
+	for _, test := range tests {
+		if contains := zone.Contains(test.point); contains != test.contains {
+			t.Errorf("%s: Contains(%v) = %t, want %t", test.name, test.point, contains, test.contains)
+		}
+	}
+
+	want := BoundingBox{SouthWest: Point{Latitude: 40, Longitude: -74}, NorthEast: Point{Latitude: 43, Longitude: -73}}
+	if bounds := zone.Bounds(); bounds != want {
+		t.Errorf("Bounds() = %v, want %v", bounds, want)
+	}
+}
+
+func TestLoadZones(t *testing.T) {
+	tests := []struct {
+		name    string
+		geoJSON string
+		zones   []string
+		valid   bool
+	}{
+		{
+			name: "polygon and multipolygon",
+			geoJSON: `{"type": "FeatureCollection", "features": [
+				{"properties": {"zone": "b", "borough": "Queens", "id": 2}, "geometry": {"type": "MultiPolygon", "coordinates": [
+					[[[-74, 40], [-73, 40], [-73, 41], [-74, 41]]],
+					[[[-74, 42], [-73, 42], [-73, 43], [-74, 43]], [[-73.8, 42.2], [-73.2, 42.2], [-73.2, 42.8], [-73.8, 42.8]]]
+				]}},
+				{"properties": {"zone": "a"}, "geometry": {"type": "Polygon", "coordinates": [[[-75, 40], [-74, 40], [-74, 41]]]}}
+			]}`,
+			zones: []string{"a", "b"},
+			valid: true,
+		},
+		{
+			name:    "missing name",
+			geoJSON: `{"features": [{"properties": {"borough": "Queens"}, "geometry": {"type": "Polygon", "coordinates": [[[-75, 40], [-74, 40], [-74, 41]]]}}]}`,
+		},
+		{
+			name: "duplicate name",
+			geoJSON: `{"features": [
+				{"properties": {"zone": "a"}, "geometry": {"type": "Polygon", "coordinates": [[[-75, 40], [-74, 40], [-74, 41]]]}},
+				{"properties": {"zone": "a"}, "geometry": {"type": "Polygon", "coordinates": [[[-75, 40], [-74, 40], [-74, 41]]]}}
+			]}`,
+		},
+		{
+			name:    "unsupported geometry",
+			geoJSON: `{"features": [{"properties": {"zone": "a"}, "geometry": {"type": "Point", "coordinates": [-74, 40]}}]}`,
+		},
+		{
+			name:    "invalid polygon",
+			geoJSON: `{"features": [{"properties": {"zone": "a"}, "geometry": {"type": "Polygon", "coordinates": [[[-75, 40], [-74, 40]]]}}]}`,
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "zones")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for i, test := range tests {
+		path := filepath.Join(dir, string(rune('a'+i))+".geojson")
+		if err := ioutil.WriteFile(path, []byte(test.geoJSON), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		zones, err := LoadZones(path, "zone")
+		if (err == nil) != test.valid {
+			t.Errorf("%s: LoadZones() = %v, want valid %t", test.name, err, test.valid)
+			continue
+		}
+		if len(zones) != len(test.zones) {
+			t.Errorf("%s: LoadZones() returned %d zones, want %d", test.name, len(zones), len(test.zones))
+			continue
+		}
+		for j, zone := range zones {
+			if zone.Name != test.zones[j] {
+				t.Errorf("%s: zone %d is [%s], want [%s]", test.name, j, zone.Name, test.zones[j])
+			}
+		}
+	}
+}
+
+func TestLoadZonesGeometry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "zones")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "zones.geojson")
+	geoJSON := `{"features": [{"properties": {"zone": "b", "id": 2, "note": null}, "geometry": {"type": "MultiPolygon", "coordinates": [
+		[[[-74, 40], [-73, 40], [-73, 41], [-74, 41]]],
+		[[[-74, 42], [-73, 42], [-73, 43], [-74, 43]], [[-73.8, 42.2], [-73.2, 42.2], [-73.2, 42.8], [-73.8, 42.8]]]
+	]}}]}`
+	if err := ioutil.WriteFile(path, []byte(geoJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	zones, err := LoadZones(path, "zone")
+	if err != nil {
+		t.Fatalf("LoadZones() = %v", err)
+	}
+	zone := zones[0]
+	if len(zone.Polygons) != 2 || len(zone.Holes) != 1 {
+		t.Errorf("zone has %d polygons and %d holes, want 2 and 1", len(zone.Polygons), len(zone.Holes))
+	}
+	// GeoJSON positions are (longitude, latitude)
+	if first := zone.Polygons[0][0]; first != (Point{Latitude: 40, Longitude: -74}) {
+		t.Errorf("first vertex = %v, want (40, -74)", first)
+	}
+	if id, found := zone.Properties["id"]; !found || id != "2" {
+		t.Errorf("property id = %q, want 2", id)
+	}
+	if _, found := zone.Properties["note"]; found {
+		t.Error("null property note is loaded")
+	}
+}
+
+func TestAirportZones(t *testing.T) {
+	tests := []struct {
+		point Point
+		zone  string
+	}{
+		// JFK terminal 4
+		{Point{Latitude: 40.6441, Longitude: -73.7823}, "JFK"},
+		// LaGuardia terminal B
+		{Point{Latitude: 40.7741, Longitude: -73.8719}, "LGA"},
+		// Times Square
+		{Point{Latitude: 40.7580, Longitude: -73.9855}, ""},
+	}
+
+	for _, test := range tests {
+		found := ""
+		for _, zone := range AirportZones() {
+			if err := zone.Polygons[0].Validate(); err != nil {
+				t.Errorf("zone [%s] polygon is invalid: %v", zone.Name, err)
+			}
+			if zone.Contains(test.point) {
+				found = zone.Name
+			}
+		}
+		if found != test.zone {
+			t.Errorf("%v is in zone [%s], want [%s]", test.point, found, test.zone)
+		}
+	}
+}
@@ -14,7 +14,7 @@ import (
 )
 
 // RunServer runs gRPC service to publish NYCab service
-func RunServer(ctx context.Context, nyCabService pbsvc.NYCabServiceServer, port string) error {
+func RunServer(ctx context.Context, nyCabService pbsvc.NYCabServiceServer, nyCabServiceV2 pbsvc.NYCabServiceV2Server, port string) error {
 	listen, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
@@ -23,6 +23,7 @@ func RunServer(ctx context.Context, nyCabService pbsvc.NYCabServiceServer, port
 	// register service
 	server := grpc.NewServer()
 	pbsvc.RegisterNYCabServiceServer(server, nyCabService)
+	pbsvc.RegisterNYCabServiceV2Server(server, nyCabServiceV2)
 
 	// graceful shutdown
 	c := make(chan os.Signal, 1)
@@ -0,0 +1,111 @@
+package holidays
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Calendar is a set of holiday dates
+type Calendar struct {
+	sync.RWMutex
+	// names of the holidays keyed by date in 'YYYY-MM-DD' format
+	dates map[string]string
+	// generate returns the holidays of a year not loaded yet, nil for file based calendars
+	generate func(year int) map[string]string
+	// years already generated
+	years map[int]bool
+}
+
+// NewNYCCalendar returns a calendar of New York City public holidays, generated on demand for any year
+func NewNYCCalendar() *Calendar {
+	return &Calendar{
+		dates:    make(map[string]string),
+		generate: nycPublicHolidays,
+		years:    make(map[int]bool),
+	}
+}
+
+// LoadCalendar loads a calendar from a file with one 'YYYY-MM-DD,name' holiday per line
+// empty lines and lines starting with '#' are ignored
+func LoadCalendar(path string) (*Calendar, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open holiday calendar [%s]: %v", path, err)
+	}
+	defer f.Close()
+
+	calendar := &Calendar{
+		dates: make(map[string]string),
+	}
+
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.SplitN(text, ",", 2)
+		date := strings.TrimSpace(fields[0])
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return nil, fmt.Errorf("invalid date [%s] in holiday calendar [%s] line %d, expecting 'YYYY-MM-DD'", date, path, line)
+		}
+
+		name := "holiday"
+		if len(fields) > 1 && strings.TrimSpace(fields[1]) != "" {
+			name = strings.TrimSpace(fields[1])
+		}
+		calendar.dates[date] = name
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read holiday calendar [%s]: %v", path, err)
+	}
+
+	return calendar, nil
+}
+
+// IsHoliday returns true if the date in 'YYYY-MM-DD' format is a holiday
+func (c *Calendar) IsHoliday(date string) bool {
+	_, found := c.Name(date)
+	return found
+}
+
+// Name returns the name of the holiday on the date in 'YYYY-MM-DD' format
+func (c *Calendar) Name(date string) (string, bool) {
+	if c.generate != nil {
+		if t, err := time.Parse("2006-01-02", date); err == nil {
+			c.ensureYear(t.Year())
+		}
+	}
+
+	c.RLock()
+	defer c.RUnlock()
+
+	name, found := c.dates[date]
+	return name, found
+}
+
+func (c *Calendar) ensureYear(year int) {
+	c.RLock()
+	generated := c.years[year]
+	c.RUnlock()
+	if generated {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	if c.years[year] {
+		return
+	}
+	for date, name := range c.generate(year) {
+		c.dates[date] = name
+	}
+	c.years[year] = true
+}
@@ -0,0 +1,67 @@
+package holidays
+
+import (
+	"time"
+)
+
+// nycPublicHolidays returns the New York City public holidays of the year keyed by date in 'YYYY-MM-DD' format
+// fixed date holidays falling on a weekend are also observed on the closest weekday
+// a New Year's Day falling on a saturday is observed on December 31 of the previous year, so it belongs to that year
+func nycPublicHolidays(year int) map[string]string {
+	dates := make(map[string]string)
+
+	addObserved := func(date time.Time, name string) {
+		if observed := observedDate(date); !observed.Equal(date) && observed.Year() == year {
+			dates[observed.Format("2006-01-02")] = name + " (observed)"
+		}
+	}
+	addFixed := func(month time.Month, day int, name string) {
+		date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		dates[date.Format("2006-01-02")] = name
+		addObserved(date, name)
+	}
+	addNth := func(month time.Month, weekday time.Weekday, n int, name string) {
+		dates[nthWeekday(year, month, weekday, n).Format("2006-01-02")] = name
+	}
+
+	addFixed(time.January, 1, "New Year's Day")
+	addNth(time.January, time.Monday, 3, "Martin Luther King Jr. Day")
+	addFixed(time.February, 12, "Lincoln's Birthday")
+	addNth(time.February, time.Monday, 3, "Washington's Birthday")
+	addNth(time.May, time.Monday, -1, "Memorial Day")
+	addFixed(time.July, 4, "Independence Day")
+	addNth(time.September, time.Monday, 1, "Labor Day")
+	addNth(time.October, time.Monday, 2, "Columbus Day")
+	// general election day, the tuesday after the first monday of november
+	dates[nthWeekday(year, time.November, time.Monday, 1).AddDate(0, 0, 1).Format("2006-01-02")] = "Election Day"
+	addFixed(time.November, 11, "Veterans Day")
+	addNth(time.November, time.Thursday, 4, "Thanksgiving Day")
+	addFixed(time.December, 25, "Christmas Day")
+	addObserved(time.Date(year+1, time.January, 1, 0, 0, 0, 0, time.UTC), "New Year's Day")
+
+	return dates
+}
+
+// observedDate returns the weekday a holiday falling on a weekend is observed on, the holiday date otherwise
+func observedDate(date time.Time) time.Time {
+	switch date.Weekday() {
+	case time.Saturday:
+		return date.AddDate(0, 0, -1)
+	case time.Sunday:
+		return date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// nthWeekday returns the nth weekday of the month, counting from the end of the month if n is negative
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int) time.Time {
+	if n < 0 {
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+		offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+		return last.AddDate(0, 0, -offset-7*(-n-1))
+	}
+
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+7*(n-1))
+}
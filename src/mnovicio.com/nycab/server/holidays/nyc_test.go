@@ -0,0 +1,102 @@
+package holidays
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNYCCalendar(t *testing.T) {
+	tests := []struct {
+		date    string
+		name    string
+		holiday bool
+	}{
+		{"2013-01-01", "New Year's Day", true},
+		{"2013-01-21", "Martin Luther King Jr. Day", true},
+		{"2013-02-12", "Lincoln's Birthday", true},
+		{"2013-05-27", "Memorial Day", true},
+		{"2013-11-05", "Election Day", true},
+		{"2013-11-28", "Thanksgiving Day", true},
+		{"2013-12-25", "Christmas Day", true},
+		{"2013-12-26", "", false},
+		// July 4 2015 is a saturday, observed on friday
+		{"2015-07-03", "Independence Day (observed)", true},
+		// Christmas 2016 is a sunday, observed on monday
+		{"2016-12-26", "Christmas Day (observed)", true},
+		// New Year's Day 2022 is a saturday, observed on friday in the previous year
+		{"2021-12-31", "New Year's Day (observed)", true},
+		{"2022-01-01", "New Year's Day", true},
+		{"2021-12-30", "", false},
+	}
+
+	calendar := NewNYCCalendar()
+	for _, test := range tests {
+		name, holiday := calendar.Name(test.date)
+		if holiday != test.holiday || name != test.name {
+			t.Errorf("Name(%s) = %q, %t, want %q, %t", test.date, name, holiday, test.name, test.holiday)
+		}
+	}
+}
+
+func TestNthWeekday(t *testing.T) {
+	tests := []struct {
+		month   int
+		weekday int
+		n       int
+		want    string
+	}{
+		// first monday of september 2013
+		{9, 1, 1, "2013-09-02"},
+		// fourth thursday of november 2013
+		{11, 4, 4, "2013-11-28"},
+		// last monday of may 2013
+		{5, 1, -1, "2013-05-27"},
+		// last friday of may 2013, the last day of the month
+		{5, 5, -1, "2013-05-31"},
+	}
+
+	for _, test := range tests {
+		date := nthWeekday(2013, time.Month(test.month), time.Weekday(test.weekday), test.n).Format("2006-01-02")
+		if date != test.want {
+			t.Errorf("nthWeekday(2013, %d, %d, %d) = %s, want %s", test.month, test.weekday, test.n, date, test.want)
+		}
+	}
+}
+
+func TestLoadCalendar(t *testing.T) {
+	dir, err := ioutil.TempDir("", "holidays")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "calendar.csv")
+	content := "# company holidays\n\n2013-12-24,Christmas Eve\n2013-12-31\n"
+	if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	calendar, err := LoadCalendar(path)
+	if err != nil {
+		t.Fatalf("LoadCalendar() = %v", err)
+	}
+	if name, _ := calendar.Name("2013-12-24"); name != "Christmas Eve" {
+		t.Errorf("Name(2013-12-24) = %q, want Christmas Eve", name)
+	}
+	if name, _ := calendar.Name("2013-12-31"); name != "holiday" {
+		t.Errorf("Name(2013-12-31) = %q, want holiday", name)
+	}
+	if calendar.IsHoliday("2013-12-25") {
+		t.Error("IsHoliday(2013-12-25) = true for a date not in the file")
+	}
+
+	if err := ioutil.WriteFile(path, []byte("12/24/2013,Christmas Eve\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadCalendar(path); err == nil {
+		t.Error("LoadCalendar() of an invalid date = nil, want error")
+	}
+}
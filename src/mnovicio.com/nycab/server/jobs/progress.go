@@ -0,0 +1,21 @@
+package jobs
+
+import (
+	"context"
+)
+
+type progressKey struct{}
+
+func withProgress(ctx context.Context, report func(progress float64)) context.Context {
+	return context.WithValue(ctx, progressKey{}, report)
+}
+
+// ReportProgress reports that done out of total steps of the query of a job are completed
+// does nothing if ctx is not the context of a job
+func ReportProgress(ctx context.Context, done, total int) {
+	report, ok := ctx.Value(progressKey{}).(func(progress float64))
+	if !ok || total <= 0 {
+		return
+	}
+	report(float64(done) / float64(total))
+}
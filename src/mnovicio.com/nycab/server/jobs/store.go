@@ -0,0 +1,244 @@
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// State is the state of a job
+type State int
+
+// Job states, a job ends in Succeeded, Failed or Cancelled
+const (
+	Pending State = iota
+	Running
+	Succeeded
+	Failed
+	Cancelled
+)
+
+// ErrStoreFull is returned when submitting a job to a store holding its maximum number of unfinished jobs
+var ErrStoreFull = errors.New("too many unfinished jobs, try again later")
+
+// RunFunc runs the query of a job, ctx is cancelled when the job is cancelled
+// the query may report its progress with ReportProgress(ctx, ...)
+type RunFunc func(ctx context.Context) (interface{}, error)
+
+// Job is a snapshot of a job
+type Job struct {
+	ID   string
+	Name string
+	// Progress goes from 0 to 1
+	Progress   float64
+	State      State
+	SubmitTime time.Time
+	// StartTime is zero until the job runs
+	StartTime time.Time
+	// EndTime is zero until the job ends
+	EndTime time.Time
+	// Err is set for failed jobs
+	Err error
+	// Result is set for succeeded jobs
+	Result interface{}
+}
+
+// Done returns true if the job has ended
+func (j Job) Done() bool {
+	return j.State == Succeeded || j.State == Failed || j.State == Cancelled
+}
+
+// Store runs jobs in the background and keeps up to a maximum number of them in memory
+// the oldest ended jobs are evicted to make room for new ones
+type Store struct {
+	sync.Mutex
+	jobs map[string]*job
+	// ids of the jobs in submission order
+	ids     []string
+	maxJobs int
+	// slots bounds the number of jobs running at once
+	slots chan struct{}
+	// timeout bounds the time a job runs, the time it waits for a slot excluded
+	timeout time.Duration
+}
+
+type job struct {
+	Job
+	cancel context.CancelFunc
+}
+
+// NewStore returns a store keeping up to maxJobs jobs, running up to maxRunning of them at once for up to timeout each
+func NewStore(maxJobs, maxRunning int, timeout time.Duration) *Store {
+	return &Store{
+		jobs:    make(map[string]*job),
+		maxJobs: maxJobs,
+		slots:   make(chan struct{}, maxRunning),
+		timeout: timeout,
+	}
+}
+
+// Submit queues a job running run, returns ErrStoreFull if no ended job can be evicted to make room for it
+func (s *Store) Submit(name string, run RunFunc) (Job, error) {
+	id, err := newJobID()
+	if err != nil {
+		return Job{}, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	j := &job{
+		Job: Job{
+			ID:         id,
+			Name:       name,
+			State:      Pending,
+			SubmitTime: time.Now(),
+		},
+		cancel: cancel,
+	}
+
+	s.Lock()
+	if len(s.ids) >= s.maxJobs && !s.evict() {
+		s.Unlock()
+		cancel()
+		return Job{}, ErrStoreFull
+	}
+	s.jobs[id] = j
+	s.ids = append(s.ids, id)
+	snapshot := j.Job
+	s.Unlock()
+
+	go s.run(ctx, j, run)
+
+	return snapshot, nil
+}
+
+// Get returns a snapshot of the job, false if the job is unknown or was evicted
+func (s *Store) Get(id string) (Job, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	j, found := s.jobs[id]
+	if !found {
+		return Job{}, false
+	}
+	return j.Job, true
+}
+
+// Cancel cancels a pending or running job, ended jobs are left as is
+// returns a snapshot of the job, false if the job is unknown or was evicted
+func (s *Store) Cancel(id string) (Job, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	j, found := s.jobs[id]
+	if !found {
+		return Job{}, false
+	}
+
+	if !j.Done() {
+		j.cancel()
+		j.end(nil, context.Canceled)
+	}
+	return j.Job, true
+}
+
+// evict removes the oldest ended job, returns false if all jobs are still pending or running
+func (s *Store) evict() bool {
+	for i, id := range s.ids {
+		if s.jobs[id].Done() {
+			delete(s.jobs, id)
+			s.ids = append(s.ids[:i], s.ids[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) run(ctx context.Context, j *job, run RunFunc) {
+	defer j.cancel()
+
+	// wait for a free slot
+	select {
+	case s.slots <- struct{}{}:
+		defer func() { <-s.slots }()
+	case <-ctx.Done():
+		return
+	}
+
+	s.Lock()
+	if j.Done() {
+		s.Unlock()
+		return
+	}
+	j.State = Running
+	j.StartTime = time.Now()
+	s.Unlock()
+
+	result, err := s.runWithTimeout(withProgress(ctx, func(progress float64) {
+		s.Lock()
+		defer s.Unlock()
+		if !j.Done() {
+			j.Progress = progress
+		}
+	}), run)
+
+	s.Lock()
+	defer s.Unlock()
+	if !j.Done() {
+		j.end(result, err)
+	}
+}
+
+// Run runs a query in the calling goroutine once a slot is free, sharing the slots and the timeout of the jobs without being a job
+// returns the context error if ctx is done before a slot is free
+func (s *Store) Run(ctx context.Context, run RunFunc) (interface{}, error) {
+	select {
+	case s.slots <- struct{}{}:
+		defer func() { <-s.slots }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return s.runWithTimeout(ctx, run)
+}
+
+// runWithTimeout runs a query, aborting it once it runs for longer than the timeout of the store
+func (s *Store) runWithTimeout(ctx context.Context, run RunFunc) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	result, err := run(ctx)
+	if err != nil && ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("query timed out after %s", s.timeout)
+	}
+	return result, err
+}
+
+// end ends the job with the result of its query, must be called with the store locked
+func (j *job) end(result interface{}, err error) {
+	j.EndTime = time.Now()
+	switch {
+	case err == context.Canceled:
+		j.State = Cancelled
+		j.Err = err
+	case err != nil:
+		j.State = Failed
+		j.Err = err
+	default:
+		j.State = Succeeded
+		j.Progress = 1
+		j.Result = result
+	}
+}
+
+// newJobID returns a random job ID
+func newJobID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate job ID: %v", err)
+	}
+	return hex.EncodeToString(id), nil
+}
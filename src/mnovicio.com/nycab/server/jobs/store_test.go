@@ -0,0 +1,234 @@
+package jobs
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// waitDone polls the store until the job has ended
+func waitDone(t *testing.T, s *Store, id string) Job {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, found := s.Get(id)
+		if !found {
+			t.Fatalf("job [%s] not found", id)
+		}
+		if job.Done() {
+			return job
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("job [%s] not done", id)
+	return Job{}
+}
+
+// blockingRun returns a query running until ctx is done or release is closed
+func blockingRun(release chan struct{}) RunFunc {
+	return func(ctx context.Context) (interface{}, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-release:
+			return "released", nil
+		}
+	}
+}
+
+func TestStoreRunsJobs(t *testing.T) {
+	s := NewStore(10, 2, time.Minute)
+
+	tests := []struct {
+		name      string
+		run       RunFunc
+		wantState State
+	}{
+		{"succeeded", func(ctx context.Context) (interface{}, error) { return 42, nil }, Succeeded},
+		{"failed", func(ctx context.Context) (interface{}, error) { return nil, context.DeadlineExceeded }, Failed},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			job, err := s.Submit(test.name, test.run)
+			if err != nil {
+				t.Fatalf("Submit() = %v", err)
+			}
+			if job.State != Pending {
+				t.Errorf("submitted state = %d, want %d", job.State, Pending)
+			}
+
+			job = waitDone(t, s, job.ID)
+			if job.State != test.wantState {
+				t.Errorf("state = %d, want %d", job.State, test.wantState)
+			}
+			if job.State == Succeeded && (job.Result != 42 || job.Progress != 1) {
+				t.Errorf("result, progress = %v, %g, want 42, 1", job.Result, job.Progress)
+			}
+		})
+	}
+}
+
+func TestStoreEvictsOldestEndedJobs(t *testing.T) {
+	s := NewStore(2, 2, time.Minute)
+	release := make(chan struct{})
+	defer close(release)
+
+	ended, err := s.Submit("ended", func(ctx context.Context) (interface{}, error) { return nil, nil })
+	if err != nil {
+		t.Fatalf("Submit(ended) = %v", err)
+	}
+	waitDone(t, s, ended.ID)
+
+	running, err := s.Submit("running", blockingRun(release))
+	if err != nil {
+		t.Fatalf("Submit(running) = %v", err)
+	}
+
+	// the store is full, the ended job makes room for the new one
+	newer, err := s.Submit("newer", blockingRun(release))
+	if err != nil {
+		t.Fatalf("Submit(newer) = %v", err)
+	}
+	if _, found := s.Get(ended.ID); found {
+		t.Error("ended job still stored, want evicted")
+	}
+	for _, id := range []string{running.ID, newer.ID} {
+		if _, found := s.Get(id); !found {
+			t.Errorf("job [%s] evicted, want stored", id)
+		}
+	}
+
+	// unfinished jobs are never evicted
+	if _, err := s.Submit("rejected", blockingRun(release)); err != ErrStoreFull {
+		t.Errorf("Submit(rejected) = %v, want %v", err, ErrStoreFull)
+	}
+}
+
+func TestStoreCancel(t *testing.T) {
+	s := NewStore(10, 1, time.Minute)
+	release := make(chan struct{})
+	defer close(release)
+
+	started := make(chan struct{})
+	cancelled := make(chan error, 1)
+	running, err := s.Submit("running", func(ctx context.Context) (interface{}, error) {
+		close(started)
+		<-ctx.Done()
+		cancelled <- ctx.Err()
+		return nil, ctx.Err()
+	})
+	if err != nil {
+		t.Fatalf("Submit(running) = %v", err)
+	}
+	<-started
+
+	// the only slot is taken, the second job waits
+	pending, err := s.Submit("pending", blockingRun(release))
+	if err != nil {
+		t.Fatalf("Submit(pending) = %v", err)
+	}
+
+	for _, id := range []string{pending.ID, running.ID} {
+		job, found := s.Cancel(id)
+		if !found {
+			t.Fatalf("Cancel(%s) not found", id)
+		}
+		if job.State != Cancelled || job.Err != context.Canceled {
+			t.Errorf("cancelled job = %d, %v, want %d, %v", job.State, job.Err, Cancelled, context.Canceled)
+		}
+	}
+
+	// the query of the running job is aborted
+	select {
+	case err := <-cancelled:
+		if err != context.Canceled {
+			t.Errorf("query context error = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("query of the cancelled job still running")
+	}
+
+	// ended jobs are left as is
+	done, err := s.Submit("done", func(ctx context.Context) (interface{}, error) { return 1, nil })
+	if err != nil {
+		t.Fatalf("Submit(done) = %v", err)
+	}
+	waitDone(t, s, done.ID)
+	if job, _ := s.Cancel(done.ID); job.State != Succeeded {
+		t.Errorf("state after cancelling an ended job = %d, want %d", job.State, Succeeded)
+	}
+
+	if _, found := s.Cancel("unknown"); found {
+		t.Error("Cancel(unknown) found, want not found")
+	}
+}
+
+func TestStoreTimeout(t *testing.T) {
+	s := NewStore(10, 1, 10*time.Millisecond)
+
+	job, err := s.Submit("slow", blockingRun(nil))
+	if err != nil {
+		t.Fatalf("Submit() = %v", err)
+	}
+
+	job = waitDone(t, s, job.ID)
+	if job.State != Failed || job.Err == nil || !strings.Contains(job.Err.Error(), "timed out") {
+		t.Errorf("job = %d, %v, want %d, timed out", job.State, job.Err, Failed)
+	}
+}
+
+func TestReportProgress(t *testing.T) {
+	s := NewStore(10, 1, time.Minute)
+	reported := make(chan struct{})
+	release := make(chan struct{})
+
+	job, err := s.Submit("progress", func(ctx context.Context) (interface{}, error) {
+		ReportProgress(ctx, 1, 4)
+		close(reported)
+		<-release
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("Submit() = %v", err)
+	}
+
+	<-reported
+	if job, _ := s.Get(job.ID); job.Progress != 0.25 || job.State != Running {
+		t.Errorf("job = %d, %g, want %d, 0.25", job.State, job.Progress, Running)
+	}
+	close(release)
+
+	// outside of a job, progress is ignored
+	ReportProgress(context.Background(), 1, 2)
+}
+
+func TestStoreRunSharesSlots(t *testing.T) {
+	s := NewStore(10, 1, time.Minute)
+	release := make(chan struct{})
+
+	started := make(chan struct{})
+	job, err := s.Submit("running", func(ctx context.Context) (interface{}, error) {
+		close(started)
+		<-release
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("Submit() = %v", err)
+	}
+	<-started
+
+	// the only slot is taken by the job
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := s.Run(ctx, blockingRun(nil)); err != context.DeadlineExceeded {
+		t.Errorf("Run() while the slot is taken = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(release)
+	waitDone(t, s, job.ID)
+	result, err := s.Run(context.Background(), func(ctx context.Context) (interface{}, error) { return 1, nil })
+	if err != nil || result != 1 {
+		t.Errorf("Run() = %v, %v, want 1, nil", result, err)
+	}
+}
@@ -11,24 +11,38 @@ import (
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	pbsvc "mnovicio.com/nycab/protocol/rpc"
+	svc "mnovicio.com/nycab/server/service"
 )
 
+// fieldsMetadata forwards the 'fields' query parameter to the service, V2 RPCs use it as field mask
+func fieldsMetadata(ctx context.Context, req *http.Request) metadata.MD {
+	fields := req.URL.Query().Get("fields")
+	if fields == "" {
+		return nil
+	}
+	return metadata.Pairs(svc.FieldMaskMetadataKey, fields)
+}
+
 // RunServer runs HTTP/REST gateway
 func RunServer(ctx context.Context, grpcPort, httpPort string) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithMetadata(fieldsMetadata))
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 	if err := pbsvc.RegisterNYCabServiceHandlerFromEndpoint(ctx, mux, "localhost:"+grpcPort, opts); err != nil {
 		log.Fatalf("failed to start HTTP gateway: %v", err)
 	}
+	if err := pbsvc.RegisterNYCabServiceV2HandlerFromEndpoint(ctx, mux, "localhost:"+grpcPort, opts); err != nil {
+		log.Fatalf("failed to start HTTP gateway: %v", err)
+	}
 
 	srv := &http.Server{
 		Addr:    ":" + httpPort,
-		Handler: mux,
+		Handler: serverSentEvents(mux),
 	}
 
 	// graceful shutdown
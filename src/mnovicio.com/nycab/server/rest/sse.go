@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+)
+
+// eventStream is the content type of Server-Sent Events
+const eventStream = "text/event-stream"
+
+// serverSentEvents serves the messages of server streaming endpoints as Server-Sent Events to clients accepting them, e.g. browser EventSources
+// each JSON object streamed by the gateway, {"result": ...} or {"error": ...}, is sent as the data of an event
+// other clients get the newline delimited JSON objects of the gateway
+func serverSentEvents(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !strings.Contains(req.Header.Get("Accept"), eventStream) || !ok {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		events := &eventWriter{
+			ResponseWriter: w,
+			flusher:        flusher,
+		}
+		next.ServeHTTP(events, req)
+		events.close()
+	})
+}
+
+// eventWriter writes each line written to it as the data of an event
+type eventWriter struct {
+	http.ResponseWriter
+	flusher     http.Flusher
+	wroteHeader bool
+	// line holds the data written since the last complete line
+	line []byte
+}
+
+func (w *eventWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.Header().Set("Content-Type", eventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *eventWriter) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+
+	w.line = append(w.line, data...)
+	for {
+		end := bytes.IndexByte(w.line, '\n')
+		if end < 0 {
+			return len(data), nil
+		}
+		if err := w.event(w.line[:end]); err != nil {
+			return 0, err
+		}
+		w.line = w.line[end+1:]
+	}
+}
+
+// Flush sends the events written so far
+func (w *eventWriter) Flush() {
+	w.flusher.Flush()
+}
+
+// close sends the last line as an event if it is not terminated by a newline, e.g. the response of a unary endpoint
+func (w *eventWriter) close() {
+	if len(w.line) > 0 {
+		w.event(w.line)
+		w.line = nil
+	}
+	w.Flush()
+}
+
+func (w *eventWriter) event(data []byte) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(append(append([]byte("data: "), data...), '\n', '\n'))
+	return err
+}
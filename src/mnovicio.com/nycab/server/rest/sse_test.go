@@ -0,0 +1,101 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServerSentEvents(t *testing.T) {
+	tests := []struct {
+		name string
+		// writes are the writes of the gateway handler
+		writes          []string
+		accept          string
+		wantBody        string
+		wantContentType string
+	}{
+		{
+			name:            "streamed objects",
+			writes:          []string{`{"result": 1}` + "\n", `{"result": 2}` + "\n"},
+			accept:          eventStream,
+			wantBody:        "data: {\"result\": 1}\n\ndata: {\"result\": 2}\n\n",
+			wantContentType: eventStream,
+		},
+		{
+			name:            "lines split across writes",
+			writes:          []string{`{"res`, `ult": 1}` + "\n" + `{"error"`, `: "failed"}` + "\n"},
+			accept:          eventStream,
+			wantBody:        "data: {\"result\": 1}\n\ndata: {\"error\": \"failed\"}\n\n",
+			wantContentType: eventStream,
+		},
+		{
+			name:            "unterminated last line",
+			writes:          []string{`{"result": 1}`},
+			accept:          "application/json, " + eventStream,
+			wantBody:        "data: {\"result\": 1}\n\n",
+			wantContentType: eventStream,
+		},
+		{
+			name:            "blank lines",
+			writes:          []string{"\n", `{"result": 1}` + "\n\n"},
+			accept:          eventStream,
+			wantBody:        "data: {\"result\": 1}\n\n",
+			wantContentType: eventStream,
+		},
+		{
+			name:            "other clients",
+			writes:          []string{`{"result": 1}` + "\n"},
+			accept:          "application/json",
+			wantBody:        `{"result": 1}` + "\n",
+			wantContentType: "application/json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := serverSentEvents(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				for _, data := range test.writes {
+					if _, err := w.Write([]byte(data)); err != nil {
+						t.Fatalf("Write() = %v", err)
+					}
+					w.(http.Flusher).Flush()
+				}
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/cabtrips/watch", nil)
+			req.Header.Set("Accept", test.accept)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			if body := recorder.Body.String(); body != test.wantBody {
+				t.Errorf("body = %q, want %q", body, test.wantBody)
+			}
+			if contentType := recorder.Header().Get("Content-Type"); contentType != test.wantContentType {
+				t.Errorf("content type = %q, want %q", contentType, test.wantContentType)
+			}
+		})
+	}
+}
+
+func TestServerSentEventsStatus(t *testing.T) {
+	handler := serverSentEvents(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"error": "invalid"}`))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/cabtrips/watch", nil)
+	req.Header.Set("Accept", eventStream)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	// only the first status is written
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", recorder.Code, http.StatusBadRequest)
+	}
+	if cacheControl := recorder.Header().Get("Cache-Control"); cacheControl != "no-cache" {
+		t.Errorf("cache control = %q, want no-cache", cacheControl)
+	}
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+
+	persistence "mnovicio.com/nycab/server/data/persistence"
+	"mnovicio.com/nycab/server/jobs"
+)
+
+const (
+	// maxBatchSize is the maximum number of requests of a batch
+	maxBatchSize = 1000
+	// batchWorkers is the number of lookups of a batch running concurrently
+	batchWorkers = 8
+	// maxLookupCabIDs is the number of cab IDs of a lookup, keeping its 'IN (...)' list well under the 65,535 placeholders of a MySQL statement
+	maxLookupCabIDs = 10000
+)
+
+// tripCountsLookupKey identifies the requests of a batch answered by the same lookup
+type tripCountsLookupKey struct {
+	dataset     pbdata.Dataset
+	pickupDate  string
+	ignoreCache bool
+}
+
+// tripCountsLookup fetches the trip counts of up to maxLookupCabIDs distinct cab IDs of the requests of a batch on the same dataset and pickup date
+type tripCountsLookup struct {
+	key       tripCountsLookupKey
+	dbContext *persistence.MySQLDBContext
+	cabIDs    []string
+
+	cabTrips *pbdata.CabTripsPerDay
+	err      error
+}
+
+// tripCountsLookups groups the cab IDs of the requests of a batch into lookups
+type tripCountsLookups struct {
+	maxCabIDs int
+	lookups   []*tripCountsLookup
+	// requestLookups are the lookups answering each request
+	requestLookups [][]*tripCountsLookup
+
+	// last is the lookup new cab IDs of a key are added to, until it holds maxCabIDs cab IDs
+	last map[tripCountsLookupKey]*tripCountsLookup
+	// cabLookups is the lookup of each cab ID of a key
+	cabLookups map[tripCountsLookupKey]map[string]*tripCountsLookup
+}
+
+func newTripCountsLookups(requestCount, maxCabIDs int) *tripCountsLookups {
+	return &tripCountsLookups{
+		maxCabIDs:      maxCabIDs,
+		requestLookups: make([][]*tripCountsLookup, requestCount),
+		last:           make(map[tripCountsLookupKey]*tripCountsLookup),
+		cabLookups:     make(map[tripCountsLookupKey]map[string]*tripCountsLookup),
+	}
+}
+
+// add adds the cab IDs of request i to the lookups of key, a cab ID requested more than once is looked up once
+func (l *tripCountsLookups) add(i int, key tripCountsLookupKey, dbContext *persistence.MySQLDBContext, cabIDs []string) {
+	cabLookups, found := l.cabLookups[key]
+	if !found {
+		cabLookups = make(map[string]*tripCountsLookup)
+		l.cabLookups[key] = cabLookups
+	}
+
+	for _, cabID := range cabIDs {
+		lookup, found := cabLookups[cabID]
+		if !found {
+			lookup = l.last[key]
+			if lookup == nil || len(lookup.cabIDs) >= l.maxCabIDs {
+				lookup = &tripCountsLookup{
+					key:       key,
+					dbContext: dbContext,
+				}
+				l.last[key] = lookup
+				l.lookups = append(l.lookups, lookup)
+			}
+			lookup.cabIDs = append(lookup.cabIDs, cabID)
+			cabLookups[cabID] = lookup
+		}
+
+		if !containsLookup(l.requestLookups[i], lookup) {
+			l.requestLookups[i] = append(l.requestLookups[i], lookup)
+		}
+	}
+}
+
+func containsLookup(lookups []*tripCountsLookup, lookup *tripCountsLookup) bool {
+	for _, l := range lookups {
+		if l == lookup {
+			return true
+		}
+	}
+	return false
+}
+
+// BatchGetTripCountsV1 returns the total number of trips of the cabs of each request on its pickup date
+// requests are answered independently, a failed request has its error set without failing the batch
+func (s *NYCabServiceImpl) BatchGetTripCountsV1(ctx context.Context, in *pbsvc.BatchGetTripCountsRequestV1) (*pbsvc.BatchGetTripCountsResponseV1, error) {
+	log.Println("BatchGetTripCountsV1: request count = ", len(in.Requests))
+	response, err := s.batchGetTripCounts(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.BatchGetTripCountsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) batchGetTripCounts(ctx context.Context, in *pbsvc.BatchGetTripCountsRequestV1) (*pbsvc.BatchGetTripCountsResponseV1, error) {
+	run, err := s.batchTripCountsQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// batchTripCountsQuery checks a batch trip counts request and returns the function running its query
+func (s *NYCabServiceImpl) batchTripCountsQuery(in *pbsvc.BatchGetTripCountsRequestV1) (func(ctx context.Context) (*pbsvc.BatchGetTripCountsResponseV1, error), error) {
+	if len(in.Requests) == 0 {
+		return nil, invalidField("requests", "empty request list")
+	}
+	if len(in.Requests) > maxBatchSize {
+		return nil, invalidField("requests", fmt.Sprintf("too many requests [%d], expecting at most %d", len(in.Requests), maxBatchSize))
+	}
+
+	return func(ctx context.Context) (*pbsvc.BatchGetTripCountsResponseV1, error) {
+		responses := make([]*pbsvc.GetTripCountsForCabIDsResponseV1, len(in.Requests))
+
+		// requests on the same dataset and pickup date share lookups of all their cab IDs
+		lookups := newTripCountsLookups(len(in.Requests), maxLookupCabIDs)
+		for i, request := range in.Requests {
+			dbContext, err := s.tripCountsDBContext(request)
+			if err != nil {
+				responses[i] = &pbsvc.GetTripCountsForCabIDsResponseV1{
+					Error: err.Error(),
+				}
+				continue
+			}
+
+			key := tripCountsLookupKey{
+				dataset:     request.Dataset,
+				pickupDate:  request.PickupDate,
+				ignoreCache: request.IgnoreCache,
+			}
+			lookups.add(i, key, dbContext, request.CabIds)
+		}
+
+		s.runTripCountsLookups(ctx, lookups.lookups)
+
+		for i, request := range in.Requests {
+			if responses[i] != nil {
+				continue
+			}
+			responses[i] = tripCountsResponse(request, lookups.requestLookups[i])
+			if responses[i].Error == "" {
+				s.filterHolidays(responses[i].CabTripsPerDay.CabTrips, request.HolidayFilter)
+			}
+		}
+
+		return &pbsvc.BatchGetTripCountsResponseV1{
+			Responses: responses,
+		}, nil
+	}, nil
+}
+
+// tripCountsResponse returns the trip counts of the cabs of a request from its lookups, or the error of the first failed one
+func tripCountsResponse(request *pbsvc.GetTripCountsForCabIDsRequestV1, lookups []*tripCountsLookup) *pbsvc.GetTripCountsForCabIDsResponseV1 {
+	for _, lookup := range lookups {
+		if lookup.err != nil {
+			return &pbsvc.GetTripCountsForCabIDsResponseV1{
+				Error: lookup.err.Error(),
+			}
+		}
+	}
+
+	// lookups are shared between requests, each request gets copies of the trips of its cabs
+	cabTrips := &pbdata.CabTripsPerDay{
+		CabTrips: make(map[string]*pbdata.TripsPerDay),
+	}
+	for _, lookup := range lookups {
+		for _, cabID := range request.CabIds {
+			if trips, found := lookup.cabTrips.CabTrips[cabID]; found {
+				cabTrips.CabTrips[cabID] = proto.Clone(trips).(*pbdata.TripsPerDay)
+			}
+		}
+	}
+
+	return &pbsvc.GetTripCountsForCabIDsResponseV1{
+		CabTripsPerDay: cabTrips,
+	}
+}
+
+// runTripCountsLookups runs the lookups on a pool of batchWorkers goroutines
+// lookups not started when the context is done fail with the context error
+func (s *NYCabServiceImpl) runTripCountsLookups(ctx context.Context, lookups []*tripCountsLookup) {
+	pending := make(chan *tripCountsLookup)
+
+	// completed lookups are reported as progress when the batch runs as a query job
+	var completedLock sync.Mutex
+	completed := 0
+
+	var wg sync.WaitGroup
+	for w := 0; w < batchWorkers && w < len(lookups); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for lookup := range pending {
+				lookup.cabTrips, lookup.err = lookup.dbContext.GetTripCountsForCabsByPickupDate(ctx,
+					lookup.cabIDs, lookup.key.pickupDate, lookup.key.ignoreCache)
+
+				completedLock.Lock()
+				completed++
+				jobs.ReportProgress(ctx, completed, len(lookups))
+				completedLock.Unlock()
+			}
+		}()
+	}
+
+	for _, lookup := range lookups {
+		select {
+		case pending <- lookup:
+		case <-ctx.Done():
+			lookup.err = ctx.Err()
+		}
+	}
+	close(pending)
+	wg.Wait()
+}
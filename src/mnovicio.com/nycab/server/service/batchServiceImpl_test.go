@@ -0,0 +1,124 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func TestTripCountsLookupsGrouping(t *testing.T) {
+	day1 := tripCountsLookupKey{dataset: pbdata.Dataset_YELLOW, pickupDate: "2013-12-01"}
+	day2 := tripCountsLookupKey{dataset: pbdata.Dataset_YELLOW, pickupDate: "2013-12-02"}
+
+	tests := []struct {
+		name      string
+		keys      []tripCountsLookupKey
+		cabIDs    [][]string
+		maxCabIDs int
+		// wantLookups are the cab IDs of each lookup
+		wantLookups [][]string
+		// wantRequests are the indices of the lookups of each request
+		wantRequests [][]int
+	}{
+		{
+			name:         "same key shares a lookup",
+			keys:         []tripCountsLookupKey{day1, day1},
+			cabIDs:       [][]string{{"A", "B"}, {"B", "C"}},
+			maxCabIDs:    10,
+			wantLookups:  [][]string{{"A", "B", "C"}},
+			wantRequests: [][]int{{0}, {0}},
+		},
+		{
+			name:         "different keys",
+			keys:         []tripCountsLookupKey{day1, day2},
+			cabIDs:       [][]string{{"A"}, {"A"}},
+			maxCabIDs:    10,
+			wantLookups:  [][]string{{"A"}, {"A"}},
+			wantRequests: [][]int{{0}, {1}},
+		},
+		{
+			name:         "chunked at maxCabIDs",
+			keys:         []tripCountsLookupKey{day1, day1},
+			cabIDs:       [][]string{{"A", "B", "C"}, {"C", "D", "A", "E"}},
+			maxCabIDs:    2,
+			wantLookups:  [][]string{{"A", "B"}, {"C", "D"}, {"E"}},
+			wantRequests: [][]int{{0, 1}, {1, 0, 2}},
+		},
+		{
+			name:         "duplicated cab IDs",
+			keys:         []tripCountsLookupKey{day1},
+			cabIDs:       [][]string{{"A", "A", "B", "A"}},
+			maxCabIDs:    1,
+			wantLookups:  [][]string{{"A"}, {"B"}},
+			wantRequests: [][]int{{0, 1}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lookups := newTripCountsLookups(len(test.keys), test.maxCabIDs)
+			for i, key := range test.keys {
+				lookups.add(i, key, nil, test.cabIDs[i])
+			}
+
+			gotLookups := [][]string{}
+			index := map[*tripCountsLookup]int{}
+			for i, lookup := range lookups.lookups {
+				gotLookups = append(gotLookups, lookup.cabIDs)
+				index[lookup] = i
+			}
+			if !reflect.DeepEqual(gotLookups, test.wantLookups) {
+				t.Errorf("lookups = %v, want %v", gotLookups, test.wantLookups)
+			}
+
+			gotRequests := [][]int{}
+			for _, requestLookups := range lookups.requestLookups {
+				indices := []int{}
+				for _, lookup := range requestLookups {
+					indices = append(indices, index[lookup])
+				}
+				gotRequests = append(gotRequests, indices)
+			}
+			if !reflect.DeepEqual(gotRequests, test.wantRequests) {
+				t.Errorf("request lookups = %v, want %v", gotRequests, test.wantRequests)
+			}
+		})
+	}
+}
+
+func TestTripCountsResponse(t *testing.T) {
+	trips := func(cabID string, count uint32) *pbdata.CabTripsPerDay {
+		return &pbdata.CabTripsPerDay{CabTrips: map[string]*pbdata.TripsPerDay{
+			cabID: {TripsPerDay: map[string]uint32{"2013-12-01": count}},
+		}}
+	}
+	first := &tripCountsLookup{cabIDs: []string{"A"}, cabTrips: trips("A", 3)}
+	second := &tripCountsLookup{cabIDs: []string{"B"}, cabTrips: trips("B", 5)}
+	failed := &tripCountsLookup{cabIDs: []string{"C"}, err: errors.New("lookup failed")}
+
+	request := &pbsvc.GetTripCountsForCabIDsRequestV1{CabIds: []string{"A", "B"}}
+	response := tripCountsResponse(request, []*tripCountsLookup{first, second})
+	if response.Error != "" {
+		t.Fatalf("error = %q, want none", response.Error)
+	}
+	for cabID, want := range map[string]uint32{"A": 3, "B": 5} {
+		if got := response.CabTripsPerDay.CabTrips[cabID].GetTripsPerDay()["2013-12-01"]; got != want {
+			t.Errorf("trips of %s = %d, want %d", cabID, got, want)
+		}
+	}
+
+	// responses get copies, the shared lookup results are left untouched
+	response.CabTripsPerDay.CabTrips["A"].TripsPerDay["2013-12-01"] = 0
+	if first.cabTrips.CabTrips["A"].TripsPerDay["2013-12-01"] != 3 {
+		t.Error("lookup result modified through the response")
+	}
+
+	// only requests with a cab in a failed lookup fail
+	request = &pbsvc.GetTripCountsForCabIDsRequestV1{CabIds: []string{"A", "C"}}
+	if response := tripCountsResponse(request, []*tripCountsLookup{first, failed}); response.Error != "lookup failed" {
+		t.Errorf("error = %q, want %q", response.Error, "lookup failed")
+	}
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// messageField is a field of a generated message, or a member of one of its oneofs
+type messageField struct {
+	name   string
+	wire   string
+	goType reflect.Type
+}
+
+// compatibleMessages caches the result of checkCompatible keyed by message types and dropped fields
+var compatibleMessages sync.Map
+
+type compatibilityKey struct {
+	from, to reflect.Type
+	dropped  string
+}
+
+// convertMessage copies a message into a wire compatible message of another type
+// the messages must have the same fields, with the same numbers, names and types, but the dropped fields which are not copied
+func convertMessage(from, to proto.Message, dropped ...string) error {
+	if err := checkCompatible(from, to, dropped...); err != nil {
+		return err
+	}
+
+	data, err := proto.Marshal(from)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %v", proto.MessageName(from), err)
+	}
+
+	to.Reset()
+	if err := proto.Unmarshal(data, to); err != nil {
+		return fmt.Errorf("failed to unmarshal %s: %v", proto.MessageName(to), err)
+	}
+
+	// the dropped fields of from are unknown to to, do not carry them over
+	if unknown := reflect.ValueOf(to).Elem().FieldByName("XXX_unrecognized"); unknown.IsValid() {
+		unknown.SetBytes(nil)
+	}
+	return nil
+}
+
+// checkCompatible returns an error if the messages are not wire compatible, checking each field by number, name and type
+// dropped: names of top level fields only one of the messages has
+func checkCompatible(from, to proto.Message, dropped ...string) error {
+	key := compatibilityKey{
+		from:    reflect.TypeOf(from),
+		to:      reflect.TypeOf(to),
+		dropped: strings.Join(dropped, ","),
+	}
+	if err, found := compatibleMessages.Load(key); found {
+		if err == nil {
+			return nil
+		}
+		return err.(error)
+	}
+
+	droppedFields := make(map[string]bool, len(dropped))
+	for _, name := range dropped {
+		droppedFields[name] = true
+	}
+
+	err := compatibleTypes(key.from.Elem(), key.to.Elem(), droppedFields)
+	if err != nil {
+		err = fmt.Errorf("%s is not compatible with %s: %v", proto.MessageName(from), proto.MessageName(to), err)
+		compatibleMessages.Store(key, err)
+	} else {
+		compatibleMessages.Store(key, nil)
+	}
+	return err
+}
+
+// compatibleTypes compares the fields of two generated message structs
+func compatibleTypes(from, to reflect.Type, dropped map[string]bool) error {
+	fromFields, toFields := messageFields(from), messageFields(to)
+
+	numbers := []int{}
+	for number := range fromFields {
+		numbers = append(numbers, number)
+	}
+	for number := range toFields {
+		if _, found := fromFields[number]; !found {
+			numbers = append(numbers, number)
+		}
+	}
+	sort.Ints(numbers)
+
+	for _, number := range numbers {
+		fromField, inFrom := fromFields[number]
+		toField, inTo := toFields[number]
+		switch {
+		case !inTo:
+			if !dropped[fromField.name] {
+				return fmt.Errorf("field %d [%s] is missing", number, fromField.name)
+			}
+		case !inFrom:
+			if !dropped[toField.name] {
+				return fmt.Errorf("field %d [%s] is missing", number, toField.name)
+			}
+		case fromField.name != toField.name:
+			return fmt.Errorf("field %d is [%s] and [%s]", number, fromField.name, toField.name)
+		case fromField.wire != toField.wire:
+			return fmt.Errorf("field %d [%s] is encoded as %s and %s", number, fromField.name, fromField.wire, toField.wire)
+		default:
+			if err := compatibleFieldTypes(fromField.goType, toField.goType); err != nil {
+				return fmt.Errorf("field %d [%s]: %v", number, fromField.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// compatibleFieldTypes compares the Go types of two fields, nested messages are compared field by field
+func compatibleFieldTypes(from, to reflect.Type) error {
+	if from == to {
+		return nil
+	}
+	if from.Kind() != to.Kind() {
+		return fmt.Errorf("type is %s and %s", from, to)
+	}
+
+	switch from.Kind() {
+	case reflect.Ptr:
+		if from.Elem().Kind() == reflect.Struct && to.Elem().Kind() == reflect.Struct {
+			return compatibleTypes(from.Elem(), to.Elem(), nil)
+		}
+	case reflect.Slice:
+		return compatibleFieldTypes(from.Elem(), to.Elem())
+	case reflect.Map:
+		if err := compatibleFieldTypes(from.Key(), to.Key()); err != nil {
+			return fmt.Errorf("map key: %v", err)
+		}
+		return compatibleFieldTypes(from.Elem(), to.Elem())
+	}
+	return fmt.Errorf("type is %s and %s", from, to)
+}
+
+// messageFields returns the fields of a generated message struct keyed by field number, oneof members included
+func messageFields(t reflect.Type) map[int]messageField {
+	properties := proto.GetProperties(t)
+
+	fields := make(map[int]messageField)
+	for i, prop := range properties.Prop {
+		if prop.Tag > 0 {
+			fields[prop.Tag] = messageField{name: prop.OrigName, wire: prop.Wire, goType: t.Field(i).Type}
+		}
+	}
+	for _, oneof := range properties.OneofTypes {
+		fields[oneof.Prop.Tag] = messageField{name: oneof.Prop.OrigName, wire: oneof.Prop.Wire, goType: oneof.Type.Elem().Field(0).Type}
+	}
+	return fields
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+// convertedResponses are the V2 responses converted from their V1 counterparts by hand
+var convertedResponses = map[string]bool{
+	"nycab.rpc.GetTripCountsForCabIDsResponseV2":       true,
+	"nycab.rpc.GetAllCabTripsResponseV2":               true,
+	"nycab.rpc.GetTripCountsForHackLicensesResponseV2": true,
+	"nycab.rpc.GetAllDriverTripsResponseV2":            true,
+}
+
+// TestV2Compatibility checks every V2 RPC message against its V1 counterpart, field by field
+func TestV2Compatibility(t *testing.T) {
+	server := reflect.TypeOf((*pbsvc.NYCabServiceV2Server)(nil)).Elem()
+	if server.NumMethod() == 0 {
+		t.Fatal("NYCabServiceV2Server has no method")
+	}
+
+	for i := 0; i < server.NumMethod(); i++ {
+		method := server.Method(i)
+		request := reflect.New(method.Type.In(1).Elem()).Interface().(proto.Message)
+		response := reflect.New(method.Type.Out(0).Elem()).Interface().(proto.Message)
+
+		requestV1 := messageV1(t, request)
+		if err := checkCompatible(request, requestV1, "field_mask"); err != nil {
+			t.Errorf("%s request: %v", method.Name, err)
+		}
+
+		if convertedResponses[proto.MessageName(response)] {
+			continue
+		}
+		responseV1 := messageV1(t, response)
+		if err := checkCompatible(responseV1, response, "error"); err != nil {
+			t.Errorf("%s response: %v", method.Name, err)
+		}
+	}
+}
+
+// messageV1 returns a new V1 counterpart of a V2 message
+func messageV1(t *testing.T, messageV2 proto.Message) proto.Message {
+	name := strings.TrimSuffix(proto.MessageName(messageV2), "V2") + "V1"
+	messageType := proto.MessageType(name)
+	if messageType == nil {
+		t.Fatalf("no V1 message [%s] for %s", name, proto.MessageName(messageV2))
+	}
+	return reflect.New(messageType.Elem()).Interface().(proto.Message)
+}
+
+func TestExportQueryCompatibility(t *testing.T) {
+	if err := checkCompatible(&pbsvc.ExportQueryRequestV1{}, &pbsvc.SubmitQueryJobRequestV1{}, "format", "file_name", "batch_trip_counts"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckCompatible(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    proto.Message
+		to      proto.Message
+		dropped []string
+		valid   bool
+	}{
+		{"same message", &pbsvc.ListTripsRequestV1{}, &pbsvc.ListTripsRequestV1{}, nil, true},
+		{"field only in from", &pbsvc.ListTripsRequestV2{}, &pbsvc.ListTripsRequestV1{}, nil, false},
+		{"field only in to", &pbsvc.ListTripsResponseV1{}, &pbsvc.ListTripsResponseV2{}, nil, false},
+		{"different names", &pbsvc.ClearCacheRequestV1{}, &pbsvc.ListTripsRequestV1{}, nil, false},
+		{"different types", &pbdata.TripRecord{}, &pbsvc.ListTripsRequestV1{}, nil, false},
+		{"oneof member missing", &pbsvc.SubmitQueryJobRequestV1{}, &pbsvc.ExportQueryRequestV1{}, []string{"format", "file_name"}, false},
+	}
+
+	for _, test := range tests {
+		if err := checkCompatible(test.from, test.to, test.dropped...); (err == nil) != test.valid {
+			t.Errorf("%s: checkCompatible(%s, %s) = %v, want valid %t", test.name, proto.MessageName(test.from), proto.MessageName(test.to), err, test.valid)
+		}
+	}
+}
+
+func TestConvertMessage(t *testing.T) {
+	in := &pbsvc.ListTripsRequestV2{
+		CabId:     "D7D598CD99978BD012A87A76A7C891B7",
+		StartTime: "2013-12-01",
+		EndTime:   "2013-12-02",
+		PageSize:  10,
+		Fields:    []string{"pickup_time"},
+		Dataset:   pbdata.Dataset_GREEN,
+		FieldMask: &field_mask.FieldMask{Paths: []string{"trips"}},
+	}
+
+	request := &pbsvc.ListTripsRequestV1{}
+	if err := convertMessage(in, request, "field_mask"); err != nil {
+		t.Fatalf("convertMessage() = %v", err)
+	}
+	want := &pbsvc.ListTripsRequestV1{
+		CabId:     in.CabId,
+		StartTime: in.StartTime,
+		EndTime:   in.EndTime,
+		PageSize:  in.PageSize,
+		Fields:    in.Fields,
+		Dataset:   in.Dataset,
+	}
+	if !proto.Equal(request, want) {
+		t.Errorf("convertMessage() = %v, want %v", request, want)
+	}
+	if len(request.XXX_unrecognized) > 0 {
+		t.Errorf("convertMessage() kept the dropped field_mask as unrecognized bytes")
+	}
+
+	export := &pbsvc.ExportQueryRequestV1{
+		Query: &pbsvc.ExportQueryRequestV1_VendorStats{VendorStats: &pbsvc.GetVendorStatsRequestV1{StartDate: "2013-12-01"}},
+	}
+	job := &pbsvc.SubmitQueryJobRequestV1{}
+	if err := convertMessage(export, job, "format", "file_name", "batch_trip_counts"); err != nil {
+		t.Fatalf("convertMessage() = %v", err)
+	}
+	if job.GetVendorStats().GetStartDate() != "2013-12-01" {
+		t.Errorf("convertMessage() = %v, want the vendor_stats query", job)
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+// GetTripCountsForHackLicensesV1 returns the total number of trips the driver has made based on pickup_datetime column with time ignored
+func (s *NYCabServiceImpl) GetTripCountsForHackLicensesV1(ctx context.Context, in *pbsvc.GetTripCountsForHackLicensesRequestV1) (*pbsvc.GetTripCountsForHackLicensesResponseV1, error) {
+	log.Println("GetTripCountsForHackLicensesV1: request = ", in)
+	response, err := s.getTripCountsForHackLicenses(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetTripCountsForHackLicensesResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getTripCountsForHackLicenses(ctx context.Context, in *pbsvc.GetTripCountsForHackLicensesRequestV1) (*pbsvc.GetTripCountsForHackLicensesResponseV1, error) {
+	dbContext, err := s.dbContext(in.Dataset, "hack_license")
+	if err != nil {
+		return nil, err
+	}
+
+	// check date format
+	if err := checkDateFormat("pickup_date", in.PickupDate); err != nil {
+		return nil, err
+	}
+
+	driverTrips, err := dbContext.GetTripCountsForDriversByPickupDate(ctx, in.HackLicenses, in.PickupDate, in.IgnoreCache)
+	if err != nil {
+		return &pbsvc.GetTripCountsForHackLicensesResponseV1{}, err
+	}
+
+	s.filterHolidays(driverTrips.DriverTrips, in.HolidayFilter)
+
+	return &pbsvc.GetTripCountsForHackLicensesResponseV1{
+		DriverTripsPerDay: driverTrips,
+	}, nil
+}
+
+// GetAllDriverTripCountPerDayV1 returns number of trips per day on record for each driver
+func (s *NYCabServiceImpl) GetAllDriverTripCountPerDayV1(ctx context.Context, in *pbsvc.GetAllDriverTripsRequestV1) (*pbsvc.GetAllDriverTripsResponseV1, error) {
+	log.Println("GetAllDriverTripCountPerDayV1: request = ", in)
+	response, err := s.getAllDriverTripCountPerDay(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetAllDriverTripsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getAllDriverTripCountPerDay(ctx context.Context, in *pbsvc.GetAllDriverTripsRequestV1) (*pbsvc.GetAllDriverTripsResponseV1, error) {
+	run, err := s.allDriverTripsQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// allDriverTripsQuery checks an all driver trips request and returns the function running its query
+func (s *NYCabServiceImpl) allDriverTripsQuery(in *pbsvc.GetAllDriverTripsRequestV1) (func(ctx context.Context) (*pbsvc.GetAllDriverTripsResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, "hack_license")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetAllDriverTripsResponseV1, error) {
+		driverTrips, err := dbContext.GetAllDriverTrips(ctx, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetAllDriverTripsResponseV1{}, err
+		}
+
+		s.filterHolidays(driverTrips.DriverTrips, in.HolidayFilter)
+
+		return &pbsvc.GetAllDriverTripsResponseV1{
+			DriverTripsPerDay: driverTrips,
+		}, nil
+	}, nil
+}
+
+// GetCabDriverMappingV1 returns which drivers drove which cabs on a given pickup date and vice versa
+func (s *NYCabServiceImpl) GetCabDriverMappingV1(ctx context.Context, in *pbsvc.GetCabDriverMappingRequestV1) (*pbsvc.GetCabDriverMappingResponseV1, error) {
+	log.Println("GetCabDriverMappingV1: request = ", in)
+	response, err := s.getCabDriverMapping(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetCabDriverMappingResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getCabDriverMapping(ctx context.Context, in *pbsvc.GetCabDriverMappingRequestV1) (*pbsvc.GetCabDriverMappingResponseV1, error) {
+	dbContext, err := s.dbContext(in.Dataset, "medallion", "hack_license")
+	if err != nil {
+		return nil, err
+	}
+
+	// check date format
+	if err := checkDateFormat("pickup_date", in.PickupDate); err != nil {
+		return nil, err
+	}
+
+	mapping, err := dbContext.GetCabDriverMapping(ctx, in.CabIds, in.HackLicenses, in.PickupDate, in.IgnoreCache)
+	if err != nil {
+		return &pbsvc.GetCabDriverMappingResponseV1{}, err
+	}
+
+	return &pbsvc.GetCabDriverMappingResponseV1{
+		CabDriverMapping: mapping,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requestError is a handled error caused by the request rather than by the server
+// V1 RPCs return its description in the error string of the response, V2 RPCs return it as a status error
+type requestError struct {
+	code codes.Code
+	// field is the request field at fault, empty if the error is not caused by a single field
+	field       string
+	description string
+}
+
+func (e *requestError) Error() string {
+	return e.description
+}
+
+// invalidField returns an InvalidArgument request error caused by a request field
+func invalidField(field, description string) error {
+	log.Println(description)
+	return &requestError{
+		code:        codes.InvalidArgument,
+		field:       field,
+		description: description,
+	}
+}
+
+// failedPrecondition returns a FailedPrecondition request error for requests the server is not configured to handle
+func failedPrecondition(description string) error {
+	log.Println(description)
+	return &requestError{
+		code:        codes.FailedPrecondition,
+		description: description,
+	}
+}
+
+// resourceExhausted returns a ResourceExhausted request error for requests the server is too busy to accept
+func resourceExhausted(description string) error {
+	log.Println(description)
+	return &requestError{
+		code:        codes.ResourceExhausted,
+		description: description,
+	}
+}
+
+// handledError returns the description of a request error, which V1 RPCs return in the error string of the response
+// returns false for any other error
+func handledError(err error) (string, bool) {
+	if reqErr, ok := err.(*requestError); ok {
+		return reqErr.description, true
+	}
+	return "", false
+}
+
+// statusError converts a request error to a status error with a google.rpc.BadRequest field violation if a field is at fault
+// cancelled and timed out queries are converted to Canceled and DeadlineExceeded status errors, any other error is returned as is
+func statusError(err error) error {
+	switch err {
+	case context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	reqErr, ok := err.(*requestError)
+	if !ok {
+		return err
+	}
+
+	st := status.New(reqErr.code, reqErr.description)
+	if reqErr.field == "" {
+		return st.Err()
+	}
+
+	detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{
+				Field:       reqErr.field,
+				Description: reqErr.description,
+			},
+		},
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,389 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+
+	"mnovicio.com/nycab/server/export"
+)
+
+const (
+	// exportChunkSize is the size of the chunks of the files streamed back by ExportQueryV1
+	exportChunkSize = 64 * 1024
+	// exportSchemaKey is the Parquet file metadata key holding the export schema as JSON
+	exportSchemaKey = "nycab.schema"
+	// exportSchemaSuffix replaces the extension of exported CSV files in the name of their schema file
+	exportSchemaSuffix = ".schema.json"
+)
+
+// ExportQueryV1 runs an aggregation query and exports its result as a CSV or Parquet file
+// the file is written in the export directory of the server if a file name is set, streamed back in chunks otherwise
+func (s *NYCabServiceImpl) ExportQueryV1(in *pbsvc.ExportQueryRequestV1, stream pbsvc.NYCabService_ExportQueryV1Server) error {
+	log.Println("ExportQueryV1: request = ", in)
+	err := s.exportQuery(stream.Context(), in, stream.Send)
+	if errString, handled := handledError(err); handled {
+		return stream.Send(&pbsvc.ExportQueryResponseV1{
+			Error: errString,
+		})
+	}
+
+	return err
+}
+
+func (s *NYCabServiceImpl) exportQuery(ctx context.Context, in *pbsvc.ExportQueryRequestV1, send func(*pbsvc.ExportQueryResponseV1) error) error {
+	if _, found := pbsvc.ExportFormat_name[int32(in.Format)]; !found {
+		return invalidField("format", fmt.Sprintf("unknown format [%d]", in.Format))
+	}
+	if in.FileName != "" {
+		if s.exportDir == "" {
+			return failedPrecondition("export directory is not configured, start the server with -export-dir")
+		}
+		if in.FileName != filepath.Base(in.FileName) || strings.HasPrefix(in.FileName, ".") {
+			return invalidField("file_name", fmt.Sprintf("invalid file name [%s], expecting a file name without directory", in.FileName))
+		}
+		if strings.HasSuffix(in.FileName, exportSchemaSuffix) {
+			return invalidField("file_name", fmt.Sprintf("invalid file name [%s], %s is the suffix of the schema files of CSV exports", in.FileName, exportSchemaSuffix))
+		}
+		// fail before running the query, the files are still created only if they do not exist
+		for _, path := range s.exportPaths(in.FileName, in.Format) {
+			if _, err := os.Stat(path); err == nil {
+				return exportFileExists(path)
+			}
+		}
+	}
+
+	// the query oneof of export requests is wire compatible with the one of query job requests, but batch_trip_counts
+	request := &pbsvc.SubmitQueryJobRequestV1{}
+	if err := convertMessage(in, request, "format", "file_name", "batch_trip_counts"); err != nil {
+		return err
+	}
+	name, run, err := s.queryJobRun(request)
+	if err != nil {
+		return err
+	}
+
+	// exports run their query in the slots of the query jobs, waiting for a running job to end if there is no free slot
+	result, err := s.jobs.Run(ctx, run)
+	if err != nil {
+		return err
+	}
+
+	table, err := exportTable(result)
+	if err != nil {
+		return err
+	}
+
+	schema, err := exportSchema(name, request, table)
+	if err != nil {
+		return err
+	}
+
+	if in.FileName != "" {
+		path, err := s.writeExport(in.FileName, table, schema, in.Format)
+		if err != nil {
+			return err
+		}
+		return send(&pbsvc.ExportQueryResponseV1{
+			Schema: schema,
+			Path:   path,
+		})
+	}
+
+	chunks := &exportChunks{
+		send:   send,
+		schema: schema,
+	}
+	if err := encodeExport(chunks, table, schema, in.Format); err != nil {
+		return err
+	}
+	return chunks.flush()
+}
+
+// exportChunks sends the file written to it in chunks of exportChunkSize bytes as it is encoded
+// the schema is sent with the first chunk, which is sent even if the file is empty
+type exportChunks struct {
+	send   func(*pbsvc.ExportQueryResponseV1) error
+	schema *pbsvc.ExportSchema
+	sent   bool
+	data   []byte
+}
+
+func (c *exportChunks) Write(p []byte) (int, error) {
+	c.data = append(c.data, p...)
+	for len(c.data) >= exportChunkSize {
+		if err := c.sendChunk(c.data[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		c.data = append([]byte{}, c.data[exportChunkSize:]...)
+	}
+	return len(p), nil
+}
+
+// flush sends the data written since the last chunk
+func (c *exportChunks) flush() error {
+	if c.sent && len(c.data) == 0 {
+		return nil
+	}
+	if err := c.sendChunk(c.data); err != nil {
+		return err
+	}
+	c.data = nil
+	return nil
+}
+
+func (c *exportChunks) sendChunk(data []byte) error {
+	response := &pbsvc.ExportQueryResponseV1{
+		Data: data,
+	}
+	if !c.sent {
+		response.Schema = c.schema
+		c.sent = true
+	}
+	return c.send(response)
+}
+
+// exportTable flattens the result of an aggregation query to a table
+// the trip counts per day are exported as V2 trip counts, nested lists are exported as one row per element
+// or a single row with null element columns if empty
+func exportTable(result interface{}) (*export.Table, error) {
+	switch result := result.(type) {
+	case *pbsvc.GetAllCabTripsResponseV1:
+		counts, err := cabTripCounts(result.CabTripsPerDay.GetCabTrips())
+		if err != nil {
+			return nil, err
+		}
+		return newExportTable(messageRows(counts), &pbdata.CabTripCount{})
+	case *pbsvc.GetAllDriverTripsResponseV1:
+		counts, err := driverTripCounts(result.DriverTripsPerDay.GetDriverTrips())
+		if err != nil {
+			return nil, err
+		}
+		return newExportTable(messageRows(counts), &pbdata.DriverTripCount{})
+	case *pbsvc.GetPickupHeatmapResponseV1:
+		return newExportTable(messageRows(result.Cells), &pbdata.HeatmapCell{})
+	case *pbsvc.GetOriginDestinationMatrixResponseV1:
+		return newExportTable(messageRows(result.Entries), &pbdata.ODMatrixEntry{})
+	case *pbsvc.GetCabUtilizationResponseV1:
+		return newExportTable(messageRows(result.Utilization), &pbdata.CabUtilization{})
+	case *pbsvc.GetTripPatternsResponseV1:
+		rows := [][]proto.Message{}
+		for _, patterns := range result.Patterns {
+			summaries := append(append([]*pbdata.TripCountSummary{}, patterns.ByDayOfWeek...), patterns.ByMonth...)
+			rows = append(rows, nestedRows(patterns, summaries)...)
+		}
+		return newExportTable(rows, &pbdata.TripPatterns{}, &pbdata.TripCountSummary{})
+	case *pbsvc.DetectCountAnomaliesResponseV1:
+		return newExportTable(messageRows(result.Anomalies), &pbdata.CountAnomaly{})
+	case *pbsvc.ForecastTripsResponseV1:
+		rows := [][]proto.Message{}
+		for _, cabForecast := range result.Forecasts {
+			rows = append(rows, nestedRows(cabForecast, cabForecast.Forecasts)...)
+		}
+		return newExportTable(rows, &pbdata.CabTripForecast{}, &pbdata.TripForecast{})
+	case *pbsvc.GetPassengerCountsResponseV1:
+		rows := [][]proto.Message{}
+		for _, distribution := range fleetFirst(result.Fleet, result.Cabs) {
+			rows = append(rows, nestedRows(distribution, distribution.(*pbdata.PassengerCountDistribution).Buckets)...)
+		}
+		return newExportTable(rows, &pbdata.PassengerCountDistribution{}, &pbdata.PassengerCountBucket{})
+	case *pbsvc.GetVendorStatsResponseV1:
+		return newExportTable(messageRows(result.Vendors), &pbdata.VendorStats{})
+	case *pbsvc.GetTaxiZoneTripCountsResponseV1:
+		return newExportTable(messageRows(result.Counts), &pbdata.TaxiZoneTripCount{})
+	case *pbsvc.GetCabZoneCoverageResponseV1:
+		rows := [][]proto.Message{}
+		for _, coverage := range result.Coverage {
+			rows = append(rows, nestedRows(coverage, coverage.Zones)...)
+		}
+		return newExportTable(rows, &pbdata.CabZoneCoverage{}, &pbdata.TaxiZoneTripCount{})
+	case *pbsvc.GetCabRevenueResponseV1:
+		return newExportTable(messageRows(result.Revenue), &pbdata.CabRevenue{})
+	case *pbsvc.GetTipRatesResponseV1:
+		return newExportTable(messageRows(fleetFirst(result.Fleet, result.Cabs)), &pbdata.TipRate{})
+	case *pbsvc.GetPaymentTypeMixResponseV1:
+		rows := [][]proto.Message{}
+		for _, mix := range fleetFirst(result.Fleet, result.Cabs) {
+			rows = append(rows, nestedRows(mix, mix.(*pbdata.PaymentTypeMix).PaymentTypes)...)
+		}
+		return newExportTable(rows, &pbdata.PaymentTypeMix{}, &pbdata.PaymentTypeShare{})
+	}
+	return nil, fmt.Errorf("unsupported export of %T", result)
+}
+
+// newExportTable returns a table of the columns of the row messages holding rows
+func newExportTable(rows [][]proto.Message, row ...proto.Message) (*export.Table, error) {
+	table, err := export.NewTable(row...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, messages := range rows {
+		if err := table.Append(messages...); err != nil {
+			return nil, err
+		}
+	}
+	return table, nil
+}
+
+// messageRows returns one row per message of a slice of messages
+func messageRows(messages interface{}) [][]proto.Message {
+	list := reflect.ValueOf(messages)
+	rows := make([][]proto.Message, list.Len())
+	for i := range rows {
+		rows[i] = []proto.Message{list.Index(i).Interface().(proto.Message)}
+	}
+	return rows
+}
+
+// nestedRows returns one row of parent and each element of a slice of messages
+// a parent without elements has a single row with a nil element, so that it is exported with null element columns
+func nestedRows(parent proto.Message, elements interface{}) [][]proto.Message {
+	list := reflect.ValueOf(elements)
+	if list.Len() == 0 {
+		return [][]proto.Message{{parent, nil}}
+	}
+
+	rows := make([][]proto.Message, list.Len())
+	for i := range rows {
+		rows[i] = []proto.Message{parent, list.Index(i).Interface().(proto.Message)}
+	}
+	return rows
+}
+
+// fleetFirst returns the fleet entry, if any, followed by the cab entries of a slice of messages
+func fleetFirst(fleet proto.Message, cabs interface{}) []proto.Message {
+	messages := []proto.Message{}
+	if !reflect.ValueOf(fleet).IsNil() {
+		messages = append(messages, fleet)
+	}
+	for _, row := range messageRows(cabs) {
+		messages = append(messages, row[0])
+	}
+	return messages
+}
+
+// exportSchema describes the table exported for the query request
+func exportSchema(name string, request *pbsvc.SubmitQueryJobRequestV1, table *export.Table) (*pbsvc.ExportSchema, error) {
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	requestJSON, err := marshaler.MarshalToString(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal export request: %v", err)
+	}
+
+	schema := &pbsvc.ExportSchema{
+		Query:      name,
+		Request:    requestJSON,
+		ExportTime: time.Now().Format(dateTimeFormat),
+		RowCount:   uint64(len(table.Rows)),
+	}
+	for _, column := range table.Columns {
+		schema.Columns = append(schema.Columns, &pbsvc.ExportColumn{
+			Name: column.Name,
+			Type: string(column.Type),
+		})
+	}
+	return schema, nil
+}
+
+// encodeExport writes the table in the export format, CSV rows are written one at a time
+// Parquet files hold the schema as JSON in their metadata
+func encodeExport(w io.Writer, table *export.Table, schema *pbsvc.ExportSchema, format pbsvc.ExportFormat) error {
+	if format == pbsvc.ExportFormat_CSV {
+		if err := export.WriteCSV(w, table); err != nil {
+			return fmt.Errorf("failed to write CSV export: %v", err)
+		}
+		return nil
+	}
+
+	schemaJSON, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(schema)
+	if err != nil {
+		return fmt.Errorf("failed to marshal export schema: %v", err)
+	}
+	if err := export.WriteParquet(w, table, map[string]string{exportSchemaKey: schemaJSON}); err != nil {
+		return fmt.Errorf("failed to write Parquet export: %v", err)
+	}
+	return nil
+}
+
+// writeExport writes an exported file in the export directory, along with its schema for CSV files
+// existing files are not overwritten
+func (s *NYCabServiceImpl) writeExport(fileName string, table *export.Table, schema *pbsvc.ExportSchema, format pbsvc.ExportFormat) (string, error) {
+	path := filepath.Join(s.exportDir, fileName)
+	err := createFile(path, func(w io.Writer) error {
+		return encodeExport(w, table, schema, format)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if format == pbsvc.ExportFormat_CSV {
+		err := createFile(exportSchemaPath(path), func(w io.Writer) error {
+			marshaler := jsonpb.Marshaler{OrigName: true, EmitDefaults: true, Indent: "  "}
+			if err := marshaler.Marshal(w, schema); err != nil {
+				return fmt.Errorf("failed to marshal export schema: %v", err)
+			}
+			return nil
+		})
+		if err != nil {
+			// a CSV file is not left behind without its schema
+			os.Remove(path)
+			return "", err
+		}
+	}
+
+	log.Printf("exported %d rows of %s to %s", schema.RowCount, schema.Query, path)
+	return path, nil
+}
+
+// exportPaths returns the paths of the files written by an export to a file, the schema file included for CSV exports
+func (s *NYCabServiceImpl) exportPaths(fileName string, format pbsvc.ExportFormat) []string {
+	path := filepath.Join(s.exportDir, fileName)
+	if format == pbsvc.ExportFormat_CSV {
+		return []string{path, exportSchemaPath(path)}
+	}
+	return []string{path}
+}
+
+// exportSchemaPath returns the path of the schema file of an exported CSV file, e.g. 'trips.schema.json' for 'trips.csv'
+func exportSchemaPath(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + exportSchemaSuffix
+}
+
+func exportFileExists(path string) error {
+	return invalidField("file_name", fmt.Sprintf("file [%s] already exists in the export directory", filepath.Base(path)))
+}
+
+// createFile writes a new file with write, returns a request error if the file already exists
+// the file is removed if write fails
+func createFile(path string, write func(w io.Writer) error) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if os.IsExist(err) {
+		return exportFileExists(path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create export file: %v", err)
+	}
+
+	err = write(f)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write export file: %v", closeErr)
+	}
+	if err != nil {
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func TestExportTableEmptyNestedLists(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  interface{}
+		columns []string
+		// wantRows are the values of the columns of each row
+		wantRows [][]interface{}
+	}{
+		{
+			name: "passenger buckets",
+			result: &pbsvc.GetPassengerCountsResponseV1{
+				Fleet: &pbdata.PassengerCountDistribution{CabId: "fleet", TotalTrips: 1,
+					Buckets: []*pbdata.PassengerCountBucket{{PassengerCount: 1, Trips: 1}}},
+				Cabs: []*pbdata.PassengerCountDistribution{{CabId: "A"}},
+			},
+			columns:  []string{"cab_id", "passenger_count", "trips"},
+			wantRows: [][]interface{}{{"fleet", int32(1), int64(1)}, {"A", nil, nil}},
+		},
+		{
+			name: "zone coverage",
+			result: &pbsvc.GetCabZoneCoverageResponseV1{
+				Coverage: []*pbdata.CabZoneCoverage{{CabId: "A"}},
+			},
+			columns:  []string{"cab_id", "zones_visited", "location_id"},
+			wantRows: [][]interface{}{{"A", int64(0), nil}},
+		},
+		{
+			name: "payment types",
+			result: &pbsvc.GetPaymentTypeMixResponseV1{
+				Cabs: []*pbdata.PaymentTypeMix{
+					{CabId: "A", TotalTrips: 2, PaymentTypes: []*pbdata.PaymentTypeShare{{PaymentType: "CSH", Trips: 2}}},
+					{CabId: "B"},
+				},
+			},
+			columns:  []string{"cab_id", "payment_type", "share"},
+			wantRows: [][]interface{}{{"A", "CSH", 0.0}, {"B", nil, nil}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			table, err := exportTable(test.result)
+			if err != nil {
+				t.Fatalf("exportTable() = %v", err)
+			}
+
+			index := make(map[string]int)
+			for i, column := range table.Columns {
+				index[column.Name] = i
+			}
+			got := [][]interface{}{}
+			for _, row := range table.Rows {
+				values := []interface{}{}
+				for _, name := range test.columns {
+					i, found := index[name]
+					if !found {
+						t.Fatalf("column [%s] not found", name)
+					}
+					values = append(values, row[i])
+				}
+				got = append(got, values)
+			}
+			if !reflect.DeepEqual(got, test.wantRows) {
+				t.Errorf("rows = %v, want %v", got, test.wantRows)
+			}
+		})
+	}
+}
+
+func TestExportChunks(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       int
+		wantChunks []int
+	}{
+		{"empty file", 0, []int{0}},
+		{"single chunk", 10, []int{10}},
+		{"exact chunks", 2 * exportChunkSize, []int{exportChunkSize, exportChunkSize}},
+		{"last partial chunk", exportChunkSize + 1, []int{exportChunkSize, 1}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema := &pbsvc.ExportSchema{Query: "tip_rates"}
+			var received []*pbsvc.ExportQueryResponseV1
+			chunks := &exportChunks{
+				send: func(response *pbsvc.ExportQueryResponseV1) error {
+					received = append(received, response)
+					return nil
+				},
+				schema: schema,
+			}
+
+			// written in small pieces, as the CSV writer does
+			data := bytes.Repeat([]byte("x"), test.size)
+			for start := 0; start < len(data); start += 1000 {
+				end := start + 1000
+				if end > len(data) {
+					end = len(data)
+				}
+				if _, err := chunks.Write(data[start:end]); err != nil {
+					t.Fatalf("Write() = %v", err)
+				}
+			}
+			if err := chunks.flush(); err != nil {
+				t.Fatalf("flush() = %v", err)
+			}
+
+			sizes := []int{}
+			var file []byte
+			for i, response := range received {
+				sizes = append(sizes, len(response.Data))
+				file = append(file, response.Data...)
+				if (response.Schema != nil) != (i == 0) {
+					t.Errorf("chunk %d schema = %v, want it on the first chunk only", i, response.Schema)
+				}
+			}
+			if !reflect.DeepEqual(sizes, test.wantChunks) {
+				t.Errorf("chunk sizes = %v, want %v", sizes, test.wantChunks)
+			}
+			if !bytes.Equal(file, data) {
+				t.Error("received file differs from the written one")
+			}
+		})
+	}
+
+	// send errors abort the export
+	chunks := &exportChunks{
+		send: func(*pbsvc.ExportQueryResponseV1) error { return errors.New("stream closed") },
+	}
+	if _, err := chunks.Write(make([]byte, exportChunkSize)); err == nil {
+		t.Error("Write() = nil, want the send error")
+	}
+}
+
+func TestExportQueryFileNames(t *testing.T) {
+	dir, err := ioutil.TempDir("", "exports")
+	if err != nil {
+		t.Fatalf("TempDir() = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "taken.schema.json"), nil, 0644); err != nil {
+		t.Fatalf("WriteFile() = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		fileName string
+		format   pbsvc.ExportFormat
+	}{
+		{"directory", "../rates.csv", pbsvc.ExportFormat_CSV},
+		{"hidden file", ".rates.csv", pbsvc.ExportFormat_CSV},
+		{"schema file", "rates.schema.json", pbsvc.ExportFormat_PARQUET},
+		{"schema file of a CSV export exists", "taken.csv", pbsvc.ExportFormat_CSV},
+	}
+
+	// the service has no job store, requests are rejected before running the query
+	s := newTestService()
+	s.exportDir = dir
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := &pbsvc.ExportQueryRequestV1{
+				FileName: test.fileName,
+				Format:   test.format,
+				Query:    &pbsvc.ExportQueryRequestV1_TipRates{TipRates: &pbsvc.GetTipRatesRequestV1{StartDate: "2013-12-01", EndDate: "2013-12-07"}},
+			}
+			err := s.exportQuery(context.Background(), in, nil)
+			reqErr, ok := err.(*requestError)
+			if !ok || reqErr.field != "file_name" {
+				t.Errorf("exportQuery() = %v, want an error of field file_name", err)
+			}
+		})
+	}
+}
+
+func TestWriteExportCSVSchemaFileExists(t *testing.T) {
+	dir, err := ioutil.TempDir("", "exports")
+	if err != nil {
+		t.Fatalf("TempDir() = %v", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "rates.schema.json"), nil, 0644); err != nil {
+		t.Fatalf("WriteFile() = %v", err)
+	}
+
+	table, err := exportTable(&pbsvc.GetTipRatesResponseV1{Fleet: &pbdata.TipRate{CabId: "fleet"}})
+	if err != nil {
+		t.Fatalf("exportTable() = %v", err)
+	}
+	schema, err := exportSchema("tip_rates", &pbsvc.SubmitQueryJobRequestV1{}, table)
+	if err != nil {
+		t.Fatalf("exportSchema() = %v", err)
+	}
+
+	s := &NYCabServiceImpl{exportDir: dir}
+	if _, err := s.writeExport("rates.csv", table, schema, pbsvc.ExportFormat_CSV); err == nil {
+		t.Fatal("writeExport() succeeded with an existing schema file")
+	}
+
+	// the CSV file is removed with its schema file failing
+	if _, err := os.Stat(filepath.Join(dir, "rates.csv")); !os.IsNotExist(err) {
+		t.Errorf("CSV file left behind: %v", err)
+	}
+}
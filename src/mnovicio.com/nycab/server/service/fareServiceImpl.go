@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/golang/protobuf/proto"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+
+	persistence "mnovicio.com/nycab/server/data/persistence"
+)
+
+// fareDBContext returns the DB context of the dataset, or an error if the dataset has no fare data or lacks any of the columns
+func (s *NYCabServiceImpl) fareDBContext(dataset pbdata.Dataset, columns ...string) (*persistence.MySQLDBContext, error) {
+	// fares are joined to the trips on these columns
+	columns = append(columns, "medallion", "hack_license")
+	dbContext, err := s.dbContext(dataset, columns...)
+	if err != nil {
+		return nil, err
+	}
+
+	if dbContext.Dataset().FareTable == "" {
+		return nil, invalidField("dataset", fmt.Sprintf("dataset [%s] has no fare data", dbContext.Dataset().Name))
+	}
+
+	return dbContext, nil
+}
+
+// GetCabRevenueV1 returns the fares, tips and tolls collected by the cabs on each day of a date range
+func (s *NYCabServiceImpl) GetCabRevenueV1(ctx context.Context, in *pbsvc.GetCabRevenueRequestV1) (*pbsvc.GetCabRevenueResponseV1, error) {
+	log.Println("GetCabRevenueV1: request = ", in)
+	response, err := s.getCabRevenue(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetCabRevenueResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getCabRevenue(ctx context.Context, in *pbsvc.GetCabRevenueRequestV1) (*pbsvc.GetCabRevenueResponseV1, error) {
+	run, err := s.cabRevenueQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// cabRevenueQuery checks a cab revenue request and returns the function running its query
+func (s *NYCabServiceImpl) cabRevenueQuery(in *pbsvc.GetCabRevenueRequestV1) (func(ctx context.Context) (*pbsvc.GetCabRevenueResponseV1, error), error) {
+	dbContext, err := s.fareDBContext(in.Dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.CabIds) == 0 {
+		return nil, invalidField("cab_ids", "empty cab ID list")
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", in.StartDate, "end_date", in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetCabRevenueResponseV1, error) {
+		revenue, err := dbContext.GetCabRevenue(ctx, in.CabIds, startDate, endDate, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetCabRevenueResponseV1{}, err
+		}
+
+		// cached entries are shared, tag copies of them
+		filtered := make([]*pbdata.CabRevenue, 0, len(revenue))
+		for _, r := range revenue {
+			isHoliday := s.holidays.IsHoliday(r.Date)
+			switch in.HolidayFilter {
+			case pbdata.HolidayFilter_TAG_HOLIDAYS:
+				r = proto.Clone(r).(*pbdata.CabRevenue)
+				r.IsHoliday = isHoliday
+			case pbdata.HolidayFilter_EXCLUDE_HOLIDAYS:
+				if isHoliday {
+					continue
+				}
+			}
+			filtered = append(filtered, r)
+		}
+
+		return &pbsvc.GetCabRevenueResponseV1{
+			Revenue: filtered,
+		}, nil
+	}, nil
+}
+
+// GetTipRatesV1 returns the tip rate of the trips paid by card of the whole fleet and of each cab over a date range
+func (s *NYCabServiceImpl) GetTipRatesV1(ctx context.Context, in *pbsvc.GetTipRatesRequestV1) (*pbsvc.GetTipRatesResponseV1, error) {
+	log.Println("GetTipRatesV1: request = ", in)
+	response, err := s.getTipRates(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetTipRatesResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getTipRates(ctx context.Context, in *pbsvc.GetTipRatesRequestV1) (*pbsvc.GetTipRatesResponseV1, error) {
+	run, err := s.tipRatesQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// tipRatesQuery checks a tip rates request and returns the function running its query
+func (s *NYCabServiceImpl) tipRatesQuery(in *pbsvc.GetTipRatesRequestV1) (func(ctx context.Context) (*pbsvc.GetTipRatesResponseV1, error), error) {
+	dbContext, err := s.fareDBContext(in.Dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", in.StartDate, "end_date", in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetTipRatesResponseV1, error) {
+		fleet, cabs, err := dbContext.GetTipRates(ctx, requestedCabIDs(ctx, in.CabIds), startDate, endDate, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetTipRatesResponseV1{}, err
+		}
+
+		return &pbsvc.GetTipRatesResponseV1{
+			Fleet: fleet,
+			Cabs:  cabs,
+		}, nil
+	}, nil
+}
+
+// GetPaymentTypeMixV1 returns the number and share of trips per payment type of the whole fleet and of each cab over a date range
+func (s *NYCabServiceImpl) GetPaymentTypeMixV1(ctx context.Context, in *pbsvc.GetPaymentTypeMixRequestV1) (*pbsvc.GetPaymentTypeMixResponseV1, error) {
+	log.Println("GetPaymentTypeMixV1: request = ", in)
+	response, err := s.getPaymentTypeMix(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetPaymentTypeMixResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getPaymentTypeMix(ctx context.Context, in *pbsvc.GetPaymentTypeMixRequestV1) (*pbsvc.GetPaymentTypeMixResponseV1, error) {
+	run, err := s.paymentTypeMixQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// paymentTypeMixQuery checks a payment type mix request and returns the function running its query
+func (s *NYCabServiceImpl) paymentTypeMixQuery(in *pbsvc.GetPaymentTypeMixRequestV1) (func(ctx context.Context) (*pbsvc.GetPaymentTypeMixResponseV1, error), error) {
+	dbContext, err := s.fareDBContext(in.Dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", in.StartDate, "end_date", in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetPaymentTypeMixResponseV1, error) {
+		fleet, cabs, err := dbContext.GetPaymentTypeMix(ctx, requestedCabIDs(ctx, in.CabIds), startDate, endDate, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetPaymentTypeMixResponseV1{}, err
+		}
+
+		return &pbsvc.GetPaymentTypeMixResponseV1{
+			Fleet: fleet,
+			Cabs:  cabs,
+		}, nil
+	}, nil
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/metadata"
+)
+
+// FieldMaskMetadataKey is the metadata key the REST gateway sets to the 'fields' query parameter
+// holds comma separated field paths, used when the request has no field mask
+const FieldMaskMetadataKey = "nycab-fields"
+
+// fieldMask is a tree of the response fields to return, a nil fieldMask selects every field
+// a field mapped to nil is selected with all of its sub fields
+type fieldMask map[string]fieldMask
+
+type fieldMaskKey struct{}
+
+// withFieldMask returns a context holding the field mask of the request, or of the 'fields' query parameter of REST requests
+// returns an error on field 'field_mask' if a path does not name a field of response
+func withFieldMask(ctx context.Context, mask *field_mask.FieldMask, response proto.Message) (context.Context, fieldMask, error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			for _, value := range md.Get(FieldMaskMetadataKey) {
+				for _, path := range strings.Split(value, ",") {
+					if path = strings.TrimSpace(path); path != "" {
+						paths = append(paths, path)
+					}
+				}
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return ctx, nil, nil
+	}
+
+	responseType := reflect.TypeOf(response).Elem()
+	tree := fieldMask{}
+	for _, path := range paths {
+		if !hasFieldPath(responseType, strings.Split(path, ".")) {
+			return ctx, nil, invalidField("field_mask", fmt.Sprintf("unknown field path [%s]", path))
+		}
+		tree.add(strings.Split(path, "."))
+	}
+
+	return context.WithValue(ctx, fieldMaskKey{}, tree), tree, nil
+}
+
+// requestedFields returns the field mask held by the context, nil if the request has none
+func requestedFields(ctx context.Context) fieldMask {
+	mask, _ := ctx.Value(fieldMaskKey{}).(fieldMask)
+	return mask
+}
+
+// requestedCabIDs returns the cab IDs of a request returning the fleet and each cab
+// returns none if the results of each cab are not requested, to skip querying them
+func requestedCabIDs(ctx context.Context, cabIDs []string) []string {
+	if !requestedFields(ctx).selects("cabs") {
+		return nil
+	}
+	return cabIDs
+}
+
+func (m fieldMask) add(path []string) {
+	sub, found := m[path[0]]
+	if found && sub == nil {
+		// the whole field is already selected
+		return
+	}
+	if len(path) == 1 {
+		m[path[0]] = nil
+		return
+	}
+	if !found {
+		sub = fieldMask{}
+		m[path[0]] = sub
+	}
+	sub.add(path[1:])
+}
+
+// selects returns true if the field at the dotted path, or any of its sub fields, is to be returned
+func (m fieldMask) selects(path string) bool {
+	for _, name := range strings.Split(path, ".") {
+		if m == nil {
+			return true
+		}
+		sub, found := m[name]
+		if !found {
+			return false
+		}
+		m = sub
+	}
+	return true
+}
+
+// prune clears the fields of the response message which are not selected
+func (m fieldMask) prune(response proto.Message) {
+	if m == nil {
+		return
+	}
+	m.pruneStruct(reflect.ValueOf(response).Elem())
+}
+
+func (m fieldMask) pruneStruct(message reflect.Value) {
+	for i := 0; i < message.NumField(); i++ {
+		name := protoFieldName(message.Type().Field(i))
+		if name == "" {
+			continue
+		}
+
+		sub, found := m[name]
+		field := message.Field(i)
+		switch {
+		case !found:
+			field.Set(reflect.Zero(field.Type()))
+		case sub != nil:
+			sub.pruneValue(field)
+		}
+	}
+}
+
+// pruneValue prunes a message field, or each message of a repeated field
+func (m fieldMask) pruneValue(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if !value.IsNil() && value.Elem().Kind() == reflect.Struct {
+			m.pruneStruct(value.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			m.pruneValue(value.Index(i))
+		}
+	}
+}
+
+// hasFieldPath returns true if the path names a field of the message type, going through message and repeated message fields
+func hasFieldPath(messageType reflect.Type, path []string) bool {
+	for i := 0; i < messageType.NumField(); i++ {
+		field := messageType.Field(i)
+		if protoFieldName(field) != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return true
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Slice {
+			fieldType = fieldType.Elem()
+		}
+		if fieldType.Kind() != reflect.Ptr || fieldType.Elem().Kind() != reflect.Struct {
+			return false
+		}
+		return hasFieldPath(fieldType.Elem(), path[1:])
+	}
+	return false
+}
+
+// protoFieldName returns the proto name of a generated struct field, empty for internal fields
+func protoFieldName(field reflect.StructField) string {
+	for _, option := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(option, "name=") {
+			return strings.TrimPrefix(option, "name=")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/metadata"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func passengerCounts() *pbsvc.GetPassengerCountsResponseV2 {
+	return &pbsvc.GetPassengerCountsResponseV2{
+		Fleet: &pbdata.PassengerCountDistribution{
+			TotalTrips: 10,
+			Buckets:    []*pbdata.PassengerCountBucket{{PassengerCount: 1, Trips: 10, Share: 1}},
+		},
+		Cabs: []*pbdata.PassengerCountDistribution{
+			{CabId: "A", TotalTrips: 6, Buckets: []*pbdata.PassengerCountBucket{{PassengerCount: 1, Trips: 6, Share: 1}}, InvalidTrips: 1},
+			{CabId: "B", TotalTrips: 4, Buckets: []*pbdata.PassengerCountBucket{{PassengerCount: 1, Trips: 4, Share: 1}}},
+		},
+	}
+}
+
+func TestFieldMaskPrune(t *testing.T) {
+	tests := []struct {
+		name  string
+		paths []string
+		want  *pbsvc.GetPassengerCountsResponseV2
+	}{
+		{"no mask", nil, passengerCounts()},
+		{
+			name:  "whole field",
+			paths: []string{"fleet"},
+			want:  &pbsvc.GetPassengerCountsResponseV2{Fleet: passengerCounts().Fleet},
+		},
+		{
+			name:  "sub fields of repeated messages",
+			paths: []string{"cabs.cab_id", "cabs.total_trips"},
+			want: &pbsvc.GetPassengerCountsResponseV2{
+				Cabs: []*pbdata.PassengerCountDistribution{{CabId: "A", TotalTrips: 6}, {CabId: "B", TotalTrips: 4}},
+			},
+		},
+		{
+			name:  "nested sub field",
+			paths: []string{"fleet.buckets.share", "fleet.total_trips"},
+			want: &pbsvc.GetPassengerCountsResponseV2{
+				Fleet: &pbdata.PassengerCountDistribution{TotalTrips: 10, Buckets: []*pbdata.PassengerCountBucket{{Share: 1}}},
+			},
+		},
+		{
+			name:  "whole field wins over its sub fields",
+			paths: []string{"fleet.total_trips", "fleet"},
+			want:  &pbsvc.GetPassengerCountsResponseV2{Fleet: passengerCounts().Fleet},
+		},
+	}
+
+	for _, test := range tests {
+		_, mask, err := withFieldMask(context.Background(), &field_mask.FieldMask{Paths: test.paths}, &pbsvc.GetPassengerCountsResponseV2{})
+		if err != nil {
+			t.Errorf("%s: withFieldMask() = %v", test.name, err)
+			continue
+		}
+
+		response := passengerCounts()
+		mask.prune(response)
+		if !proto.Equal(response, test.want) {
+			t.Errorf("%s: prune() = %v, want %v", test.name, response, test.want)
+		}
+	}
+}
+
+func TestWithFieldMask(t *testing.T) {
+	response := &pbsvc.GetPassengerCountsResponseV2{}
+
+	// the REST gateway passes the 'fields' query parameter as metadata
+	md := metadata.Pairs(FieldMaskMetadataKey, "fleet.total_trips, cabs.cab_id")
+	ctx, mask, err := withFieldMask(metadata.NewIncomingContext(context.Background(), md), nil, response)
+	if err != nil {
+		t.Fatalf("withFieldMask() = %v", err)
+	}
+	if !mask.selects("fleet") || !mask.selects("cabs.cab_id") || mask.selects("cabs.buckets") {
+		t.Errorf("withFieldMask() = %v, want fleet.total_trips and cabs.cab_id", mask)
+	}
+	if requestedFields(ctx) == nil {
+		t.Error("requestedFields() = nil, want the mask of the context")
+	}
+
+	// the field mask of the request has precedence over the metadata
+	_, mask, err = withFieldMask(metadata.NewIncomingContext(context.Background(), md), &field_mask.FieldMask{Paths: []string{"fleet"}}, response)
+	if err != nil || mask.selects("cabs") {
+		t.Errorf("withFieldMask() = %v, %v, want fleet only", mask, err)
+	}
+
+	for _, path := range []string{"unknown", "fleet.unknown", "fleet.total_trips.value", "cabs.buckets.unknown"} {
+		if _, _, err := withFieldMask(context.Background(), &field_mask.FieldMask{Paths: []string{path}}, response); err == nil {
+			t.Errorf("withFieldMask(%s) = nil, want error", path)
+		}
+	}
+
+	if ctx, mask, err := withFieldMask(context.Background(), nil, response); err != nil || mask != nil || requestedFields(ctx) != nil {
+		t.Errorf("withFieldMask() without paths = %v, %v, want no mask", mask, err)
+	}
+}
+
+func TestRequestedCabIDs(t *testing.T) {
+	cabIDs := []string{"A", "B"}
+	response := &pbsvc.GetPassengerCountsResponseV2{}
+
+	tests := []struct {
+		paths []string
+		want  int
+	}{
+		{nil, 2},
+		{[]string{"cabs.cab_id"}, 2},
+		{[]string{"fleet"}, 0},
+	}
+
+	for _, test := range tests {
+		ctx, _, err := withFieldMask(context.Background(), &field_mask.FieldMask{Paths: test.paths}, response)
+		if err != nil {
+			t.Fatalf("withFieldMask(%v) = %v", test.paths, err)
+		}
+		if ids := requestedCabIDs(ctx, cabIDs); len(ids) != test.want {
+			t.Errorf("requestedCabIDs() with paths %v = %v, want %d IDs", test.paths, ids, test.want)
+		}
+	}
+}
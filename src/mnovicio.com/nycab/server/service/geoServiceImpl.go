@@ -0,0 +1,597 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+
+	"mnovicio.com/nycab/server/geo"
+)
+
+// maxPolygonTimeRangeDays is the longest time range accepted when counting the trips of a polygon, each pickup inside its bounds being tested against the polygon
+const maxPolygonTimeRangeDays = 7
+
+// CountTripsInAreaV1 returns the number of trips picked up inside a bounding box or polygon within a time range
+func (s *NYCabServiceImpl) CountTripsInAreaV1(ctx context.Context, in *pbsvc.CountTripsInAreaRequestV1) (*pbsvc.CountTripsInAreaResponseV1, error) {
+	log.Println("CountTripsInAreaV1: request = ", in)
+	response, err := s.countTripsInArea(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.CountTripsInAreaResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) countTripsInArea(ctx context.Context, in *pbsvc.CountTripsInAreaRequestV1) (*pbsvc.CountTripsInAreaResponseV1, error) {
+	dbContext, err := s.dbContext(in.Dataset, "medallion", "pickup_latitude", "pickup_longitude")
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, endTime, err := parseTimeRange("start_time", in.StartTime, "end_time", in.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var tripsPerCab map[string]uint32
+	switch {
+	case len(in.Polygon) > 0:
+		polygon := toPolygon(in.Polygon)
+		if err := polygon.Validate(); err != nil {
+			return nil, invalidField("polygon", fmt.Sprintf("invalid polygon. Error: %s", err.Error()))
+		}
+		if endTime.Sub(startTime) > maxPolygonTimeRangeDays*24*time.Hour {
+			return nil, invalidField("end_time", fmt.Sprintf("time range [%s, %s] exceeds %d days with a polygon", in.StartTime, in.EndTime, maxPolygonTimeRangeDays))
+		}
+
+		// narrow down the search to the polygon bounds, then keep the pickups inside the polygon itself
+		locations, err := dbContext.GetPickupLocationsInBoundingBox(ctx, polygon.Bounds(), startTime, endTime)
+		if err != nil {
+			return &pbsvc.CountTripsInAreaResponseV1{}, err
+		}
+
+		tripsPerCab = make(map[string]uint32)
+		for _, location := range locations {
+			if polygon.Contains(geo.Point{Latitude: location.Latitude, Longitude: location.Longitude}) {
+				tripsPerCab[location.CabID]++
+			}
+		}
+	case in.BoundingBox != nil:
+		box := toBoundingBox(in.BoundingBox)
+		if err := box.Validate(); err != nil {
+			return nil, invalidField("bounding_box", fmt.Sprintf("invalid bounding box. Error: %s", err.Error()))
+		}
+
+		var err error
+		tripsPerCab, err = dbContext.CountTripsInBoundingBox(ctx, box, startTime, endTime)
+		if err != nil {
+			return &pbsvc.CountTripsInAreaResponseV1{}, err
+		}
+	default:
+		return nil, invalidField("bounding_box", "missing area, expecting either bounding_box or polygon")
+	}
+
+	response := &pbsvc.CountTripsInAreaResponseV1{}
+	for _, count := range tripsPerCab {
+		response.TripCount += count
+	}
+	if in.IncludeCabIds {
+		response.TripsPerCab = tripsPerCab
+	}
+
+	return response, nil
+}
+
+// GetPickupHeatmapV1 returns the number of trips picked up in each geohash cell within a time range
+func (s *NYCabServiceImpl) GetPickupHeatmapV1(ctx context.Context, in *pbsvc.GetPickupHeatmapRequestV1) (*pbsvc.GetPickupHeatmapResponseV1, error) {
+	log.Println("GetPickupHeatmapV1: request = ", in)
+	response, err := s.getPickupHeatmap(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetPickupHeatmapResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getPickupHeatmap(ctx context.Context, in *pbsvc.GetPickupHeatmapRequestV1) (*pbsvc.GetPickupHeatmapResponseV1, error) {
+	run, err := s.pickupHeatmapQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// pickupHeatmapQuery checks a pickup heatmap request and returns the function running its query
+func (s *NYCabServiceImpl) pickupHeatmapQuery(in *pbsvc.GetPickupHeatmapRequestV1) (func(ctx context.Context) (*pbsvc.GetPickupHeatmapResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, "pickup_latitude", "pickup_longitude")
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Precision < 1 || in.Precision > geo.MaxGeohashPrecision {
+		return nil, invalidField("precision", fmt.Sprintf("invalid precision [%d], expecting value between 1 and %d", in.Precision, geo.MaxGeohashPrecision))
+	}
+
+	startTime, endTime, err := parseTimeRange("start_time", in.StartTime, "end_time", in.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetPickupHeatmapResponseV1, error) {
+		cells, err := dbContext.GetPickupHeatmap(ctx, in.Precision, startTime, endTime, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetPickupHeatmapResponseV1{}, err
+		}
+
+		return &pbsvc.GetPickupHeatmapResponseV1{
+			Cells: cells,
+		}, nil
+	}, nil
+}
+
+// GetOriginDestinationMatrixV1 returns the number of trips and average trip duration between pickup and dropoff cells within a time range
+func (s *NYCabServiceImpl) GetOriginDestinationMatrixV1(ctx context.Context, in *pbsvc.GetOriginDestinationMatrixRequestV1) (*pbsvc.GetOriginDestinationMatrixResponseV1, error) {
+	log.Println("GetOriginDestinationMatrixV1: request = ", in)
+	response, err := s.getOriginDestinationMatrix(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetOriginDestinationMatrixResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getOriginDestinationMatrix(ctx context.Context, in *pbsvc.GetOriginDestinationMatrixRequestV1) (*pbsvc.GetOriginDestinationMatrixResponseV1, error) {
+	run, err := s.originDestinationMatrixQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// originDestinationMatrixQuery checks an origin-destination matrix request and returns the function running its query
+func (s *NYCabServiceImpl) originDestinationMatrixQuery(in *pbsvc.GetOriginDestinationMatrixRequestV1) (func(ctx context.Context) (*pbsvc.GetOriginDestinationMatrixResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, "dropoff_datetime", "pickup_latitude", "pickup_longitude", "dropoff_latitude", "dropoff_longitude")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case in.GeohashPrecision > 0 && in.GridSize > 0:
+		return nil, invalidField("grid_size", "expecting either geohash_precision or grid_size, not both")
+	case in.GeohashPrecision > geo.MaxGeohashPrecision:
+		return nil, invalidField("geohash_precision", fmt.Sprintf("invalid geohash_precision [%d], expecting value between 1 and %d", in.GeohashPrecision, geo.MaxGeohashPrecision))
+	case in.GeohashPrecision == 0 && (in.GridSize <= 0 || in.GridSize > 180):
+		return nil, invalidField("grid_size", fmt.Sprintf("invalid grid_size [%f], expecting value greater than 0 and up to 180 degrees", in.GridSize))
+	}
+
+	startTime, endTime, err := parseTimeRange("start_time", in.StartTime, "end_time", in.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetOriginDestinationMatrixResponseV1, error) {
+		entries, err := dbContext.GetOriginDestinationMatrix(ctx, in.GeohashPrecision, in.GridSize, startTime, endTime, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetOriginDestinationMatrixResponseV1{}, err
+		}
+
+		return &pbsvc.GetOriginDestinationMatrixResponseV1{
+			Entries: entries,
+		}, nil
+	}, nil
+}
+
+// maxZoneTripsDateRangeDays is the longest date range accepted by CountZoneTripsV1, which tests every trip starting or ending within the zone bounds
+const maxZoneTripsDateRangeDays = 7
+
+// CountZoneTripsV1 returns the number of trips of each cab starting or ending in each named zone per day
+func (s *NYCabServiceImpl) CountZoneTripsV1(ctx context.Context, in *pbsvc.CountZoneTripsRequestV1) (*pbsvc.CountZoneTripsResponseV1, error) {
+	log.Println("CountZoneTripsV1: request = ", in)
+	response, err := s.countZoneTrips(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.CountZoneTripsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) countZoneTrips(ctx context.Context, in *pbsvc.CountZoneTripsRequestV1) (*pbsvc.CountZoneTripsResponseV1, error) {
+	dbContext, err := s.dbContext(in.Dataset, "medallion", "pickup_latitude", "pickup_longitude", "dropoff_latitude", "dropoff_longitude")
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", in.StartDate, "end_date", in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if endDate.Sub(startDate).Hours()/24 >= maxZoneTripsDateRangeDays {
+		return nil, invalidField("end_date", fmt.Sprintf("date range [%s, %s] exceeds %d days", in.StartDate, in.EndDate, maxZoneTripsDateRangeDays))
+	}
+
+	zones, err := s.selectZones(in.Zones)
+	if err != nil {
+		return nil, err
+	}
+
+	// narrow down the search to the zone bounds, then keep the trips starting or ending inside the zones themselves
+	boxes := make([]geo.BoundingBox, 0, len(zones))
+	for _, zone := range zones {
+		boxes = append(boxes, zone.Bounds())
+	}
+	trips, err := dbContext.GetTripEndpointsInBoundingBoxes(ctx, boxes, in.CabIds, startDate, endDate)
+	if err != nil {
+		return &pbsvc.CountZoneTripsResponseV1{}, err
+	}
+
+	response := &pbsvc.CountZoneTripsResponseV1{
+		Counts: []*pbdata.ZoneTripCount{},
+	}
+	for _, zone := range zones {
+		counts := make(map[string]*pbdata.ZoneTripCount)
+		for _, trip := range trips {
+			pickup, dropoff := zone.Contains(trip.Pickup), zone.Contains(trip.Dropoff)
+			if !pickup && !dropoff {
+				continue
+			}
+
+			key := trip.CabID + ":" + trip.PickupDate
+			count, found := counts[key]
+			if !found {
+				count = &pbdata.ZoneTripCount{
+					Zone:  zone.Name,
+					CabId: trip.CabID,
+					Date:  trip.PickupDate,
+				}
+				counts[key] = count
+			}
+			if pickup {
+				count.Pickups++
+			}
+			if dropoff {
+				count.Dropoffs++
+			}
+			count.Trips++
+		}
+
+		zoneCounts := make([]*pbdata.ZoneTripCount, 0, len(counts))
+		for _, count := range counts {
+			zoneCounts = append(zoneCounts, count)
+		}
+		sort.Slice(zoneCounts, func(i, j int) bool {
+			if zoneCounts[i].CabId != zoneCounts[j].CabId {
+				return zoneCounts[i].CabId < zoneCounts[j].CabId
+			}
+			return zoneCounts[i].Date < zoneCounts[j].Date
+		})
+		response.Counts = append(response.Counts, zoneCounts...)
+	}
+
+	return response, nil
+}
+
+// selectZones returns the configured zones with the given names, all of them if names is empty
+// returns an error if a name is unknown
+func (s *NYCabServiceImpl) selectZones(names []string) ([]geo.Zone, error) {
+	if len(names) == 0 {
+		return s.zones, nil
+	}
+
+	selected := make(map[string]bool, len(names))
+	for _, name := range names {
+		selected[name] = true
+	}
+
+	zones := make([]geo.Zone, 0, len(selected))
+	for _, zone := range s.zones {
+		if selected[zone.Name] {
+			zones = append(zones, zone)
+			delete(selected, zone.Name)
+		}
+	}
+
+	if len(selected) > 0 {
+		unknown := make([]string, 0, len(selected))
+		for name := range selected {
+			unknown = append(unknown, name)
+		}
+		sort.Strings(unknown)
+		return nil, invalidField("zones", fmt.Sprintf("unknown zones %v", unknown))
+	}
+
+	return zones, nil
+}
+
+// maxTaxiZoneDateRangeDays is the longest date range accepted by the taxi zone RPCs, which map every trip of the range to its zones
+const maxTaxiZoneDateRangeDays = 31
+
+// maxAllTaxiZonesDateRangeDays is the longest date range accepted when counting the trips of all taxi zones, which map every location of the city
+const maxAllTaxiZonesDateRangeDays = 7
+
+// maxZoneCoverageCabs is the number of cabs a single GetCabZoneCoverageV1 request may map every trip of
+const maxZoneCoverageCabs = 100
+
+// GetTaxiZoneTripCountsV1 returns the number of trips starting and ending in each TLC taxi zone per day
+func (s *NYCabServiceImpl) GetTaxiZoneTripCountsV1(ctx context.Context, in *pbsvc.GetTaxiZoneTripCountsRequestV1) (*pbsvc.GetTaxiZoneTripCountsResponseV1, error) {
+	log.Println("GetTaxiZoneTripCountsV1: request = ", in)
+	response, err := s.getTaxiZoneTripCounts(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetTaxiZoneTripCountsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getTaxiZoneTripCounts(ctx context.Context, in *pbsvc.GetTaxiZoneTripCountsRequestV1) (*pbsvc.GetTaxiZoneTripCountsResponseV1, error) {
+	run, err := s.taxiZoneTripCountsQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// taxiZoneTripCountsQuery checks a taxi zone trip counts request and returns the function running its query
+func (s *NYCabServiceImpl) taxiZoneTripCountsQuery(in *pbsvc.GetTaxiZoneTripCountsRequestV1) (func(ctx context.Context) (*pbsvc.GetTaxiZoneTripCountsResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, "pickup_latitude", "pickup_longitude", "dropoff_latitude", "dropoff_longitude")
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := s.parseTaxiZoneDateRange(in.StartDate, in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]bool, len(in.LocationIds))
+	for _, locationID := range in.LocationIds {
+		if _, found := s.taxiZones.Zone(locationID); !found {
+			return nil, invalidField("location_ids", fmt.Sprintf("unknown taxi zone [%s]", locationID))
+		}
+		selected[locationID] = true
+	}
+
+	// narrow down the search to the bounds of the selected zones, or of all zones
+	boxes := []geo.BoundingBox{}
+	if len(selected) > 0 {
+		for locationID := range selected {
+			zone, _ := s.taxiZones.Zone(locationID)
+			boxes = append(boxes, zone.Bounds())
+		}
+	} else {
+		if endDate.Sub(startDate).Hours()/24 >= maxAllTaxiZonesDateRangeDays {
+			return nil, invalidField("end_date", fmt.Sprintf("date range [%s, %s] exceeds %d days without location_ids", in.StartDate, in.EndDate, maxAllTaxiZonesDateRangeDays))
+		}
+		corners := geo.Polygon{}
+		for _, zone := range s.taxiZones.Zones() {
+			bounds := zone.Bounds()
+			corners = append(corners, bounds.SouthWest, bounds.NorthEast)
+		}
+		boxes = append(boxes, corners.Bounds())
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetTaxiZoneTripCountsResponseV1, error) {
+		endpoints, err := dbContext.CountTripEndpointsInBoundingBoxes(ctx, boxes, startDate, endDate)
+		if err != nil {
+			return &pbsvc.GetTaxiZoneTripCountsResponseV1{}, err
+		}
+
+		counts := make(map[string]*pbdata.TaxiZoneTripCount)
+		for _, endpoint := range endpoints {
+			zone, found := s.taxiZones.Lookup(endpoint.Location)
+			if !found || (len(selected) > 0 && !selected[zone.Name]) {
+				continue
+			}
+
+			key := zone.Name + ":" + endpoint.PickupDate
+			count, found := counts[key]
+			if !found {
+				count = toPBTaxiZoneTripCount(zone)
+				count.Date = endpoint.PickupDate
+				counts[key] = count
+			}
+			count.Pickups += endpoint.Pickups
+			count.Dropoffs += endpoint.Dropoffs
+		}
+
+		response := &pbsvc.GetTaxiZoneTripCountsResponseV1{
+			Counts: make([]*pbdata.TaxiZoneTripCount, 0, len(counts)),
+		}
+		for _, count := range counts {
+			response.Counts = append(response.Counts, count)
+		}
+		sort.Slice(response.Counts, func(i, j int) bool {
+			if response.Counts[i].LocationId != response.Counts[j].LocationId {
+				return lessLocationID(response.Counts[i].LocationId, response.Counts[j].LocationId)
+			}
+			return response.Counts[i].Date < response.Counts[j].Date
+		})
+
+		return response, nil
+	}, nil
+}
+
+// GetCabZoneCoverageV1 returns the TLC taxi zones each cab picked up or dropped off passengers in
+func (s *NYCabServiceImpl) GetCabZoneCoverageV1(ctx context.Context, in *pbsvc.GetCabZoneCoverageRequestV1) (*pbsvc.GetCabZoneCoverageResponseV1, error) {
+	log.Println("GetCabZoneCoverageV1: request = ", in)
+	response, err := s.getCabZoneCoverage(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetCabZoneCoverageResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getCabZoneCoverage(ctx context.Context, in *pbsvc.GetCabZoneCoverageRequestV1) (*pbsvc.GetCabZoneCoverageResponseV1, error) {
+	run, err := s.cabZoneCoverageQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// cabZoneCoverageQuery checks a cab zone coverage request and returns the function running its query
+func (s *NYCabServiceImpl) cabZoneCoverageQuery(in *pbsvc.GetCabZoneCoverageRequestV1) (func(ctx context.Context) (*pbsvc.GetCabZoneCoverageResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, "medallion", "pickup_latitude", "pickup_longitude", "dropoff_latitude", "dropoff_longitude")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.CabIds) == 0 {
+		return nil, invalidField("cab_ids", "empty cab ID list")
+	}
+	if cabIDs := uniqueIDs(in.CabIds); len(cabIDs) > maxZoneCoverageCabs {
+		return nil, invalidField("cab_ids", fmt.Sprintf("%d cab IDs exceed the maximum of %d cabs", len(cabIDs), maxZoneCoverageCabs))
+	}
+
+	startDate, endDate, err := s.parseTaxiZoneDateRange(in.StartDate, in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetCabZoneCoverageResponseV1, error) {
+		trips, err := dbContext.GetTripEndpoints(ctx, in.CabIds, startDate, endDate)
+		if err != nil {
+			return &pbsvc.GetCabZoneCoverageResponseV1{}, err
+		}
+
+		coverage := make(map[string]*pbdata.CabZoneCoverage, len(in.CabIds))
+		zoneCounts := make(map[string]map[string]*pbdata.TaxiZoneTripCount, len(in.CabIds))
+		for _, cabID := range in.CabIds {
+			coverage[cabID] = &pbdata.CabZoneCoverage{
+				CabId: cabID,
+				Zones: []*pbdata.TaxiZoneTripCount{},
+			}
+			zoneCounts[cabID] = make(map[string]*pbdata.TaxiZoneTripCount)
+		}
+
+		countTrip := func(cabID string, location geo.Point, pickup bool) {
+			zone, found := s.taxiZones.Lookup(location)
+			if !found {
+				return
+			}
+
+			count, found := zoneCounts[cabID][zone.Name]
+			if !found {
+				count = toPBTaxiZoneTripCount(zone)
+				zoneCounts[cabID][zone.Name] = count
+			}
+			if pickup {
+				count.Pickups++
+			} else {
+				count.Dropoffs++
+			}
+		}
+		for _, trip := range trips {
+			if _, found := coverage[trip.CabID]; !found {
+				continue
+			}
+			coverage[trip.CabID].Trips++
+			countTrip(trip.CabID, trip.Pickup, true)
+			countTrip(trip.CabID, trip.Dropoff, false)
+		}
+
+		response := &pbsvc.GetCabZoneCoverageResponseV1{
+			Coverage: make([]*pbdata.CabZoneCoverage, 0, len(coverage)),
+		}
+		cabIDs := make([]string, 0, len(coverage))
+		for cabID := range coverage {
+			cabIDs = append(cabIDs, cabID)
+		}
+		sort.Strings(cabIDs)
+		for _, cabID := range cabIDs {
+			cabCoverage := coverage[cabID]
+			for _, count := range zoneCounts[cabID] {
+				cabCoverage.Zones = append(cabCoverage.Zones, count)
+			}
+			sort.Slice(cabCoverage.Zones, func(i, j int) bool {
+				return lessLocationID(cabCoverage.Zones[i].LocationId, cabCoverage.Zones[j].LocationId)
+			})
+			cabCoverage.ZonesVisited = uint32(len(cabCoverage.Zones))
+
+			response.Coverage = append(response.Coverage, cabCoverage)
+		}
+
+		return response, nil
+	}, nil
+}
+
+// parseTaxiZoneDateRange parses the start_date and end_date fields of a taxi zone RPC
+// returns an error if taxi zones are not configured, or the range is invalid or exceeds maxTaxiZoneDateRangeDays
+func (s *NYCabServiceImpl) parseTaxiZoneDateRange(start, end string) (time.Time, time.Time, error) {
+	if s.taxiZones == nil {
+		return time.Time{}, time.Time{}, failedPrecondition("taxi zones are not configured, start the server with -taxi-zones")
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", start, "end_date", end)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if endDate.Sub(startDate).Hours()/24 >= maxTaxiZoneDateRangeDays {
+		return time.Time{}, time.Time{}, invalidField("end_date", fmt.Sprintf("date range [%s, %s] exceeds %d days", start, end, maxTaxiZoneDateRangeDays))
+	}
+
+	return startDate, endDate, nil
+}
+
+// lessLocationID orders taxi zone IDs numerically when both are numbers, alphabetically otherwise
+func lessLocationID(a, b string) bool {
+	x, errX := strconv.Atoi(a)
+	y, errY := strconv.Atoi(b)
+	if errX == nil && errY == nil {
+		return x < y
+	}
+	return a < b
+}
+
+func toPBTaxiZoneTripCount(zone geo.Zone) *pbdata.TaxiZoneTripCount {
+	return &pbdata.TaxiZoneTripCount{
+		LocationId: zone.Name,
+		Zone:       zone.Properties["zone"],
+		Borough:    zone.Properties["borough"],
+	}
+}
+
+func toPoint(p *pbdata.GeoPoint) geo.Point {
+	return geo.Point{
+		Latitude:  p.GetLatitude(),
+		Longitude: p.GetLongitude(),
+	}
+}
+
+func toBoundingBox(b *pbdata.BoundingBox) geo.BoundingBox {
+	return geo.BoundingBox{
+		SouthWest: toPoint(b.GetSouthWest()),
+		NorthEast: toPoint(b.GetNorthEast()),
+	}
+}
+
+func toPolygon(points []*pbdata.GeoPoint) geo.Polygon {
+	polygon := make(geo.Polygon, 0, len(points))
+	for _, p := range points {
+		polygon = append(polygon, toPoint(p))
+	}
+	return polygon
+}
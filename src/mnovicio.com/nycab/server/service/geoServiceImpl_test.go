@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+
+	persistence "mnovicio.com/nycab/server/data/persistence"
+)
+
+// newTestService returns a service of the yellow dataset without DB connection, for requests rejected before querying
+func newTestService() *NYCabServiceImpl {
+	return &NYCabServiceImpl{
+		dbContexts: map[pbdata.Dataset]*persistence.MySQLDBContext{
+			pbdata.Dataset_YELLOW: persistence.GetSQLDBContextInstance(nil, persistence.YellowDataset),
+		},
+	}
+}
+
+func TestCountTripsInAreaRejectedRequests(t *testing.T) {
+	triangle := []*pbdata.GeoPoint{
+		{Latitude: 40.70, Longitude: -74.02},
+		{Latitude: 40.75, Longitude: -74.02},
+		{Latitude: 40.75, Longitude: -73.97},
+	}
+
+	tests := []struct {
+		name      string
+		in        *pbsvc.CountTripsInAreaRequestV1
+		wantField string
+	}{
+		{
+			name:      "polygon over 7 days",
+			in:        &pbsvc.CountTripsInAreaRequestV1{Polygon: triangle, StartTime: "2013-12-01", EndTime: "2013-12-08 00:00:01"},
+			wantField: "end_time",
+		},
+		{
+			name:      "polygon over a month",
+			in:        &pbsvc.CountTripsInAreaRequestV1{Polygon: triangle, StartTime: "2013-01-01", EndTime: "2014-01-01"},
+			wantField: "end_time",
+		},
+		{
+			name:      "invalid polygon",
+			in:        &pbsvc.CountTripsInAreaRequestV1{Polygon: triangle[:2], StartTime: "2013-12-01", EndTime: "2013-12-02"},
+			wantField: "polygon",
+		},
+		{
+			name:      "missing area",
+			in:        &pbsvc.CountTripsInAreaRequestV1{StartTime: "2013-12-01", EndTime: "2013-12-02"},
+			wantField: "bounding_box",
+		},
+	}
+
+	s := newTestService()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := s.countTripsInArea(context.Background(), test.in)
+			reqErr, ok := err.(*requestError)
+			if !ok || reqErr.field != test.wantField {
+				t.Errorf("countTripsInArea() = %v, want an error of field %s", err, test.wantField)
+			}
+		})
+	}
+}
+
+func TestCountZoneTripsDateRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		endDate   string
+		wantError bool
+	}{
+		{"7 days", "2013-12-07", false},
+		{"8 days", "2013-12-08", true},
+		{"a year", "2014-11-30", true},
+	}
+
+	s := newTestService()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// unknown zones are rejected after the date range, without querying
+			in := &pbsvc.CountZoneTripsRequestV1{Zones: []string{"unknown"}, StartDate: "2013-12-01", EndDate: test.endDate}
+			_, err := s.countZoneTrips(context.Background(), in)
+			reqErr, ok := err.(*requestError)
+			if !ok {
+				t.Fatalf("countZoneTrips() = %v, want a request error", err)
+			}
+			if gotError := reqErr.field == "end_date"; gotError != test.wantError {
+				t.Errorf("countZoneTrips() = %v, date range rejected = %t, want %t", err, gotError, test.wantError)
+			}
+		})
+	}
+}
+
+func TestGetCabZoneCoverageCabIDs(t *testing.T) {
+	cabIDs := make([]string, 0, maxZoneCoverageCabs+1)
+	for i := 0; i <= maxZoneCoverageCabs; i++ {
+		cabIDs = append(cabIDs, fmt.Sprintf("cab%d", i))
+	}
+
+	tests := []struct {
+		name      string
+		cabIDs    []string
+		wantField string
+	}{
+		{"no cabs", nil, "cab_ids"},
+		{"too many cabs", cabIDs, "cab_ids"},
+		// duplicates count once, the request then fails on the missing taxi zones
+		{"duplicates", append(cabIDs[:maxZoneCoverageCabs:maxZoneCoverageCabs], cabIDs[0]), ""},
+	}
+
+	s := newTestService()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			in := &pbsvc.GetCabZoneCoverageRequestV1{CabIds: test.cabIDs, StartDate: "2013-12-01", EndDate: "2013-12-07"}
+			_, err := s.getCabZoneCoverage(context.Background(), in)
+			reqErr, ok := err.(*requestError)
+			if !ok || reqErr.field != test.wantField {
+				t.Errorf("getCabZoneCoverage() = %v, want an error of field [%s]", err, test.wantField)
+			}
+		})
+	}
+}
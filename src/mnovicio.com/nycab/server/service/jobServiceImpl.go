@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+
+	"mnovicio.com/nycab/server/jobs"
+)
+
+const (
+	// maxQueryJobs is the number of query jobs kept in memory, the oldest ended jobs are evicted first
+	maxQueryJobs = 100
+	// maxRunningQueryJobs is the number of query jobs running at once, other jobs wait for them to end
+	maxRunningQueryJobs = 4
+	// queryJobTimeout is the time a query job runs before it is aborted and fails
+	queryJobTimeout = time.Hour
+)
+
+// SubmitQueryJobV1 starts running a query in the background and returns its job
+func (s *NYCabServiceImpl) SubmitQueryJobV1(ctx context.Context, in *pbsvc.SubmitQueryJobRequestV1) (*pbsvc.SubmitQueryJobResponseV1, error) {
+	log.Println("SubmitQueryJobV1: request = ", in)
+	response, err := s.submitQueryJob(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.SubmitQueryJobResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) submitQueryJob(ctx context.Context, in *pbsvc.SubmitQueryJobRequestV1) (*pbsvc.SubmitQueryJobResponseV1, error) {
+	name, run, err := s.queryJobRun(in)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.jobs.Submit(name, run)
+	if err == jobs.ErrStoreFull {
+		return nil, resourceExhausted(err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbsvc.SubmitQueryJobResponseV1{
+		Job: toPBQueryJob(job),
+	}, nil
+}
+
+// GetQueryJobV1 returns the state and progress of a query job, and its result once it has succeeded
+func (s *NYCabServiceImpl) GetQueryJobV1(ctx context.Context, in *pbsvc.GetQueryJobRequestV1) (*pbsvc.GetQueryJobResponseV1, error) {
+	log.Println("GetQueryJobV1: request = ", in)
+	response, err := s.getQueryJob(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetQueryJobResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getQueryJob(ctx context.Context, in *pbsvc.GetQueryJobRequestV1) (*pbsvc.GetQueryJobResponseV1, error) {
+	job, found := s.jobs.Get(in.JobId)
+	if !found {
+		return nil, unknownJob(in.JobId)
+	}
+
+	return &pbsvc.GetQueryJobResponseV1{
+		Job: toPBQueryJob(job),
+	}, nil
+}
+
+// CancelQueryJobV1 cancels a pending or running query job, aborting its running DB queries
+func (s *NYCabServiceImpl) CancelQueryJobV1(ctx context.Context, in *pbsvc.CancelQueryJobRequestV1) (*pbsvc.CancelQueryJobResponseV1, error) {
+	log.Println("CancelQueryJobV1: request = ", in)
+	response, err := s.cancelQueryJob(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.CancelQueryJobResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) cancelQueryJob(ctx context.Context, in *pbsvc.CancelQueryJobRequestV1) (*pbsvc.CancelQueryJobResponseV1, error) {
+	job, found := s.jobs.Cancel(in.JobId)
+	if !found {
+		return nil, unknownJob(in.JobId)
+	}
+
+	return &pbsvc.CancelQueryJobResponseV1{
+		Job: toPBQueryJob(job),
+	}, nil
+}
+
+func unknownJob(jobID string) error {
+	return invalidField("job_id", fmt.Sprintf("unknown job [%s], ended jobs are evicted by newer jobs", jobID))
+}
+
+// queryJobRun returns the name of the query of a job request and the function running it
+// the query request is checked before the job is submitted, its errors are returned instead of failing the job
+func (s *NYCabServiceImpl) queryJobRun(in *pbsvc.SubmitQueryJobRequestV1) (string, jobs.RunFunc, error) {
+	switch query := in.Query.(type) {
+	case *pbsvc.SubmitQueryJobRequestV1_AllCabTrips:
+		run, err := s.allCabTripsQuery(query.AllCabTrips)
+		return "all_cab_trips", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_AllDriverTrips:
+		run, err := s.allDriverTripsQuery(query.AllDriverTrips)
+		return "all_driver_trips", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_BatchTripCounts:
+		run, err := s.batchTripCountsQuery(query.BatchTripCounts)
+		return "batch_trip_counts", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_PickupHeatmap:
+		run, err := s.pickupHeatmapQuery(query.PickupHeatmap)
+		return "pickup_heatmap", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_OriginDestinationMatrix:
+		run, err := s.originDestinationMatrixQuery(query.OriginDestinationMatrix)
+		return "origin_destination_matrix", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_CabUtilization:
+		run, err := s.cabUtilizationQuery(query.CabUtilization)
+		return "cab_utilization", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_TripPatterns:
+		run, err := s.tripPatternsQuery(query.TripPatterns)
+		return "trip_patterns", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_CountAnomalies:
+		run, err := s.countAnomaliesQuery(query.CountAnomalies)
+		return "count_anomalies", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_Forecast:
+		run, err := s.forecastQuery(query.Forecast)
+		return "forecast", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_PassengerCounts:
+		run, err := s.passengerCountsQuery(query.PassengerCounts)
+		return "passenger_counts", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_VendorStats:
+		run, err := s.vendorStatsQuery(query.VendorStats)
+		return "vendor_stats", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_TaxiZoneTripCounts:
+		run, err := s.taxiZoneTripCountsQuery(query.TaxiZoneTripCounts)
+		return "taxi_zone_trip_counts", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_CabZoneCoverage:
+		run, err := s.cabZoneCoverageQuery(query.CabZoneCoverage)
+		return "cab_zone_coverage", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_CabRevenue:
+		run, err := s.cabRevenueQuery(query.CabRevenue)
+		return "cab_revenue", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_TipRates:
+		run, err := s.tipRatesQuery(query.TipRates)
+		return "tip_rates", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	case *pbsvc.SubmitQueryJobRequestV1_PaymentTypeMix:
+		run, err := s.paymentTypeMixQuery(query.PaymentTypeMix)
+		return "payment_type_mix", func(ctx context.Context) (interface{}, error) {
+			return run(ctx)
+		}, err
+	default:
+		return "", nil, invalidField("query", "missing query")
+	}
+}
+
+// toPBQueryJob converts a job snapshot, setting the result of the query of succeeded jobs
+func toPBQueryJob(job jobs.Job) *pbsvc.QueryJob {
+	pbJob := &pbsvc.QueryJob{
+		JobId:      job.ID,
+		Query:      job.Name,
+		State:      pbsvc.QueryJobState(job.State),
+		Progress:   job.Progress,
+		SubmitTime: job.SubmitTime.Format(dateTimeFormat),
+		StartTime:  formatJobTime(job.StartTime),
+		EndTime:    formatJobTime(job.EndTime),
+	}
+	if job.State == jobs.Failed {
+		pbJob.Error = job.Err.Error()
+	}
+	if job.State != jobs.Succeeded {
+		return pbJob
+	}
+
+	switch result := job.Result.(type) {
+	case *pbsvc.GetAllCabTripsResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_AllCabTrips{AllCabTrips: result}
+	case *pbsvc.GetAllDriverTripsResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_AllDriverTrips{AllDriverTrips: result}
+	case *pbsvc.BatchGetTripCountsResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_BatchTripCounts{BatchTripCounts: result}
+	case *pbsvc.GetPickupHeatmapResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_PickupHeatmap{PickupHeatmap: result}
+	case *pbsvc.GetOriginDestinationMatrixResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_OriginDestinationMatrix{OriginDestinationMatrix: result}
+	case *pbsvc.GetCabUtilizationResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_CabUtilization{CabUtilization: result}
+	case *pbsvc.GetTripPatternsResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_TripPatterns{TripPatterns: result}
+	case *pbsvc.DetectCountAnomaliesResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_CountAnomalies{CountAnomalies: result}
+	case *pbsvc.ForecastTripsResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_Forecast{Forecast: result}
+	case *pbsvc.GetPassengerCountsResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_PassengerCounts{PassengerCounts: result}
+	case *pbsvc.GetVendorStatsResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_VendorStats{VendorStats: result}
+	case *pbsvc.GetTaxiZoneTripCountsResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_TaxiZoneTripCounts{TaxiZoneTripCounts: result}
+	case *pbsvc.GetCabZoneCoverageResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_CabZoneCoverage{CabZoneCoverage: result}
+	case *pbsvc.GetCabRevenueResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_CabRevenue{CabRevenue: result}
+	case *pbsvc.GetTipRatesResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_TipRates{TipRates: result}
+	case *pbsvc.GetPaymentTypeMixResponseV1:
+		pbJob.Result = &pbsvc.QueryJob_PaymentTypeMix{PaymentTypeMix: result}
+	}
+	return pbJob
+}
+
+// formatJobTime formats the start or end time of a job, empty if the job has not started or ended yet
+func formatJobTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(dateTimeFormat)
+}
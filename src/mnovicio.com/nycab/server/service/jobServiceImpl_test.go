@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func TestSubmitQueryJobRejectedRequests(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        *pbsvc.SubmitQueryJobRequestV1
+		wantField string
+	}{
+		{"missing query", &pbsvc.SubmitQueryJobRequestV1{}, "query"},
+		{
+			name: "invalid date range",
+			in: &pbsvc.SubmitQueryJobRequestV1{Query: &pbsvc.SubmitQueryJobRequestV1_TripPatterns{
+				TripPatterns: &pbsvc.GetTripPatternsRequestV1{StartDate: "2013-12-31", EndDate: "2013-12-01"},
+			}},
+			wantField: "end_date",
+		},
+		{
+			name: "invalid precision",
+			in: &pbsvc.SubmitQueryJobRequestV1{Query: &pbsvc.SubmitQueryJobRequestV1_PickupHeatmap{
+				PickupHeatmap: &pbsvc.GetPickupHeatmapRequestV1{StartTime: "2013-12-01", EndTime: "2013-12-02"},
+			}},
+			wantField: "precision",
+		},
+		{
+			name: "missing cab IDs",
+			in: &pbsvc.SubmitQueryJobRequestV1{Query: &pbsvc.SubmitQueryJobRequestV1_CabUtilization{
+				CabUtilization: &pbsvc.GetCabUtilizationRequestV1{StartDate: "2013-12-01", EndDate: "2013-12-07"},
+			}},
+			wantField: "cab_ids",
+		},
+	}
+
+	// the service has no job store, requests are rejected before submitting a job
+	s := newTestService()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := s.submitQueryJob(context.Background(), test.in)
+			reqErr, ok := err.(*requestError)
+			if !ok || reqErr.field != test.wantField {
+				t.Errorf("submitQueryJob() = %v, want an error of field %s", err, test.wantField)
+			}
+		})
+	}
+}
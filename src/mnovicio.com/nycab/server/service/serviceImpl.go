@@ -5,12 +5,19 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	pbdata "mnovicio.com/nycab/protocol/objects"
 	pbsvc "mnovicio.com/nycab/protocol/rpc"
 
 	persistence "mnovicio.com/nycab/server/data/persistence"
+	"mnovicio.com/nycab/server/geo"
+	"mnovicio.com/nycab/server/holidays"
+	"mnovicio.com/nycab/server/jobs"
+	"mnovicio.com/nycab/server/watch"
 )
 
 var (
@@ -20,60 +27,317 @@ var (
 
 // NYCabServiceImpl implements NYCabService
 type NYCabServiceImpl struct {
-	dbContext *persistence.MySQLDBContext
+	// dbContexts holds one DB context per dataset
+	dbContexts map[pbdata.Dataset]*persistence.MySQLDBContext
+	holidays   *holidays.Calendar
+	zones      []geo.Zone
+	// taxiZones is nil if no taxi zones are configured
+	taxiZones *geo.ZoneIndex
+	// jobs runs the queries submitted with SubmitQueryJobV1
+	jobs *jobs.Store
+	// exportDir is the directory ExportQueryV1 writes files in, empty if exports can only be streamed back
+	exportDir string
+	// watchers are notified when the trips of the cabs watched with WatchTripCountsV1 may have changed
+	watchers *watch.Hub
+	// detectImportsOnce starts detecting imported trips along with the first watch
+	detectImportsOnce sync.Once
 }
 
 // GetServiceInstance returns single instance of NYCabServiceImpl
-func GetServiceInstance(db *sql.DB) *NYCabServiceImpl {
+func GetServiceInstance(db *sql.DB, holidayCalendar *holidays.Calendar, zones []geo.Zone, taxiZones *geo.ZoneIndex, exportDir string) *NYCabServiceImpl {
 	serviceSyncOnce.Do(func() {
 		serviceInstance = &NYCabServiceImpl{
-			dbContext: persistence.GetSQLDBContextInstance(db),
+			dbContexts: make(map[pbdata.Dataset]*persistence.MySQLDBContext),
+			holidays:   holidayCalendar,
+			zones:      zones,
+			taxiZones:  taxiZones,
+			jobs:       jobs.NewStore(maxQueryJobs, maxRunningQueryJobs, queryJobTimeout),
+			exportDir:  exportDir,
+			watchers:   watch.NewHub(),
+		}
+		for value, name := range pbdata.Dataset_name {
+			if dataset, found := persistence.Datasets[strings.ToLower(name)]; found {
+				dbContext := persistence.GetSQLDBContextInstance(db, dataset)
+				dbContext.OnCacheRefreshed(serviceInstance.cacheRefreshed(pbdata.Dataset(value)))
+				serviceInstance.dbContexts[pbdata.Dataset(value)] = dbContext
+			}
 		}
 	})
 
 	return serviceInstance
 }
 
+// cacheRefreshed returns the function notifying the watchers of the cabs whose cached trip counts changed in the dataset
+func (s *NYCabServiceImpl) cacheRefreshed(dataset pbdata.Dataset) func(cabIDs []string) {
+	return func(cabIDs []string) {
+		s.watchers.Publish(watch.Event{
+			Dataset: dataset.String(),
+			CabIDs:  cabIDs,
+			Trigger: watch.CacheRefreshed,
+		})
+	}
+}
+
+// dbContext returns the DB context of the dataset, or an error if the dataset is unknown or lacks any of the columns
+func (s *NYCabServiceImpl) dbContext(dataset pbdata.Dataset, columns ...string) (*persistence.MySQLDBContext, error) {
+	dbContext, found := s.dbContexts[dataset]
+	if !found {
+		return nil, invalidField("dataset", fmt.Sprintf("unknown dataset [%s]", dataset))
+	}
+
+	if missing := dbContext.Dataset().Missing(columns...); len(missing) > 0 {
+		return nil, invalidField("dataset", fmt.Sprintf("dataset [%s] has no %v column", dbContext.Dataset().Name, missing))
+	}
+
+	return dbContext, nil
+}
+
+// withCabIDs adds the medallion column to the columns if the request filters by cab IDs
+func withCabIDs(cabIDs []string, columns ...string) []string {
+	if len(cabIDs) > 0 {
+		return append(columns, "medallion")
+	}
+	return columns
+}
+
+// uniqueIDs returns the IDs without duplicates, in the order they are first found
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
 // GetTripCountsForCabIDsV1 returns the total number of trips the cab has made based on pickup_datetime column with time ignored
 func (s *NYCabServiceImpl) GetTripCountsForCabIDsV1(ctx context.Context, in *pbsvc.GetTripCountsForCabIDsRequestV1) (*pbsvc.GetTripCountsForCabIDsResponseV1, error) {
 	log.Println("GetTripCountsForCabIDsV1: request = ", in)
-	// check date format
-	_, err := time.Parse("2006-01-02", in.PickupDate)
-	if err != nil {
-		errString := fmt.Sprintf("wrong file format for [%s], expecting 'YYYY-MM-DD'", in.PickupDate)
-		log.Println(errString)
+	response, err := s.getTripCountsForCabIDs(ctx, in)
+	if errString, handled := handledError(err); handled {
 		return &pbsvc.GetTripCountsForCabIDsResponseV1{
-			Error: fmt.Sprintf("%s. Error: %s", errString, err.Error()),
+			Error: errString,
 		}, nil
 	}
 
-	cabTrips, err := s.dbContext.GetTripCountsForCabsByPickupDate(in.CabIds, in.PickupDate, in.IgnoreCache)
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getTripCountsForCabIDs(ctx context.Context, in *pbsvc.GetTripCountsForCabIDsRequestV1) (*pbsvc.GetTripCountsForCabIDsResponseV1, error) {
+	dbContext, err := s.tripCountsDBContext(in)
+	if err != nil {
+		return nil, err
+	}
+
+	cabTrips, err := dbContext.GetTripCountsForCabsByPickupDate(ctx, in.CabIds, in.PickupDate, in.IgnoreCache)
 	if err != nil {
 		return &pbsvc.GetTripCountsForCabIDsResponseV1{}, err
 	}
 
+	s.filterHolidays(cabTrips.CabTrips, in.HolidayFilter)
+
 	return &pbsvc.GetTripCountsForCabIDsResponseV1{
 		CabTripsPerDay: cabTrips,
 	}, nil
 }
 
+// tripCountsDBContext checks a trip counts request and returns the DB context of its dataset
+func (s *NYCabServiceImpl) tripCountsDBContext(in *pbsvc.GetTripCountsForCabIDsRequestV1) (*persistence.MySQLDBContext, error) {
+	dbContext, err := s.dbContext(in.Dataset, "medallion")
+	if err != nil {
+		return nil, err
+	}
+
+	// check date format
+	if err := checkDateFormat("pickup_date", in.PickupDate); err != nil {
+		return nil, err
+	}
+
+	return dbContext, nil
+}
+
+// checkDateFormat returns an error on the request field if date is not in 'YYYY-MM-DD' format
+func checkDateFormat(field, date string) error {
+	_, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return invalidField(field, fmt.Sprintf("wrong file format for [%s], expecting 'YYYY-MM-DD'. Error: %s", date, err.Error()))
+	}
+
+	return nil
+}
+
+// parseTimeRange parses the start and end time request fields in 'YYYY-MM-DD HH:MM:SS' or 'YYYY-MM-DD' format
+// returns an error if either is malformed or end is not after start
+func parseTimeRange(startField, start, endField, end string) (time.Time, time.Time, error) {
+	startTime, err := parseDateTime(start)
+	if err != nil {
+		return time.Time{}, time.Time{}, invalidField(startField, err.Error())
+	}
+
+	endTime, err := parseDateTime(end)
+	if err != nil {
+		return time.Time{}, time.Time{}, invalidField(endField, err.Error())
+	}
+
+	if !endTime.After(startTime) {
+		return time.Time{}, time.Time{}, invalidField(endField, fmt.Sprintf("end time [%s] must be after start time [%s]", end, start))
+	}
+
+	return startTime, endTime, nil
+}
+
+// maxDateRangeDays is the longest date range accepted by parseDateRange
+const maxDateRangeDays = 366
+
+// parseDateRange parses the start and end date request fields in 'YYYY-MM-DD' format, both inclusive
+// returns an error if either is malformed, end is before start or the range exceeds maxDateRangeDays
+func parseDateRange(startField, start, endField, end string) (time.Time, time.Time, error) {
+	if err := checkDateFormat(startField, start); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if err := checkDateFormat(endField, end); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	startDate, _ := time.Parse("2006-01-02", start)
+	endDate, _ := time.Parse("2006-01-02", end)
+	if endDate.Before(startDate) {
+		return time.Time{}, time.Time{}, invalidField(endField, fmt.Sprintf("end date [%s] must not be before start date [%s]", end, start))
+	}
+
+	if endDate.Sub(startDate).Hours()/24 >= maxDateRangeDays {
+		return time.Time{}, time.Time{}, invalidField(endField, fmt.Sprintf("date range [%s, %s] exceeds %d days", start, end, maxDateRangeDays))
+	}
+
+	return startDate, endDate, nil
+}
+
+func parseDateTime(value string) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("wrong date/time format for [%s], expecting 'YYYY-MM-DD HH:MM:SS' or 'YYYY-MM-DD'", value)
+}
+
 // GetAllCabTripCountPerDayV1 returns number of trips per day on record for each cab
 func (s *NYCabServiceImpl) GetAllCabTripCountPerDayV1(ctx context.Context, in *pbsvc.GetAllCabTripsRequestV1) (*pbsvc.GetAllCabTripsResponseV1, error) {
 	log.Println("GetAllCabTripCountPerDayV1: request = ", in)
-	cabTrips, err := s.dbContext.GetAllCabTrips(in.IgnoreCache)
+	response, err := s.getAllCabTripCountPerDay(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetAllCabTripsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getAllCabTripCountPerDay(ctx context.Context, in *pbsvc.GetAllCabTripsRequestV1) (*pbsvc.GetAllCabTripsResponseV1, error) {
+	run, err := s.allCabTripsQuery(in)
 	if err != nil {
-		return &pbsvc.GetAllCabTripsResponseV1{}, err
+		return nil, err
 	}
 
-	return &pbsvc.GetAllCabTripsResponseV1{
-		CabTripsPerDay: cabTrips,
+	return run(ctx)
+}
+
+// allCabTripsQuery checks an all cab trips request and returns the function running its query
+func (s *NYCabServiceImpl) allCabTripsQuery(in *pbsvc.GetAllCabTripsRequestV1) (func(ctx context.Context) (*pbsvc.GetAllCabTripsResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, "medallion")
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetAllCabTripsResponseV1, error) {
+		cabTrips, err := dbContext.GetAllCabTrips(ctx, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetAllCabTripsResponseV1{}, err
+		}
+
+		s.filterHolidays(cabTrips.CabTrips, in.HolidayFilter)
+
+		return &pbsvc.GetAllCabTripsResponseV1{
+			CabTripsPerDay: cabTrips,
+		}, nil
 	}, nil
 }
 
-// ClearCacheV1 clears the cache
+// ClearCacheV1 clears the cache of the dataset
 func (s *NYCabServiceImpl) ClearCacheV1(ctx context.Context, in *pbsvc.ClearCacheRequestV1) (*pbsvc.ClearCacheResponseV1, error) {
-	cleared, err := s.dbContext.ClearCache()
+	response, err := s.clearCache(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.ClearCacheResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) clearCache(ctx context.Context, in *pbsvc.ClearCacheRequestV1) (*pbsvc.ClearCacheResponseV1, error) {
+	dbContext, err := s.dbContext(in.Dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	cleared, err := dbContext.ClearCache()
+	if cleared {
+		s.watchers.Publish(watch.Event{
+			Dataset: in.Dataset.String(),
+			Trigger: watch.CacheCleared,
+		})
+	}
 	return &pbsvc.ClearCacheResponseV1{
 		CacheCleared: cleared,
 	}, err
 }
+
+// sortedIDs returns the cab IDs (or driver IDs) of the trips per day in ascending order
+func sortedIDs(tripsPerDay map[string]*pbdata.TripsPerDay) []string {
+	ids := make([]string, 0, len(tripsPerDay))
+	for id := range tripsPerDay {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// sortedDates returns the dates of the trip counts in ascending order
+func sortedDates(tripCounts map[string]uint32) []string {
+	dates := make([]string, 0, len(tripCounts))
+	for date := range tripCounts {
+		dates = append(dates, date)
+	}
+	sort.Strings(dates)
+	return dates
+}
+
+// filterHolidays tags or removes the holiday dates of the trips per day according to the holiday filter
+func (s *NYCabServiceImpl) filterHolidays(tripsPerDay map[string]*pbdata.TripsPerDay, filter pbdata.HolidayFilter) {
+	if filter == pbdata.HolidayFilter_INCLUDE_HOLIDAYS {
+		return
+	}
+
+	for _, trips := range tripsPerDay {
+		if filter == pbdata.HolidayFilter_TAG_HOLIDAYS {
+			trips.IsHoliday = make(map[string]bool, len(trips.TripsPerDay))
+		}
+		for date := range trips.TripsPerDay {
+			isHoliday := s.holidays.IsHoliday(date)
+			switch filter {
+			case pbdata.HolidayFilter_TAG_HOLIDAYS:
+				trips.IsHoliday[date] = isHoliday
+			case pbdata.HolidayFilter_EXCLUDE_HOLIDAYS:
+				if isHoliday {
+					delete(trips.TripsPerDay, date)
+				}
+			}
+		}
+	}
+}
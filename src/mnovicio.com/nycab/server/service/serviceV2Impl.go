@@ -0,0 +1,440 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+// V2 RPCs share the implementation of the V1 RPCs, V2 messages are wire compatible with their V1 counterparts
+// except for the daily trip counts, which are converted from the V1 maps
+// request errors are returned as status errors instead of being set in the response
+
+// runV2 runs a V2 RPC with the implementation of its V1 counterpart
+// in is converted into requestV1, call runs the V1 implementation on it, and its response is converted into responseV2
+// convert converts the V1 response into responseV2 when the messages are not wire compatible, nil to copy it
+func runV2(ctx context.Context, in, requestV1, responseV2 proto.Message, call func(ctx context.Context) (proto.Message, error), convert func(responseV1 proto.Message) error) error {
+	if err := convertMessage(in, requestV1, "field_mask"); err != nil {
+		return err
+	}
+
+	var fieldMaskPaths *field_mask.FieldMask
+	if masked, ok := in.(interface{ GetFieldMask() *field_mask.FieldMask }); ok {
+		fieldMaskPaths = masked.GetFieldMask()
+	}
+	ctx, mask, err := withFieldMask(ctx, fieldMaskPaths, responseV2)
+	if err != nil {
+		return statusError(err)
+	}
+
+	responseV1, err := call(ctx)
+	if err != nil {
+		return statusError(err)
+	}
+
+	if convert != nil {
+		err = convert(responseV1)
+	} else {
+		err = convertMessage(responseV1, responseV2, "error")
+	}
+	if err != nil {
+		return err
+	}
+
+	mask.prune(responseV2)
+	return nil
+}
+
+// protoDate converts a date in 'YYYY-MM-DD' format to a google.type.Date
+func protoDate(value string) (*date.Date, error) {
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse date [%s]: %v", value, err)
+	}
+
+	return &date.Date{
+		Year:  int32(t.Year()),
+		Month: int32(t.Month()),
+		Day:   int32(t.Day()),
+	}, nil
+}
+
+// cabTripCounts flattens the trips per day of each cab to trip counts ordered by cab ID and date
+func cabTripCounts(cabTrips map[string]*pbdata.TripsPerDay) ([]*pbdata.CabTripCount, error) {
+	counts := []*pbdata.CabTripCount{}
+	for _, cabID := range sortedIDs(cabTrips) {
+		trips := cabTrips[cabID]
+		for _, day := range sortedDates(trips.TripsPerDay) {
+			pickupDate, err := protoDate(day)
+			if err != nil {
+				return nil, err
+			}
+
+			counts = append(counts, &pbdata.CabTripCount{
+				CabId:     cabID,
+				Date:      pickupDate,
+				TripCount: trips.TripsPerDay[day],
+				IsHoliday: trips.IsHoliday[day],
+			})
+		}
+	}
+	return counts, nil
+}
+
+// driverTripCounts flattens the trips per day of each driver to trip counts ordered by hack license and date
+func driverTripCounts(driverTrips map[string]*pbdata.TripsPerDay) ([]*pbdata.DriverTripCount, error) {
+	counts := []*pbdata.DriverTripCount{}
+	for _, hackLicense := range sortedIDs(driverTrips) {
+		trips := driverTrips[hackLicense]
+		for _, day := range sortedDates(trips.TripsPerDay) {
+			pickupDate, err := protoDate(day)
+			if err != nil {
+				return nil, err
+			}
+
+			counts = append(counts, &pbdata.DriverTripCount{
+				HackLicense: hackLicense,
+				Date:        pickupDate,
+				TripCount:   trips.TripsPerDay[day],
+				IsHoliday:   trips.IsHoliday[day],
+			})
+		}
+	}
+	return counts, nil
+}
+
+// GetTripCountsForCabIDsV2 returns the total number of trips the cab has made based on pickup_datetime column with time ignored
+func (s *NYCabServiceImpl) GetTripCountsForCabIDsV2(ctx context.Context, in *pbsvc.GetTripCountsForCabIDsRequestV2) (*pbsvc.GetTripCountsForCabIDsResponseV2, error) {
+	log.Println("GetTripCountsForCabIDsV2: request = ", in)
+	request, response := &pbsvc.GetTripCountsForCabIDsRequestV1{}, &pbsvc.GetTripCountsForCabIDsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getTripCountsForCabIDs(ctx, request)
+	}, func(responseV1 proto.Message) error {
+		counts, err := cabTripCounts(responseV1.(*pbsvc.GetTripCountsForCabIDsResponseV1).CabTripsPerDay.GetCabTrips())
+		response.CabTripCounts = counts
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetAllCabTripCountPerDayV2 returns number of trips per day on record for each cab
+func (s *NYCabServiceImpl) GetAllCabTripCountPerDayV2(ctx context.Context, in *pbsvc.GetAllCabTripsRequestV2) (*pbsvc.GetAllCabTripsResponseV2, error) {
+	log.Println("GetAllCabTripCountPerDayV2: request = ", in)
+	request, response := &pbsvc.GetAllCabTripsRequestV1{}, &pbsvc.GetAllCabTripsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getAllCabTripCountPerDay(ctx, request)
+	}, func(responseV1 proto.Message) error {
+		counts, err := cabTripCounts(responseV1.(*pbsvc.GetAllCabTripsResponseV1).CabTripsPerDay.GetCabTrips())
+		response.CabTripCounts = counts
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ClearCacheV2 clears the cache of the dataset
+func (s *NYCabServiceImpl) ClearCacheV2(ctx context.Context, in *pbsvc.ClearCacheRequestV2) (*pbsvc.ClearCacheResponseV2, error) {
+	log.Println("ClearCacheV2: request = ", in)
+	request, response := &pbsvc.ClearCacheRequestV1{}, &pbsvc.ClearCacheResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.clearCache(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetTripCountsForHackLicensesV2 returns the total number of trips the driver has made based on pickup_datetime column with time ignored
+func (s *NYCabServiceImpl) GetTripCountsForHackLicensesV2(ctx context.Context, in *pbsvc.GetTripCountsForHackLicensesRequestV2) (*pbsvc.GetTripCountsForHackLicensesResponseV2, error) {
+	log.Println("GetTripCountsForHackLicensesV2: request = ", in)
+	request, response := &pbsvc.GetTripCountsForHackLicensesRequestV1{}, &pbsvc.GetTripCountsForHackLicensesResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getTripCountsForHackLicenses(ctx, request)
+	}, func(responseV1 proto.Message) error {
+		counts, err := driverTripCounts(responseV1.(*pbsvc.GetTripCountsForHackLicensesResponseV1).DriverTripsPerDay.GetDriverTrips())
+		response.DriverTripCounts = counts
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetAllDriverTripCountPerDayV2 returns number of trips per day on record for each driver
+func (s *NYCabServiceImpl) GetAllDriverTripCountPerDayV2(ctx context.Context, in *pbsvc.GetAllDriverTripsRequestV2) (*pbsvc.GetAllDriverTripsResponseV2, error) {
+	log.Println("GetAllDriverTripCountPerDayV2: request = ", in)
+	request, response := &pbsvc.GetAllDriverTripsRequestV1{}, &pbsvc.GetAllDriverTripsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getAllDriverTripCountPerDay(ctx, request)
+	}, func(responseV1 proto.Message) error {
+		counts, err := driverTripCounts(responseV1.(*pbsvc.GetAllDriverTripsResponseV1).DriverTripsPerDay.GetDriverTrips())
+		response.DriverTripCounts = counts
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetCabDriverMappingV2 returns which drivers drove which cabs on a given pickup date and vice versa
+func (s *NYCabServiceImpl) GetCabDriverMappingV2(ctx context.Context, in *pbsvc.GetCabDriverMappingRequestV2) (*pbsvc.GetCabDriverMappingResponseV2, error) {
+	log.Println("GetCabDriverMappingV2: request = ", in)
+	request, response := &pbsvc.GetCabDriverMappingRequestV1{}, &pbsvc.GetCabDriverMappingResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getCabDriverMapping(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetCabShiftsV2 reconstructs the shifts of a cab on a given pickup date from its trips ordered by pickup_datetime
+func (s *NYCabServiceImpl) GetCabShiftsV2(ctx context.Context, in *pbsvc.GetCabShiftsRequestV2) (*pbsvc.GetCabShiftsResponseV2, error) {
+	log.Println("GetCabShiftsV2: request = ", in)
+	request, response := &pbsvc.GetCabShiftsRequestV1{}, &pbsvc.GetCabShiftsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getCabShifts(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// FindTripAnomaliesV2 returns the overlapping, zero duration and implausible speed trips of the cabs within a time range
+func (s *NYCabServiceImpl) FindTripAnomaliesV2(ctx context.Context, in *pbsvc.FindTripAnomaliesRequestV2) (*pbsvc.FindTripAnomaliesResponseV2, error) {
+	log.Println("FindTripAnomaliesV2: request = ", in)
+	request, response := &pbsvc.FindTripAnomaliesRequestV1{}, &pbsvc.FindTripAnomaliesResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.findTripAnomalies(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ListTripsV2 returns a page of the trips of a cab within a time range, ordered by pickup_datetime
+func (s *NYCabServiceImpl) ListTripsV2(ctx context.Context, in *pbsvc.ListTripsRequestV2) (*pbsvc.ListTripsResponseV2, error) {
+	log.Println("ListTripsV2: request = ", in)
+	request, response := &pbsvc.ListTripsRequestV1{}, &pbsvc.ListTripsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.listTrips(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetPassengerCountsV2 returns the distribution of passenger counts of the whole fleet and of each cab over a date range
+func (s *NYCabServiceImpl) GetPassengerCountsV2(ctx context.Context, in *pbsvc.GetPassengerCountsRequestV2) (*pbsvc.GetPassengerCountsResponseV2, error) {
+	log.Println("GetPassengerCountsV2: request = ", in)
+	request, response := &pbsvc.GetPassengerCountsRequestV1{}, &pbsvc.GetPassengerCountsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getPassengerCounts(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CountTripsInAreaV2 returns the number of trips picked up inside a bounding box or polygon within a time range
+func (s *NYCabServiceImpl) CountTripsInAreaV2(ctx context.Context, in *pbsvc.CountTripsInAreaRequestV2) (*pbsvc.CountTripsInAreaResponseV2, error) {
+	log.Println("CountTripsInAreaV2: request = ", in)
+	request, response := &pbsvc.CountTripsInAreaRequestV1{}, &pbsvc.CountTripsInAreaResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.countTripsInArea(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetPickupHeatmapV2 returns the number of trips picked up in each geohash cell within a time range
+func (s *NYCabServiceImpl) GetPickupHeatmapV2(ctx context.Context, in *pbsvc.GetPickupHeatmapRequestV2) (*pbsvc.GetPickupHeatmapResponseV2, error) {
+	log.Println("GetPickupHeatmapV2: request = ", in)
+	request, response := &pbsvc.GetPickupHeatmapRequestV1{}, &pbsvc.GetPickupHeatmapResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getPickupHeatmap(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetOriginDestinationMatrixV2 returns the number of trips and average trip duration between pickup and dropoff cells within a time range
+func (s *NYCabServiceImpl) GetOriginDestinationMatrixV2(ctx context.Context, in *pbsvc.GetOriginDestinationMatrixRequestV2) (*pbsvc.GetOriginDestinationMatrixResponseV2, error) {
+	log.Println("GetOriginDestinationMatrixV2: request = ", in)
+	request, response := &pbsvc.GetOriginDestinationMatrixRequestV1{}, &pbsvc.GetOriginDestinationMatrixResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getOriginDestinationMatrix(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// CountZoneTripsV2 returns the number of trips of each cab starting or ending in each named zone per day
+func (s *NYCabServiceImpl) CountZoneTripsV2(ctx context.Context, in *pbsvc.CountZoneTripsRequestV2) (*pbsvc.CountZoneTripsResponseV2, error) {
+	log.Println("CountZoneTripsV2: request = ", in)
+	request, response := &pbsvc.CountZoneTripsRequestV1{}, &pbsvc.CountZoneTripsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.countZoneTrips(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetTaxiZoneTripCountsV2 returns the number of trips starting and ending in each TLC taxi zone per day
+func (s *NYCabServiceImpl) GetTaxiZoneTripCountsV2(ctx context.Context, in *pbsvc.GetTaxiZoneTripCountsRequestV2) (*pbsvc.GetTaxiZoneTripCountsResponseV2, error) {
+	log.Println("GetTaxiZoneTripCountsV2: request = ", in)
+	request, response := &pbsvc.GetTaxiZoneTripCountsRequestV1{}, &pbsvc.GetTaxiZoneTripCountsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getTaxiZoneTripCounts(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetCabZoneCoverageV2 returns the TLC taxi zones each cab picked up or dropped off passengers in
+func (s *NYCabServiceImpl) GetCabZoneCoverageV2(ctx context.Context, in *pbsvc.GetCabZoneCoverageRequestV2) (*pbsvc.GetCabZoneCoverageResponseV2, error) {
+	log.Println("GetCabZoneCoverageV2: request = ", in)
+	request, response := &pbsvc.GetCabZoneCoverageRequestV1{}, &pbsvc.GetCabZoneCoverageResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getCabZoneCoverage(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetCabUtilizationV2 returns how much of its active window each cab spent with a passenger on each day of a date range
+func (s *NYCabServiceImpl) GetCabUtilizationV2(ctx context.Context, in *pbsvc.GetCabUtilizationRequestV2) (*pbsvc.GetCabUtilizationResponseV2, error) {
+	log.Println("GetCabUtilizationV2: request = ", in)
+	request, response := &pbsvc.GetCabUtilizationRequestV1{}, &pbsvc.GetCabUtilizationResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getCabUtilization(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetTripPatternsV2 returns the daily trip counts of each cab (or the whole fleet) aggregated by day of the week and by month
+func (s *NYCabServiceImpl) GetTripPatternsV2(ctx context.Context, in *pbsvc.GetTripPatternsRequestV2) (*pbsvc.GetTripPatternsResponseV2, error) {
+	log.Println("GetTripPatternsV2: request = ", in)
+	request, response := &pbsvc.GetTripPatternsRequestV1{}, &pbsvc.GetTripPatternsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getTripPatterns(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DetectCountAnomaliesV2 returns the days on which the trip count of each cab (or the whole fleet) deviates strongly from the baseline of its prior days
+func (s *NYCabServiceImpl) DetectCountAnomaliesV2(ctx context.Context, in *pbsvc.DetectCountAnomaliesRequestV2) (*pbsvc.DetectCountAnomaliesResponseV2, error) {
+	log.Println("DetectCountAnomaliesV2: request = ", in)
+	request, response := &pbsvc.DetectCountAnomaliesRequestV1{}, &pbsvc.DetectCountAnomaliesResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.detectCountAnomalies(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ForecastTripsV2 returns the expected trip counts of each cab (or the whole fleet) for the days following the history
+func (s *NYCabServiceImpl) ForecastTripsV2(ctx context.Context, in *pbsvc.ForecastTripsRequestV2) (*pbsvc.ForecastTripsResponseV2, error) {
+	log.Println("ForecastTripsV2: request = ", in)
+	request, response := &pbsvc.ForecastTripsRequestV1{}, &pbsvc.ForecastTripsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.forecastTrips(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetVendorStatsV2 returns the trip counts, average distance and anomaly rates of each vendor over a date range
+func (s *NYCabServiceImpl) GetVendorStatsV2(ctx context.Context, in *pbsvc.GetVendorStatsRequestV2) (*pbsvc.GetVendorStatsResponseV2, error) {
+	log.Println("GetVendorStatsV2: request = ", in)
+	request, response := &pbsvc.GetVendorStatsRequestV1{}, &pbsvc.GetVendorStatsResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getVendorStats(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetCabRevenueV2 returns the fares, tips and tolls collected by the cabs on each day of a date range
+func (s *NYCabServiceImpl) GetCabRevenueV2(ctx context.Context, in *pbsvc.GetCabRevenueRequestV2) (*pbsvc.GetCabRevenueResponseV2, error) {
+	log.Println("GetCabRevenueV2: request = ", in)
+	request, response := &pbsvc.GetCabRevenueRequestV1{}, &pbsvc.GetCabRevenueResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getCabRevenue(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetTipRatesV2 returns the tip rate of the trips paid by card of the whole fleet and of each cab over a date range
+func (s *NYCabServiceImpl) GetTipRatesV2(ctx context.Context, in *pbsvc.GetTipRatesRequestV2) (*pbsvc.GetTipRatesResponseV2, error) {
+	log.Println("GetTipRatesV2: request = ", in)
+	request, response := &pbsvc.GetTipRatesRequestV1{}, &pbsvc.GetTipRatesResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getTipRates(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetPaymentTypeMixV2 returns the number and share of trips per payment type of the whole fleet and of each cab over a date range
+func (s *NYCabServiceImpl) GetPaymentTypeMixV2(ctx context.Context, in *pbsvc.GetPaymentTypeMixRequestV2) (*pbsvc.GetPaymentTypeMixResponseV2, error) {
+	log.Println("GetPaymentTypeMixV2: request = ", in)
+	request, response := &pbsvc.GetPaymentTypeMixRequestV1{}, &pbsvc.GetPaymentTypeMixResponseV2{}
+	err := runV2(ctx, in, request, response, func(ctx context.Context) (proto.Message, error) {
+		return s.getPaymentTypeMix(ctx, request)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+)
+
+func TestProtoDate(t *testing.T) {
+	tests := []struct {
+		value     string
+		want      *date.Date
+		wantError bool
+	}{
+		{"2013-12-01", &date.Date{Year: 2013, Month: 12, Day: 1}, false},
+		{"2016-02-29", &date.Date{Year: 2016, Month: 2, Day: 29}, false},
+		{"2013-02-29", nil, true},
+		{"2013-12-01 10:00:00", nil, true},
+		{"", nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			got, err := protoDate(test.value)
+			if (err != nil) != test.wantError || !reflect.DeepEqual(got, test.want) {
+				t.Errorf("protoDate(%s) = %v, %v, want %v, error %t", test.value, got, err, test.want, test.wantError)
+			}
+		})
+	}
+}
+
+func TestCabTripCounts(t *testing.T) {
+	cabTrips := map[string]*pbdata.TripsPerDay{
+		"B": {TripsPerDay: map[string]uint32{"2013-12-02": 4, "2013-12-01": 0}},
+		"A": {
+			TripsPerDay: map[string]uint32{"2013-12-25": 2, "2013-12-24": 7},
+			IsHoliday:   map[string]bool{"2013-12-25": true},
+		},
+	}
+
+	counts, err := cabTripCounts(cabTrips)
+	if err != nil {
+		t.Fatalf("cabTripCounts() = %v", err)
+	}
+
+	// ordered by cab ID and date, days without trips included
+	want := []*pbdata.CabTripCount{
+		{CabId: "A", Date: &date.Date{Year: 2013, Month: 12, Day: 24}, TripCount: 7},
+		{CabId: "A", Date: &date.Date{Year: 2013, Month: 12, Day: 25}, TripCount: 2, IsHoliday: true},
+		{CabId: "B", Date: &date.Date{Year: 2013, Month: 12, Day: 1}},
+		{CabId: "B", Date: &date.Date{Year: 2013, Month: 12, Day: 2}, TripCount: 4},
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("cabTripCounts() = %v, want %v", counts, want)
+	}
+
+	if counts, err := cabTripCounts(nil); err != nil || len(counts) != 0 || counts == nil {
+		t.Errorf("cabTripCounts(nil) = %v, %v, want an empty list", counts, err)
+	}
+	if _, err := cabTripCounts(map[string]*pbdata.TripsPerDay{"A": {TripsPerDay: map[string]uint32{"12/01/2013": 1}}}); err == nil {
+		t.Error("cabTripCounts() of a malformed date succeeded")
+	}
+}
+
+func TestDriverTripCounts(t *testing.T) {
+	driverTrips := map[string]*pbdata.TripsPerDay{
+		"H2": {TripsPerDay: map[string]uint32{"2014-01-01": 3}, IsHoliday: map[string]bool{"2014-01-01": true}},
+		"H1": {TripsPerDay: map[string]uint32{"2013-12-31": 5, "2013-12-30": 1}},
+	}
+
+	counts, err := driverTripCounts(driverTrips)
+	if err != nil {
+		t.Fatalf("driverTripCounts() = %v", err)
+	}
+
+	want := []*pbdata.DriverTripCount{
+		{HackLicense: "H1", Date: &date.Date{Year: 2013, Month: 12, Day: 30}, TripCount: 1},
+		{HackLicense: "H1", Date: &date.Date{Year: 2013, Month: 12, Day: 31}, TripCount: 5},
+		{HackLicense: "H2", Date: &date.Date{Year: 2014, Month: 1, Day: 1}, TripCount: 3, IsHoliday: true},
+	}
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("driverTripCounts() = %v, want %v", counts, want)
+	}
+}
+
+func TestGetTripCountsForCabIDsV2InvalidPickupDate(t *testing.T) {
+	s := newTestService()
+	in := &pbsvc.GetTripCountsForCabIDsRequestV2{CabIds: []string{"A"}, PickupDate: "12/01/2013"}
+
+	// request errors are returned as status errors naming the field
+	_, err := s.GetTripCountsForCabIDsV2(context.Background(), in)
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("GetTripCountsForCabIDsV2() = %v, want an invalid argument status", err)
+	}
+	var field string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok && len(badRequest.FieldViolations) > 0 {
+			field = badRequest.FieldViolations[0].Field
+		}
+	}
+	if field != "pickup_date" {
+		t.Errorf("GetTripCountsForCabIDsV2() field violation = [%s], want [pickup_date]", field)
+	}
+}
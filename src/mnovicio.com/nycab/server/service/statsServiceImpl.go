@@ -0,0 +1,442 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+
+	"mnovicio.com/nycab/server/analytics"
+	"mnovicio.com/nycab/server/jobs"
+)
+
+const (
+	defaultBaselineWindowDays = 28
+	maxBaselineWindowDays     = 90
+	defaultAnomalyThreshold   = 3
+	defaultForecastDays       = 7
+	maxForecastDays           = 28
+	defaultPredictionLevel    = 0.95
+)
+
+// GetCabUtilizationV1 returns how much of its active window each cab spent with a passenger on each day of a date range
+func (s *NYCabServiceImpl) GetCabUtilizationV1(ctx context.Context, in *pbsvc.GetCabUtilizationRequestV1) (*pbsvc.GetCabUtilizationResponseV1, error) {
+	log.Println("GetCabUtilizationV1: request = ", in)
+	response, err := s.getCabUtilization(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetCabUtilizationResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getCabUtilization(ctx context.Context, in *pbsvc.GetCabUtilizationRequestV1) (*pbsvc.GetCabUtilizationResponseV1, error) {
+	run, err := s.cabUtilizationQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// cabUtilizationQuery checks a cab utilization request and returns the function running its query
+func (s *NYCabServiceImpl) cabUtilizationQuery(in *pbsvc.GetCabUtilizationRequestV1) (func(ctx context.Context) (*pbsvc.GetCabUtilizationResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, "medallion", "dropoff_datetime")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.CabIds) == 0 {
+		return nil, invalidField("cab_ids", "empty cab ID list")
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", in.StartDate, "end_date", in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetCabUtilizationResponseV1, error) {
+		utilization, err := dbContext.GetCabUtilization(ctx, in.CabIds, startDate, endDate, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetCabUtilizationResponseV1{}, err
+		}
+
+		// cached entries are shared, tag copies of them
+		filtered := make([]*pbdata.CabUtilization, 0, len(utilization))
+		for _, u := range utilization {
+			isHoliday := s.holidays.IsHoliday(u.Date)
+			switch in.HolidayFilter {
+			case pbdata.HolidayFilter_TAG_HOLIDAYS:
+				u = proto.Clone(u).(*pbdata.CabUtilization)
+				u.IsHoliday = isHoliday
+			case pbdata.HolidayFilter_EXCLUDE_HOLIDAYS:
+				if isHoliday {
+					continue
+				}
+			}
+			filtered = append(filtered, u)
+		}
+
+		return &pbsvc.GetCabUtilizationResponseV1{
+			Utilization: filtered,
+		}, nil
+	}, nil
+}
+
+// GetTripPatternsV1 returns the daily trip counts of each cab (or the whole fleet) aggregated by day of the week and by month
+func (s *NYCabServiceImpl) GetTripPatternsV1(ctx context.Context, in *pbsvc.GetTripPatternsRequestV1) (*pbsvc.GetTripPatternsResponseV1, error) {
+	log.Println("GetTripPatternsV1: request = ", in)
+	response, err := s.getTripPatterns(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetTripPatternsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getTripPatterns(ctx context.Context, in *pbsvc.GetTripPatternsRequestV1) (*pbsvc.GetTripPatternsResponseV1, error) {
+	run, err := s.tripPatternsQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// tripPatternsQuery checks a trip patterns request and returns the function running its query
+func (s *NYCabServiceImpl) tripPatternsQuery(in *pbsvc.GetTripPatternsRequestV1) (func(ctx context.Context) (*pbsvc.GetTripPatternsResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, withCabIDs(in.CabIds)...)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", in.StartDate, "end_date", in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetTripPatternsResponseV1, error) {
+		cabTripsPerDay, err := dbContext.GetDailyTripCounts(ctx, in.CabIds, startDate, endDate, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetTripPatternsResponseV1{}, err
+		}
+
+		response := &pbsvc.GetTripPatternsResponseV1{
+			Patterns: []*pbdata.TripPatterns{},
+		}
+		for _, cabID := range sortedIDs(cabTripsPerDay.CabTrips) {
+			counts := toDailyCounts(cabTripsPerDay.CabTrips[cabID])
+			if in.HolidayFilter == pbdata.HolidayFilter_EXCLUDE_HOLIDAYS {
+				counts = s.excludeHolidays(counts)
+			}
+
+			patterns := &pbdata.TripPatterns{
+				CabId: cabID,
+			}
+
+			byWeekday := analytics.ByWeekday(counts)
+			for i := range byWeekday {
+				// Monday first
+				weekday := time.Weekday((i + 1) % 7)
+				patterns.ByDayOfWeek = append(patterns.ByDayOfWeek, toPBTripCountSummary(weekday.String(), byWeekday[weekday]))
+			}
+
+			byMonth := analytics.ByMonth(counts)
+			months := make([]string, 0, len(byMonth))
+			for month := range byMonth {
+				months = append(months, month)
+			}
+			sort.Strings(months)
+			for _, month := range months {
+				patterns.ByMonth = append(patterns.ByMonth, toPBTripCountSummary(month, byMonth[month]))
+			}
+
+			response.Patterns = append(response.Patterns, patterns)
+		}
+
+		return response, nil
+	}, nil
+}
+
+// DetectCountAnomaliesV1 returns the days on which the trip count of each cab (or the whole fleet) deviates strongly from the baseline of its prior days
+func (s *NYCabServiceImpl) DetectCountAnomaliesV1(ctx context.Context, in *pbsvc.DetectCountAnomaliesRequestV1) (*pbsvc.DetectCountAnomaliesResponseV1, error) {
+	log.Println("DetectCountAnomaliesV1: request = ", in)
+	response, err := s.detectCountAnomalies(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.DetectCountAnomaliesResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) detectCountAnomalies(ctx context.Context, in *pbsvc.DetectCountAnomaliesRequestV1) (*pbsvc.DetectCountAnomaliesResponseV1, error) {
+	run, err := s.countAnomaliesQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// countAnomaliesQuery checks a count anomalies request and returns the function running its query
+func (s *NYCabServiceImpl) countAnomaliesQuery(in *pbsvc.DetectCountAnomaliesRequestV1) (func(ctx context.Context) (*pbsvc.DetectCountAnomaliesResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, withCabIDs(in.CabIds)...)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", in.StartDate, "end_date", in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	windowDays := int(in.WindowDays)
+	if windowDays == 0 {
+		windowDays = defaultBaselineWindowDays
+	}
+	if windowDays < 2 || windowDays > maxBaselineWindowDays {
+		return nil, invalidField("window_days", fmt.Sprintf("invalid window days [%d], expecting 2 to %d", windowDays, maxBaselineWindowDays))
+	}
+
+	threshold := in.Threshold
+	if threshold == 0 {
+		threshold = defaultAnomalyThreshold
+	}
+	if threshold < 0 {
+		return nil, invalidField("threshold", fmt.Sprintf("invalid threshold [%g], expecting a positive value", threshold))
+	}
+
+	return func(ctx context.Context) (*pbsvc.DetectCountAnomaliesResponseV1, error) {
+		// the baseline of the first scored date needs the window days before it
+		cabTripsPerDay, err := dbContext.GetDailyTripCounts(ctx, in.CabIds, startDate.AddDate(0, 0, -windowDays), endDate, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.DetectCountAnomaliesResponseV1{}, err
+		}
+
+		method := analytics.RollingMean
+		if in.Method == pbdata.BaselineMethod_MEDIAN_ABSOLUTE_DEVIATION {
+			method = analytics.MedianAbsoluteDeviation
+		}
+
+		response := &pbsvc.DetectCountAnomaliesResponseV1{
+			Anomalies: []*pbdata.CountAnomaly{},
+		}
+		// the cabs scored are reported as progress when the query runs as a query job
+		cabIDs := sortedIDs(cabTripsPerDay.CabTrips)
+		for i, cabID := range cabIDs {
+			jobs.ReportProgress(ctx, i, len(cabIDs))
+			counts := toDailyCounts(cabTripsPerDay.CabTrips[cabID])
+			if in.HolidayFilter == pbdata.HolidayFilter_EXCLUDE_HOLIDAYS {
+				counts = s.excludeHolidays(counts)
+			}
+
+			for _, anomaly := range analytics.DetectCountAnomalies(counts, startDate, windowDays, method, threshold) {
+				date := anomaly.Date.Format("2006-01-02")
+				response.Anomalies = append(response.Anomalies, &pbdata.CountAnomaly{
+					CabId:     cabID,
+					Date:      date,
+					Count:     uint32(anomaly.Count),
+					Expected:  anomaly.Expected,
+					Score:     anomaly.Score,
+					IsHoliday: in.HolidayFilter == pbdata.HolidayFilter_TAG_HOLIDAYS && s.holidays.IsHoliday(date),
+				})
+			}
+		}
+
+		return response, nil
+	}, nil
+}
+
+// ForecastTripsV1 returns the expected trip counts of each cab (or the whole fleet) for the days following the history
+func (s *NYCabServiceImpl) ForecastTripsV1(ctx context.Context, in *pbsvc.ForecastTripsRequestV1) (*pbsvc.ForecastTripsResponseV1, error) {
+	log.Println("ForecastTripsV1: request = ", in)
+	response, err := s.forecastTrips(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.ForecastTripsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) forecastTrips(ctx context.Context, in *pbsvc.ForecastTripsRequestV1) (*pbsvc.ForecastTripsResponseV1, error) {
+	run, err := s.forecastQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// forecastQuery checks a forecast request and returns the function running its query
+func (s *NYCabServiceImpl) forecastQuery(in *pbsvc.ForecastTripsRequestV1) (func(ctx context.Context) (*pbsvc.ForecastTripsResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, withCabIDs(in.CabIds)...)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := parseDateRange("history_start_date", in.HistoryStartDate, "history_end_date", in.HistoryEndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	horizonDays := int(in.HorizonDays)
+	if horizonDays == 0 {
+		horizonDays = defaultForecastDays
+	}
+	if horizonDays > maxForecastDays {
+		return nil, invalidField("horizon_days", fmt.Sprintf("invalid horizon days [%d], expecting 1 to %d", horizonDays, maxForecastDays))
+	}
+
+	level := in.PredictionLevel
+	if level == 0 {
+		level = defaultPredictionLevel
+	}
+	if level <= 0 || level >= 1 {
+		return nil, invalidField("prediction_level", fmt.Sprintf("invalid prediction level [%g], expecting a value between 0 and 1", level))
+	}
+
+	return func(ctx context.Context) (*pbsvc.ForecastTripsResponseV1, error) {
+		cabTripsPerDay, err := dbContext.GetDailyTripCounts(ctx, in.CabIds, startDate, endDate, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.ForecastTripsResponseV1{}, err
+		}
+
+		method := analytics.SeasonalNaive
+		if in.Method == pbdata.ForecastMethod_HOLT_WINTERS {
+			method = analytics.HoltWinters
+		}
+
+		response := &pbsvc.ForecastTripsResponseV1{
+			Forecasts: []*pbdata.CabTripForecast{},
+		}
+		// the cabs forecast are reported as progress when the query runs as a query job
+		cabIDs := sortedIDs(cabTripsPerDay.CabTrips)
+		for i, cabID := range cabIDs {
+			jobs.ReportProgress(ctx, i, len(cabIDs))
+			forecasts, err := analytics.ForecastCounts(toDailyCounts(cabTripsPerDay.CabTrips[cabID]), horizonDays, method, level)
+			if err != nil {
+				return nil, invalidField("history_start_date", fmt.Sprintf("failed to forecast [%s]: %v", cabID, err))
+			}
+
+			cabForecast := &pbdata.CabTripForecast{
+				CabId:     cabID,
+				Forecasts: make([]*pbdata.TripForecast, 0, len(forecasts)),
+			}
+			for _, forecast := range forecasts {
+				date := forecast.Date.Format("2006-01-02")
+				cabForecast.Forecasts = append(cabForecast.Forecasts, &pbdata.TripForecast{
+					Date:      date,
+					Expected:  forecast.Expected,
+					Lower:     forecast.Lower,
+					Upper:     forecast.Upper,
+					IsHoliday: s.holidays.IsHoliday(date),
+				})
+			}
+			response.Forecasts = append(response.Forecasts, cabForecast)
+		}
+
+		return response, nil
+	}, nil
+}
+
+// GetVendorStatsV1 returns the trip counts, average distance and anomaly rates of each vendor over a date range
+func (s *NYCabServiceImpl) GetVendorStatsV1(ctx context.Context, in *pbsvc.GetVendorStatsRequestV1) (*pbsvc.GetVendorStatsResponseV1, error) {
+	log.Println("GetVendorStatsV1: request = ", in)
+	response, err := s.getVendorStats(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetVendorStatsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getVendorStats(ctx context.Context, in *pbsvc.GetVendorStatsRequestV1) (*pbsvc.GetVendorStatsResponseV1, error) {
+	run, err := s.vendorStatsQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// vendorStatsQuery checks a vendor stats request and returns the function running its query
+func (s *NYCabServiceImpl) vendorStatsQuery(in *pbsvc.GetVendorStatsRequestV1) (func(ctx context.Context) (*pbsvc.GetVendorStatsResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, withCabIDs(in.CabIds, "vendor_id", "dropoff_datetime", "trip_distance", "passenger_count", "pickup_latitude", "pickup_longitude")...)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", in.StartDate, "end_date", in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSpeedMph := in.MaxSpeedMph
+	if maxSpeedMph <= 0 {
+		maxSpeedMph = defaultMaxSpeedMph
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetVendorStatsResponseV1, error) {
+		vendors, err := dbContext.GetVendorStats(ctx, in.CabIds, startDate, endDate, maxSpeedMph, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetVendorStatsResponseV1{}, err
+		}
+
+		return &pbsvc.GetVendorStatsResponseV1{
+			Vendors: vendors,
+		}, nil
+	}, nil
+}
+
+// toDailyCounts returns the trip counts ordered by date
+func toDailyCounts(tripsPerDay *pbdata.TripsPerDay) []analytics.DailyCount {
+	counts := make([]analytics.DailyCount, 0, len(tripsPerDay.GetTripsPerDay()))
+	for _, date := range sortedDates(tripsPerDay.GetTripsPerDay()) {
+		t, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			continue
+		}
+		counts = append(counts, analytics.DailyCount{
+			Date:  t,
+			Count: float64(tripsPerDay.TripsPerDay[date]),
+		})
+	}
+	return counts
+}
+
+// excludeHolidays returns the daily counts not falling on a holiday
+func (s *NYCabServiceImpl) excludeHolidays(counts []analytics.DailyCount) []analytics.DailyCount {
+	filtered := make([]analytics.DailyCount, 0, len(counts))
+	for _, count := range counts {
+		if !s.holidays.IsHoliday(count.Date.Format("2006-01-02")) {
+			filtered = append(filtered, count)
+		}
+	}
+	return filtered
+}
+
+func toPBTripCountSummary(period string, summary analytics.Summary) *pbdata.TripCountSummary {
+	return &pbdata.TripCountSummary{
+		Period:     period,
+		Days:       uint32(summary.Days),
+		TotalTrips: uint64(summary.Total),
+		Mean:       summary.Mean,
+		StdDev:     summary.StdDev,
+	}
+}
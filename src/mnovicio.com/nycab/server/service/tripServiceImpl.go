@@ -0,0 +1,433 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+
+	"mnovicio.com/nycab/server/analytics"
+	persistence "mnovicio.com/nycab/server/data/persistence"
+)
+
+// defaultMaxIdleMinutes is the idle gap starting a new shift when the request does not specify one
+const defaultMaxIdleMinutes = 60
+
+// shiftLookaround is how far before and after the pickup date trips are fetched to reconstruct the shifts crossing midnight
+const shiftLookaround = 24 * time.Hour
+
+// defaultMaxSpeedMph is the average speed above which a trip is implausible when the request does not specify one
+const defaultMaxSpeedMph = 80
+
+// defaultPageSize is the number of trips per page when the request does not specify one
+const defaultPageSize = 100
+
+// maxPageSize is the maximum number of trips per page
+const maxPageSize = 1000
+
+// dateTimeFormat is the date/time format used in responses
+const dateTimeFormat = "2006-01-02 15:04:05"
+
+// GetCabShiftsV1 reconstructs the shifts of a cab on a given pickup date from its trips ordered by pickup_datetime
+func (s *NYCabServiceImpl) GetCabShiftsV1(ctx context.Context, in *pbsvc.GetCabShiftsRequestV1) (*pbsvc.GetCabShiftsResponseV1, error) {
+	log.Println("GetCabShiftsV1: request = ", in)
+	response, err := s.getCabShifts(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetCabShiftsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getCabShifts(ctx context.Context, in *pbsvc.GetCabShiftsRequestV1) (*pbsvc.GetCabShiftsResponseV1, error) {
+	dbContext, err := s.dbContext(in.Dataset, "medallion", "hack_license", "dropoff_datetime", "trip_distance")
+	if err != nil {
+		return nil, err
+	}
+
+	if in.CabId == "" {
+		return nil, invalidField("cab_id", "missing cab_id")
+	}
+
+	// check date format
+	if err := checkDateFormat("pickup_date", in.PickupDate); err != nil {
+		return nil, err
+	}
+
+	maxIdleMinutes := in.MaxIdleMinutes
+	if maxIdleMinutes == 0 {
+		maxIdleMinutes = defaultMaxIdleMinutes
+	}
+
+	// shifts are reconstructed from the trips around the pickup date, then only the ones with a trip picked up on the date are kept
+	start, _ := time.Parse("2006-01-02", in.PickupDate)
+	end := start.AddDate(0, 0, 1)
+	trips, err := dbContext.GetTripsForCabs(ctx, []string{in.CabId}, start.Add(-shiftLookaround), end.Add(shiftLookaround))
+	if err != nil {
+		return &pbsvc.GetCabShiftsResponseV1{}, err
+	}
+
+	shifts := analytics.ShiftsPickedUpBetween(analytics.ReconstructShifts(toAnalyticsTrips(trips), time.Duration(maxIdleMinutes)*time.Minute), start, end)
+
+	response := &pbsvc.GetCabShiftsResponseV1{
+		Shifts: make([]*pbdata.Shift, 0, len(shifts)),
+	}
+	for _, shift := range shifts {
+		response.Shifts = append(response.Shifts, &pbdata.Shift{
+			CabId:       in.CabId,
+			HackLicense: shift.HackLicense,
+			StartTime:   shift.Start.Format(dateTimeFormat),
+			EndTime:     shift.End.Format(dateTimeFormat),
+			TripCount:   uint32(shift.TripCount),
+			ActiveSecs:  uint32(shift.Active.Seconds()),
+			IdleSecs:    uint32(shift.Idle.Seconds()),
+		})
+	}
+
+	return response, nil
+}
+
+// FindTripAnomaliesV1 returns the overlapping, zero duration and implausible speed trips of the cabs within a time range
+func (s *NYCabServiceImpl) FindTripAnomaliesV1(ctx context.Context, in *pbsvc.FindTripAnomaliesRequestV1) (*pbsvc.FindTripAnomaliesResponseV1, error) {
+	log.Println("FindTripAnomaliesV1: request = ", in)
+	response, err := s.findTripAnomalies(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.FindTripAnomaliesResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) findTripAnomalies(ctx context.Context, in *pbsvc.FindTripAnomaliesRequestV1) (*pbsvc.FindTripAnomaliesResponseV1, error) {
+	dbContext, err := s.dbContext(in.Dataset, "medallion", "hack_license", "dropoff_datetime", "trip_distance")
+	if err != nil {
+		return nil, err
+	}
+
+	// duplicate cab IDs would report the anomalies of their cab twice
+	cabIDs := uniqueIDs(in.CabIds)
+	if len(cabIDs) == 0 {
+		return nil, invalidField("cab_ids", "empty cab ID list")
+	}
+
+	startTime, endTime, err := parseTimeRange("start_time", in.StartTime, "end_time", in.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSpeedMph := in.MaxSpeedMph
+	if maxSpeedMph <= 0 {
+		maxSpeedMph = defaultMaxSpeedMph
+	}
+
+	trips, err := dbContext.GetTripsForCabs(ctx, cabIDs, startTime, endTime)
+	if err != nil {
+		return &pbsvc.FindTripAnomaliesResponseV1{}, err
+	}
+
+	response := &pbsvc.FindTripAnomaliesResponseV1{
+		Anomalies: []*pbdata.TripAnomaly{},
+	}
+	tripsPerCab := groupTripsByCab(trips)
+	for _, cabID := range cabIDs {
+		for _, anomaly := range analytics.FindAnomalies(toAnalyticsTrips(tripsPerCab[cabID]), maxSpeedMph) {
+			response.Anomalies = append(response.Anomalies, toPBTripAnomaly(cabID, anomaly))
+		}
+	}
+
+	return response, nil
+}
+
+// ListTripsV1 returns a page of the trips of a cab within a time range, ordered by pickup_datetime
+func (s *NYCabServiceImpl) ListTripsV1(ctx context.Context, in *pbsvc.ListTripsRequestV1) (*pbsvc.ListTripsResponseV1, error) {
+	log.Println("ListTripsV1: request = ", in)
+	response, err := s.listTrips(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.ListTripsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) listTrips(ctx context.Context, in *pbsvc.ListTripsRequestV1) (*pbsvc.ListTripsResponseV1, error) {
+	if in.CabId == "" {
+		return nil, invalidField("cab_id", "missing cab_id")
+	}
+
+	startTime, endTime, err := parseTimeRange("start_time", in.StartTime, "end_time", in.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := in.Fields
+	if len(fields) == 0 {
+		fields = s.availableTripFields(in.Dataset)
+	}
+	if unknown := unknownTripFields(fields); len(unknown) > 0 {
+		return nil, invalidField("fields", fmt.Sprintf("unknown fields %v, expecting any of %v", unknown, persistence.TripFields))
+	}
+
+	// only fetch the columns of the trip fields selected by the field mask
+	if mask := requestedFields(ctx); mask != nil {
+		selected := []string{}
+		for _, field := range fields {
+			if mask.selects("trips." + field) {
+				selected = append(selected, field)
+			}
+		}
+		fields = selected
+	}
+
+	dbContext, err := s.dbContext(in.Dataset, persistence.TripColumns(fields)...)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := in.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	// a page token only continues the query it was returned for
+	query := pageTokenQuery(in.CabId, startTime, endTime, fields, in.Dataset)
+	offset, err := decodePageToken(in.PageToken, query)
+	if err != nil {
+		return nil, invalidField("page_token", fmt.Sprintf("invalid page_token [%s]: %v", in.PageToken, err))
+	}
+	if offset > math.MaxUint32-pageSize {
+		return nil, invalidField("page_token", fmt.Sprintf("invalid page_token [%s]: offset %d out of range", in.PageToken, offset))
+	}
+
+	// fetch one more trip than requested to know if there is a next page
+	trips, err := dbContext.ListTrips(ctx, in.CabId, startTime, endTime, fields, offset, pageSize+1)
+	if err != nil {
+		return &pbsvc.ListTripsResponseV1{}, err
+	}
+
+	response := &pbsvc.ListTripsResponseV1{
+		Trips: []*pbdata.TripRecord{},
+	}
+	if uint32(len(trips)) > pageSize {
+		trips = trips[:pageSize]
+		response.NextPageToken = encodePageToken(offset+pageSize, query)
+	}
+	for _, trip := range trips {
+		response.Trips = append(response.Trips, toPBTripRecord(trip, fields))
+	}
+
+	return response, nil
+}
+
+// availableTripFields returns the trip fields whose columns the dataset has
+func (s *NYCabServiceImpl) availableTripFields(dataset pbdata.Dataset) []string {
+	dbContext, found := s.dbContexts[dataset]
+	if !found {
+		return persistence.TripFields
+	}
+
+	fields := []string{}
+	for _, field := range persistence.TripFields {
+		if len(dbContext.Dataset().Missing(persistence.TripColumns([]string{field})...)) == 0 {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func unknownTripFields(fields []string) []string {
+	unknown := []string{}
+	for _, field := range fields {
+		known := false
+		for _, tripField := range persistence.TripFields {
+			if field == tripField {
+				known = true
+				break
+			}
+		}
+		if !known {
+			unknown = append(unknown, field)
+		}
+	}
+	return unknown
+}
+
+// pageTokenQuery returns a fingerprint of the filters of a trip listing, carried by its page tokens
+func pageTokenQuery(cabID string, start, end time.Time, fields []string, dataset pbdata.Dataset) uint64 {
+	hash := fnv.New64a()
+	fmt.Fprintf(hash, "%s|%s|%s|%s|%s", cabID, start.Format(dateTimeFormat), end.Format(dateTimeFormat), strings.Join(fields, ","), dataset)
+	return hash.Sum64()
+}
+
+// encodePageToken returns an opaque page token for the trip offset of the query
+func encodePageToken(offset uint32, query uint64) string {
+	return base64.URLEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(offset), 10) + "." + strconv.FormatUint(query, 16)))
+}
+
+// decodePageToken returns the trip offset of the page token, 0 for an empty token
+// returns an error if the token was returned for another query
+func decodePageToken(token string, query uint64) (uint32, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	decoded, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	parts := strings.Split(string(decoded), ".")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("malformed token")
+	}
+	offset, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	tokenQuery, err := strconv.ParseUint(parts[1], 16, 64)
+	if err != nil {
+		return 0, err
+	}
+	if tokenQuery != query {
+		return 0, fmt.Errorf("token does not match the cab_id, time range, fields and dataset of the request")
+	}
+	return uint32(offset), nil
+}
+
+func toPBTripRecord(trip persistence.Trip, fields []string) *pbdata.TripRecord {
+	record := &pbdata.TripRecord{
+		CabId: trip.CabID,
+	}
+
+	for _, field := range fields {
+		switch field {
+		case persistence.TripFieldHackLicense:
+			record.HackLicense = trip.HackLicense
+		case persistence.TripFieldPickupTime:
+			record.PickupTime = trip.PickupTime.Format(dateTimeFormat)
+		case persistence.TripFieldDropoffTime:
+			record.DropoffTime = trip.DropoffTime.Format(dateTimeFormat)
+		case persistence.TripFieldPickupLocation:
+			record.PickupLocation = &pbdata.GeoPoint{Latitude: trip.PickupLatitude, Longitude: trip.PickupLongitude}
+		case persistence.TripFieldDropoffLocation:
+			record.DropoffLocation = &pbdata.GeoPoint{Latitude: trip.DropoffLatitude, Longitude: trip.DropoffLongitude}
+		case persistence.TripFieldTripDistance:
+			record.TripDistance = trip.TripDistance
+		case persistence.TripFieldPassengerCount:
+			record.PassengerCount = trip.PassengerCount
+		}
+	}
+
+	return record
+}
+
+func groupTripsByCab(trips []persistence.Trip) map[string][]persistence.Trip {
+	tripsPerCab := make(map[string][]persistence.Trip)
+	for _, trip := range trips {
+		tripsPerCab[trip.CabID] = append(tripsPerCab[trip.CabID], trip)
+	}
+	return tripsPerCab
+}
+
+func toPBTripAnomaly(cabID string, anomaly analytics.Anomaly) *pbdata.TripAnomaly {
+	pbAnomaly := &pbdata.TripAnomaly{
+		CabId:        cabID,
+		HackLicense:  anomaly.Trip.HackLicense,
+		PickupTime:   anomaly.Trip.Pickup.Format(dateTimeFormat),
+		DropoffTime:  anomaly.Trip.Dropoff.Format(dateTimeFormat),
+		TripDistance: anomaly.Trip.Distance,
+		Detail:       anomaly.Detail,
+	}
+
+	switch anomaly.Type {
+	case analytics.OverlappingTrips:
+		pbAnomaly.Type = pbdata.TripAnomalyType_OVERLAPPING_TRIPS
+	case analytics.ZeroDuration:
+		pbAnomaly.Type = pbdata.TripAnomalyType_ZERO_DURATION
+	case analytics.ImplausibleSpeed:
+		pbAnomaly.Type = pbdata.TripAnomalyType_IMPLAUSIBLE_SPEED
+	}
+
+	if anomaly.Conflicting != nil {
+		pbAnomaly.ConflictingPickupTime = anomaly.Conflicting.Pickup.Format(dateTimeFormat)
+		pbAnomaly.ConflictingHackLicense = anomaly.Conflicting.HackLicense
+	}
+
+	return pbAnomaly
+}
+
+func toAnalyticsTrips(trips []persistence.Trip) []analytics.Trip {
+	analyticsTrips := make([]analytics.Trip, 0, len(trips))
+	for _, trip := range trips {
+		analyticsTrips = append(analyticsTrips, analytics.Trip{
+			HackLicense: trip.HackLicense,
+			Pickup:      trip.PickupTime,
+			Dropoff:     trip.DropoffTime,
+			Distance:    trip.TripDistance,
+		})
+	}
+	return analyticsTrips
+}
+
+// GetPassengerCountsV1 returns the distribution of passenger counts of the whole fleet and of each cab over a date range
+func (s *NYCabServiceImpl) GetPassengerCountsV1(ctx context.Context, in *pbsvc.GetPassengerCountsRequestV1) (*pbsvc.GetPassengerCountsResponseV1, error) {
+	log.Println("GetPassengerCountsV1: request = ", in)
+	response, err := s.getPassengerCounts(ctx, in)
+	if errString, handled := handledError(err); handled {
+		return &pbsvc.GetPassengerCountsResponseV1{
+			Error: errString,
+		}, nil
+	}
+
+	return response, err
+}
+
+func (s *NYCabServiceImpl) getPassengerCounts(ctx context.Context, in *pbsvc.GetPassengerCountsRequestV1) (*pbsvc.GetPassengerCountsResponseV1, error) {
+	run, err := s.passengerCountsQuery(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return run(ctx)
+}
+
+// passengerCountsQuery checks a passenger counts request and returns the function running its query
+func (s *NYCabServiceImpl) passengerCountsQuery(in *pbsvc.GetPassengerCountsRequestV1) (func(ctx context.Context) (*pbsvc.GetPassengerCountsResponseV1, error), error) {
+	dbContext, err := s.dbContext(in.Dataset, withCabIDs(in.CabIds, "passenger_count")...)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", in.StartDate, "end_date", in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context) (*pbsvc.GetPassengerCountsResponseV1, error) {
+		fleet, cabs, err := dbContext.GetPassengerCountDistribution(ctx, requestedCabIDs(ctx, in.CabIds), startDate, endDate, in.IgnoreCache)
+		if err != nil {
+			return &pbsvc.GetPassengerCountsResponseV1{}, err
+		}
+
+		return &pbsvc.GetPassengerCountsResponseV1{
+			Fleet: fleet,
+			Cabs:  cabs,
+		}, nil
+	}, nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+)
+
+func TestPageToken(t *testing.T) {
+	start := time.Date(2013, 12, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	fields := []string{"pickup_time", "dropoff_time"}
+	query := pageTokenQuery("D7D598CD99978BD012A87A76A7C891B7", start, end, fields, pbdata.Dataset_YELLOW)
+
+	offset, err := decodePageToken(encodePageToken(200, query), query)
+	if err != nil || offset != 200 {
+		t.Errorf("decodePageToken(encodePageToken(200)) = %d, %v, want 200", offset, err)
+	}
+	if offset, err := decodePageToken("", query); err != nil || offset != 0 {
+		t.Errorf("decodePageToken(\"\") = %d, %v, want 0", offset, err)
+	}
+
+	otherQueries := []struct {
+		name  string
+		query uint64
+	}{
+		{"cab_id", pageTokenQuery("0F3B4B2B1CB4C05D2E9C4B1A8E6C2A4F", start, end, fields, pbdata.Dataset_YELLOW)},
+		{"start_time", pageTokenQuery("D7D598CD99978BD012A87A76A7C891B7", start.Add(time.Hour), end, fields, pbdata.Dataset_YELLOW)},
+		{"end_time", pageTokenQuery("D7D598CD99978BD012A87A76A7C891B7", start, end.Add(time.Hour), fields, pbdata.Dataset_YELLOW)},
+		{"fields", pageTokenQuery("D7D598CD99978BD012A87A76A7C891B7", start, end, fields[:1], pbdata.Dataset_YELLOW)},
+		{"dataset", pageTokenQuery("D7D598CD99978BD012A87A76A7C891B7", start, end, fields, pbdata.Dataset_GREEN)},
+	}
+	for _, other := range otherQueries {
+		if _, err := decodePageToken(encodePageToken(200, query), other.query); err == nil {
+			t.Errorf("decodePageToken() of a token for another %s = nil, want error", other.name)
+		}
+	}
+
+	for _, token := range []string{"not base64!", "MjAw", "eC4x"} {
+		if _, err := decodePageToken(token, query); err == nil {
+			t.Errorf("decodePageToken(%q) = nil, want error", token)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+	pbsvc "mnovicio.com/nycab/protocol/rpc"
+
+	"mnovicio.com/nycab/server/watch"
+)
+
+const (
+	// maxWatchedCabs is the number of cabs a single watch may watch
+	maxWatchedCabs = 100
+	// importPollInterval is the time between checks for trips committed to the trip tables of watched datasets
+	importPollInterval = 15 * time.Second
+)
+
+// WatchTripCountsV1 streams the daily trip counts of cabs, then the counts that changed whenever trips are imported,
+// the cache of the dataset is cleared or cached trip counts of the cabs are replaced by different counts
+func (s *NYCabServiceImpl) WatchTripCountsV1(in *pbsvc.WatchTripCountsRequestV1, stream pbsvc.NYCabService_WatchTripCountsV1Server) error {
+	log.Println("WatchTripCountsV1: request = ", in)
+	err := s.watchTripCounts(stream.Context(), in, stream.Send)
+	if errString, handled := handledError(err); handled {
+		return stream.Send(&pbsvc.WatchTripCountsResponseV1{
+			Error: errString,
+		})
+	}
+
+	return err
+}
+
+func (s *NYCabServiceImpl) watchTripCounts(ctx context.Context, in *pbsvc.WatchTripCountsRequestV1, send func(*pbsvc.WatchTripCountsResponseV1) error) error {
+	cabIDs := uniqueIDs(in.CabIds)
+	if len(cabIDs) == 0 {
+		return invalidField("cab_ids", "missing cab IDs to watch")
+	}
+	if len(cabIDs) > maxWatchedCabs {
+		return invalidField("cab_ids", fmt.Sprintf("%d cab IDs exceed the maximum of %d watched cabs", len(cabIDs), maxWatchedCabs))
+	}
+
+	startDate, endDate, err := parseDateRange("start_date", in.StartDate, "end_date", in.EndDate)
+	if err != nil {
+		return err
+	}
+
+	dbContext, err := s.dbContext(in.Dataset, "medallion")
+	if err != nil {
+		return err
+	}
+
+	// watch before fetching the trip counts to not miss changes made meanwhile
+	events, stop := s.watchers.Watch(in.Dataset.String(), cabIDs)
+	defer stop()
+	s.detectImportsOnce.Do(func() {
+		go s.detectImports()
+	})
+
+	watched, err := dbContext.GetDailyTripCounts(ctx, cabIDs, startDate, endDate, false)
+	if err != nil {
+		return err
+	}
+	if err := send(&pbsvc.WatchTripCountsResponseV1{
+		CabTripsPerDay: watched,
+		Trigger:        pbsvc.WatchTrigger_STARTED,
+	}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("stopped watching trip counts of %v", cabIDs)
+			return nil
+		case event := <-events:
+			lastDate, err := dbContext.LastPickupDate(ctx)
+			if ctx.Err() != nil {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			// only the dates the event may have changed are fetched, refreshed counts are read from the cache
+			refreshStart, refreshEnd, ignoreCache := refreshDateRange(event.Trigger, lastDate, startDate, endDate)
+			if refreshEnd.Before(refreshStart) {
+				continue
+			}
+			updated, err := dbContext.GetDailyTripCounts(ctx, cabIDs, refreshStart, refreshEnd, ignoreCache)
+			if ctx.Err() != nil {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			changed := changedTripCounts(watched, updated)
+			mergeTripCounts(watched, updated)
+			if len(changed.CabTrips) == 0 {
+				continue
+			}
+			if err := send(&pbsvc.WatchTripCountsResponseV1{
+				CabTripsPerDay: changed,
+				Trigger:        pbsvc.WatchTrigger(event.Trigger),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// refreshDateRange returns the dates of the watched range an event may have changed, and whether their counts are fetched ignoring the cache
+// lastDate is the last pickup date, the daily trip counts are cached up to it:
+// imports add trips on or after it, which are fetched from DB, and a refreshed cache holds counts up to it, which are read from the cache
+// the returned range is empty if the event changed none of the watched dates
+func refreshDateRange(trigger watch.Trigger, lastDate string, startDate, endDate time.Time) (time.Time, time.Time, bool) {
+	last, err := time.Parse("2006-01-02", lastDate)
+	if err != nil {
+		// the table had no trips, every date may have been imported since
+		return startDate, endDate, trigger == watch.TripsImported
+	}
+
+	switch trigger {
+	case watch.TripsImported:
+		if last.After(startDate) {
+			startDate = last
+		}
+		return startDate, endDate, true
+	case watch.CacheRefreshed:
+		if last.Before(endDate) {
+			endDate = last
+		}
+	}
+	return startDate, endDate, false
+}
+
+// mergeTripCounts sets the trip counts of updated in watched
+func mergeTripCounts(watched, updated *pbdata.CabTripsPerDay) {
+	for cabID, trips := range updated.CabTrips {
+		if watched.CabTrips[cabID] == nil {
+			watched.CabTrips[cabID] = &pbdata.TripsPerDay{
+				TripsPerDay: make(map[string]uint32),
+			}
+		}
+		for date, count := range trips.TripsPerDay {
+			watched.CabTrips[cabID].TripsPerDay[date] = count
+		}
+	}
+}
+
+// changedTripCounts returns the trip counts of updated that differ from the ones of previous
+func changedTripCounts(previous, updated *pbdata.CabTripsPerDay) *pbdata.CabTripsPerDay {
+	changed := &pbdata.CabTripsPerDay{
+		CabTrips: make(map[string]*pbdata.TripsPerDay),
+	}
+	for cabID, trips := range updated.CabTrips {
+		previousTrips := previous.CabTrips[cabID].GetTripsPerDay()
+		for date, count := range trips.TripsPerDay {
+			if previousCount, found := previousTrips[date]; found && previousCount == count {
+				continue
+			}
+			if changed.CabTrips[cabID] == nil {
+				changed.CabTrips[cabID] = &pbdata.TripsPerDay{
+					TripsPerDay: make(map[string]uint32),
+				}
+			}
+			changed.CabTrips[cabID].TripsPerDay[date] = count
+		}
+	}
+	return changed
+}
+
+// detectImports polls the update time of the trip tables of the watched datasets, and notifies their watchers when trips are committed
+// it relies on the UPDATE_TIME of information_schema.TABLES, which MySQL only sets for InnoDB tables (since 5.7) and loses when it restarts:
+// a restart is reported as an import, and imports into tables without UPDATE_TIME are not detected
+func (s *NYCabServiceImpl) detectImports() {
+	updateTimes := make(map[pbdata.Dataset]time.Time)
+	for range time.Tick(importPollInterval) {
+		for dataset, dbContext := range s.dbContexts {
+			if !s.watchers.Watched(dataset.String()) {
+				delete(updateTimes, dataset)
+				continue
+			}
+
+			updateTime, err := dbContext.TableUpdateTime(context.Background())
+			if err != nil {
+				log.Printf("failed to check for imported trips of dataset [%s]: %v", dataset, err)
+				continue
+			}
+
+			if lastUpdateTime, found := updateTimes[dataset]; found && !updateTime.Equal(lastUpdateTime) {
+				log.Printf("trips were committed to dataset [%s] at %s", dataset, updateTime.Format(dateTimeFormat))
+				s.watchers.Publish(watch.Event{
+					Dataset: dataset.String(),
+					Trigger: watch.TripsImported,
+				})
+			}
+			updateTimes[dataset] = updateTime
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	pbdata "mnovicio.com/nycab/protocol/objects"
+
+	"mnovicio.com/nycab/server/watch"
+)
+
+// tripCounts returns trip counts per day keyed by cab ID
+func tripCounts(counts map[string]map[string]uint32) *pbdata.CabTripsPerDay {
+	tripsPerDay := &pbdata.CabTripsPerDay{
+		CabTrips: make(map[string]*pbdata.TripsPerDay),
+	}
+	for cabID, days := range counts {
+		tripsPerDay.CabTrips[cabID] = &pbdata.TripsPerDay{TripsPerDay: days}
+	}
+	return tripsPerDay
+}
+
+func TestChangedTripCounts(t *testing.T) {
+	previous := map[string]map[string]uint32{
+		"A": {"2013-12-01": 10, "2013-12-02": 5},
+		"B": {"2013-12-01": 3},
+	}
+
+	tests := []struct {
+		name    string
+		updated map[string]map[string]uint32
+		want    map[string]map[string]uint32
+	}{
+		{"unchanged", previous, map[string]map[string]uint32{}},
+		{
+			name:    "changed count",
+			updated: map[string]map[string]uint32{"A": {"2013-12-01": 10, "2013-12-02": 6}, "B": {"2013-12-01": 3}},
+			want:    map[string]map[string]uint32{"A": {"2013-12-02": 6}},
+		},
+		{
+			name:    "new date",
+			updated: map[string]map[string]uint32{"A": {"2013-12-01": 10, "2013-12-02": 5, "2013-12-03": 0}, "B": {"2013-12-01": 3}},
+			want:    map[string]map[string]uint32{"A": {"2013-12-03": 0}},
+		},
+		{
+			name:    "new cab",
+			updated: map[string]map[string]uint32{"A": {"2013-12-01": 10, "2013-12-02": 5}, "B": {"2013-12-01": 3}, "C": {"2013-12-01": 1}},
+			want:    map[string]map[string]uint32{"C": {"2013-12-01": 1}},
+		},
+		{
+			// counts missing from the update are not reported
+			name:    "missing cab",
+			updated: map[string]map[string]uint32{"A": {"2013-12-01": 11}},
+			want:    map[string]map[string]uint32{"A": {"2013-12-01": 11}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := changedTripCounts(tripCounts(previous), tripCounts(test.updated))
+			if want := tripCounts(test.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("changedTripCounts() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRefreshDateRange(t *testing.T) {
+	date := func(value string) time.Time {
+		d, _ := time.Parse("2006-01-02", value)
+		return d
+	}
+	start, end := date("2013-12-01"), date("2013-12-31")
+
+	tests := []struct {
+		name            string
+		trigger         watch.Trigger
+		lastDate        string
+		wantStart       string
+		wantEnd         string
+		wantIgnoreCache bool
+	}{
+		{"imported in range", watch.TripsImported, "2013-12-20", "2013-12-20", "2013-12-31", true},
+		{"imported before range", watch.TripsImported, "2013-11-15", "2013-12-01", "2013-12-31", true},
+		{"imported after range", watch.TripsImported, "2014-01-10", "2014-01-10", "2013-12-31", true},
+		{"imported into empty table", watch.TripsImported, "", "2013-12-01", "2013-12-31", true},
+		{"refreshed in range", watch.CacheRefreshed, "2013-12-20", "2013-12-01", "2013-12-20", false},
+		{"refreshed after range", watch.CacheRefreshed, "2014-01-10", "2013-12-01", "2013-12-31", false},
+		{"refreshed before range", watch.CacheRefreshed, "2013-11-15", "2013-12-01", "2013-11-15", false},
+		{"cache cleared", watch.CacheCleared, "2013-12-20", "2013-12-01", "2013-12-31", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			gotStart, gotEnd, gotIgnoreCache := refreshDateRange(test.trigger, test.lastDate, start, end)
+			if !gotStart.Equal(date(test.wantStart)) || !gotEnd.Equal(date(test.wantEnd)) || gotIgnoreCache != test.wantIgnoreCache {
+				t.Errorf("refreshDateRange() = %s, %s, %t, want %s, %s, %t", gotStart.Format("2006-01-02"), gotEnd.Format("2006-01-02"), gotIgnoreCache,
+					test.wantStart, test.wantEnd, test.wantIgnoreCache)
+			}
+		})
+	}
+}
+
+func TestMergeTripCounts(t *testing.T) {
+	watched := tripCounts(map[string]map[string]uint32{"A": {"2013-12-01": 10, "2013-12-02": 5}})
+	mergeTripCounts(watched, tripCounts(map[string]map[string]uint32{"A": {"2013-12-02": 6, "2013-12-03": 1}, "B": {"2013-12-03": 2}}))
+
+	want := tripCounts(map[string]map[string]uint32{"A": {"2013-12-01": 10, "2013-12-02": 6, "2013-12-03": 1}, "B": {"2013-12-03": 2}})
+	if !reflect.DeepEqual(watched, want) {
+		t.Errorf("mergeTripCounts() = %v, want %v", watched, want)
+	}
+}
@@ -0,0 +1,109 @@
+package watch
+
+import (
+	"sync"
+)
+
+// Trigger tells what may have changed the trips of a dataset
+type Trigger int
+
+// Triggers, Started is only used for the first trip counts sent to a watcher
+const (
+	Started Trigger = iota
+	TripsImported
+	CacheCleared
+	CacheRefreshed
+)
+
+// Event tells the watchers of a dataset that its trips may have changed
+type Event struct {
+	Dataset string
+	// CabIDs are the cabs whose trips may have changed, every cab if empty
+	CabIDs  []string
+	Trigger Trigger
+}
+
+// Hub delivers events to the watchers of the cabs of a dataset
+type Hub struct {
+	sync.Mutex
+	watchers map[*watcher]bool
+}
+
+type watcher struct {
+	dataset string
+	cabIDs  map[string]bool
+	events  chan Event
+}
+
+// NewHub returns a hub without watchers
+func NewHub() *Hub {
+	return &Hub{
+		watchers: make(map[*watcher]bool),
+	}
+}
+
+// Watch returns a channel receiving the events of the dataset concerning any of the cabs, and a function to stop watching
+// an event is dropped while the previous one is not received yet, so watchers should refresh all their cabs on each event
+func (h *Hub) Watch(dataset string, cabIDs []string) (<-chan Event, func()) {
+	w := &watcher{
+		dataset: dataset,
+		cabIDs:  make(map[string]bool),
+		events:  make(chan Event, 1),
+	}
+	for _, cabID := range cabIDs {
+		w.cabIDs[cabID] = true
+	}
+
+	h.Lock()
+	h.watchers[w] = true
+	h.Unlock()
+
+	return w.events, func() {
+		h.Lock()
+		delete(h.watchers, w)
+		h.Unlock()
+	}
+}
+
+// Watched returns true if the dataset has watchers
+func (h *Hub) Watched(dataset string) bool {
+	h.Lock()
+	defer h.Unlock()
+
+	for w := range h.watchers {
+		if w.dataset == dataset {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish delivers the event to the watchers of its dataset watching any of its cabs, without blocking
+func (h *Hub) Publish(event Event) {
+	h.Lock()
+	defer h.Unlock()
+
+	for w := range h.watchers {
+		if w.dataset != event.Dataset || !w.watches(event.CabIDs) {
+			continue
+		}
+		select {
+		case w.events <- event:
+		default:
+			// the watcher has yet to handle its previous event
+		}
+	}
+}
+
+// watches returns true if the watcher watches any of the cabs, or if cabIDs is empty
+func (w *watcher) watches(cabIDs []string) bool {
+	if len(cabIDs) == 0 {
+		return true
+	}
+	for _, cabID := range cabIDs {
+		if w.cabIDs[cabID] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package watch
+
+import (
+	"testing"
+)
+
+// received returns the event waiting on the channel, false if there is none
+func received(events <-chan Event) (Event, bool) {
+	select {
+	case event := <-events:
+		return event, true
+	default:
+		return Event{}, false
+	}
+}
+
+func TestHubPublish(t *testing.T) {
+	tests := []struct {
+		name      string
+		event     Event
+		wantFirst bool
+		wantOther bool
+	}{
+		{"watched cab", Event{Dataset: "YELLOW", CabIDs: []string{"A"}, Trigger: CacheRefreshed}, true, false},
+		{"any of the cabs", Event{Dataset: "YELLOW", CabIDs: []string{"X", "B"}, Trigger: CacheRefreshed}, true, false},
+		{"every cab", Event{Dataset: "YELLOW", Trigger: CacheCleared}, true, true},
+		{"other cabs", Event{Dataset: "YELLOW", CabIDs: []string{"X"}, Trigger: CacheRefreshed}, false, false},
+		{"other dataset", Event{Dataset: "GREEN", Trigger: TripsImported}, false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hub := NewHub()
+			first, stopFirst := hub.Watch("YELLOW", []string{"A", "B"})
+			defer stopFirst()
+			other, stopOther := hub.Watch("YELLOW", []string{"C"})
+			defer stopOther()
+
+			hub.Publish(test.event)
+
+			event, found := received(first)
+			if found != test.wantFirst {
+				t.Errorf("first watcher received = %t, want %t", found, test.wantFirst)
+			}
+			if found && event.Trigger != test.event.Trigger {
+				t.Errorf("first watcher trigger = %d, want %d", event.Trigger, test.event.Trigger)
+			}
+			if _, found := received(other); found != test.wantOther {
+				t.Errorf("other watcher received = %t, want %t", found, test.wantOther)
+			}
+		})
+	}
+}
+
+func TestHubDropsEventsOfBusyWatchers(t *testing.T) {
+	hub := NewHub()
+	events, stop := hub.Watch("YELLOW", []string{"A"})
+	defer stop()
+
+	// the second event is dropped without blocking while the first one is not received
+	hub.Publish(Event{Dataset: "YELLOW", Trigger: TripsImported})
+	hub.Publish(Event{Dataset: "YELLOW", Trigger: CacheCleared})
+
+	if event, found := received(events); !found || event.Trigger != TripsImported {
+		t.Errorf("received = %v, %t, want the first event", event, found)
+	}
+	if event, found := received(events); found {
+		t.Errorf("received = %v, want no second event", event)
+	}
+}
+
+func TestHubStopWatching(t *testing.T) {
+	hub := NewHub()
+	events, stop := hub.Watch("YELLOW", []string{"A"})
+	if !hub.Watched("YELLOW") || hub.Watched("GREEN") {
+		t.Errorf("Watched(YELLOW), Watched(GREEN) = %t, %t, want true, false", hub.Watched("YELLOW"), hub.Watched("GREEN"))
+	}
+
+	stop()
+	if hub.Watched("YELLOW") {
+		t.Error("Watched(YELLOW) = true after stop, want false")
+	}
+	hub.Publish(Event{Dataset: "YELLOW"})
+	if _, found := received(events); found {
+		t.Error("stopped watcher received an event")
+	}
+}